@@ -1,13 +1,14 @@
 package tars
 
 import (
-	"bytes"
-	"encoding/binary"
 	"fmt"
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/endpointf"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/tup"
 	"github.com/TarsCloud/TarsGo/tars/transport"
+	"github.com/TarsCloud/TarsGo/tars/util/limit"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -19,16 +20,22 @@ type AdapterProxy struct {
 	point      *endpointf.EndpointF
 	tarsClient *transport.TarsClient
 	comm       *Communicator
+	limiter    limit.Limiter
+	latency    latencyStat
 	failCount  int32
 	sendCount  int32
 	status     bool
-	closed     bool
+	done       chan struct{}
+	closeOnce  sync.Once
+
+	ejectedUntil int64
 }
 
 // New : Construct an adapter proxy
 func (c *AdapterProxy) New(point *endpointf.EndpointF, comm *Communicator) error {
 	c.comm = comm
 	c.point = point
+	c.limiter = newLimiter(comm.Client, comm.Client.AdapterLimit, AdapterLimitMin, AdapterLimitMax)
 	proto := "tcp"
 	if point.Istcp == 0 {
 		proto = "udp"
@@ -44,6 +51,7 @@ func (c *AdapterProxy) New(point *endpointf.EndpointF, comm *Communicator) error
 	}
 	c.tarsClient = transport.NewTarsClient(fmt.Sprintf("%s:%d", point.Host, point.Port), c, conf)
 	c.status = true
+	c.done = make(chan struct{})
 	go c.checkActive()
 	return nil
 }
@@ -62,8 +70,7 @@ func (c *AdapterProxy) Recv(pkg []byte) {
 			TLOG.Error("recv pkg painc:", err)
 		}
 	}()
-	packet := requestf.ResponsePacket{}
-	err := packet.ReadFrom(codec.NewReader(pkg))
+	packet, err := decodeResponse(pkg)
 	if err != nil {
 		TLOG.Error("decode packet error", err.Error())
 		return
@@ -72,25 +79,39 @@ func (c *AdapterProxy) Recv(pkg []byte) {
 	if ok {
 		ch := chIF.(chan *requestf.ResponsePacket)
 		TLOG.Debug("IN:", packet)
-		ch <- &packet
+		ch <- packet
 	} else {
 		TLOG.Error("timeout resp,drop it:", packet.IRequestId)
 	}
 }
 
+// decodeResponse decodes the response, TUP servers respond with a request packet.
+func decodeResponse(pkg []byte) (*requestf.ResponsePacket, error) {
+	var version int16
+	is := codec.NewReader(pkg)
+	if err := is.Read_int16(&version, 1, true); err != nil {
+		return nil, err
+	}
+	is.Reset(pkg)
+	if version == basef.TUPVERSION {
+		packet := requestf.RequestPacket{}
+		if err := packet.ReadFrom(is); err != nil {
+			return nil, err
+		}
+		return tup.PacketToResponse(&packet), nil
+	}
+	packet := &requestf.ResponsePacket{}
+	if err := packet.ReadFrom(is); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+
 // Send : Send packet
 func (c *AdapterProxy) Send(req *requestf.RequestPacket) error {
 	TLOG.Debug("send req:", req.IRequestId)
 	c.sendAdd()
-	sbuf := bytes.NewBuffer(nil)
-	sbuf.Write(make([]byte, 4))
-	os := codec.NewBuffer()
-	req.WriteTo(os)
-	bs := os.ToBytes()
-	sbuf.Write(bs)
-	len := sbuf.Len()
-	binary.BigEndian.PutUint32(sbuf.Bytes(), uint32(len))
-	return c.tarsClient.Send(sbuf.Bytes())
+	return c.tarsClient.Send(encodePacket(req))
 }
 
 // GetPoint : Get an endpoint
@@ -98,10 +119,22 @@ func (c *AdapterProxy) GetPoint() *endpointf.EndpointF {
 	return c.point
 }
 
+// Limit : Get the current concurrency limit of the endpoint
+func (c *AdapterProxy) Limit() int {
+	return c.limiter.Limit()
+}
+
+// QueueLen : Get the number of requests waiting for response from the endpoint
+func (c *AdapterProxy) QueueLen() int {
+	return c.limiter.Inflight()
+}
+
 // Close : Close the client
 func (c *AdapterProxy) Close() {
-	c.tarsClient.Close()
-	c.closed = true
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.tarsClient.Close()
+	})
 }
 
 func (c *AdapterProxy) sendAdd() {
@@ -119,11 +152,13 @@ func (c *AdapterProxy) reset() {
 
 func (c *AdapterProxy) checkActive() {
 	loop := time.NewTicker(AdapterProxyTicker)
+	defer loop.Stop()
 	count := 0 // Detect if a dead node recovers each minute
-	for range loop.C {
-		if c.closed {
-			loop.Stop()
+	for {
+		select {
+		case <-c.done:
 			return
+		case <-loop.C:
 		}
 		if c.failCount > c.sendCount/2 {
 			c.status = false
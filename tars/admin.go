@@ -56,6 +56,8 @@ func (a *Admin) Notify(command string) (string, error) {
 		}
 		return fmt.Sprintf("Getconfig Success!: %s", cmd[1]), nil
 
+	case "tars.fault":
+		return faultNotify(cmd[1:])
 	case "tars.connection":
 		return fmt.Sprintf("%s not support now!", command), nil
 	default:
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -49,9 +50,33 @@ func Init() {
 	initOnce.Do(initConfig)
 }
 
+// configArg returns the value of -config or --config in the command line arguments before --.
+// The other flags are unknown, so the arguments not starting with - are skipped as their values.
+func configArg(args []string) string {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return ""
+		}
+		if len(arg) < 2 || arg[0] != '-' {
+			continue
+		}
+		name := strings.TrimPrefix(arg[1:], "-")
+		if name == "config" && i+1 < len(args) {
+			return args[i+1]
+		}
+		if strings.HasPrefix(name, "config=") {
+			return strings.TrimPrefix(name, "config=")
+		}
+	}
+	return ""
+}
+
 func initConfig() {
 	confPath := flag.String("config", "", "init config path")
-	flag.Parse()
+	// the flags of the application and go test are not defined yet, so only -config is taken here,
+	// flag.Parse of the application still accepts it
+	*confPath = configArg(os.Args[1:])
 	if len(*confPath) == 0 {
 		return
 	}
@@ -105,11 +130,34 @@ func initConfig() {
 	}
 	
 	cltCfg.refreshEndpointInterval = c.GetInt("/tars/application/client<refresh-endpoint-interval>")
+	if cltCfg.refreshEndpointInterval <= 0 {
+		cltCfg.refreshEndpointInterval = refreshEndpointInterval
+	}
+	cltCfg.Registry = cMap["registry"]
+	cltCfg.ObjLimit = c.GetInt("/tars/application/client<obj-limit>")
+	if cltCfg.ObjLimit <= 0 {
+		cltCfg.ObjLimit = ObjLimitInit
+	}
+	cltCfg.AdapterLimit = c.GetInt("/tars/application/client<adapter-limit>")
+	if cltCfg.AdapterLimit <= 0 {
+		cltCfg.AdapterLimit = AdapterLimitInit
+	}
+	if cMap["disable-limit"] == "Y" {
+		cltCfg.DisableLimit = true
+	}
 	serList = c.GetDomain("/tars/application/server")
 
 	for _, adapter := range serList {
 		endString := c.GetString("/tars/application/server/" + adapter + "<endpoint>")
-		end := endpoint.Parse(endString)
+		end, err := endpoint.Parse(endString)
+		if err != nil {
+			TLOG.Error("parse endpoint of adapter fail:", adapter, err)
+			continue
+		}
+		if !endpoint.IsSupported(end.Proto) {
+			TLOG.Error("unsupported endpoint protocol of adapter:", adapter, end.Proto)
+			continue
+		}
 		svrObj := c.GetString("/tars/application/server/" + adapter + "<servant>")
 		protocol := c.GetString("/tars/application/server/" + adapter + "<protocol>")
 		threads := c.GetInt("/tars/application/server/" + adapter + "<threads>")
@@ -137,7 +185,10 @@ func initConfig() {
 	}
 	TLOG.Debug("config add ", tarsConfig)
 	localString := c.GetString("/tars/application/server<local>")
-	localpoint := endpoint.Parse(localString)
+	localpoint, err := endpoint.Parse(localString)
+	if err != nil {
+		TLOG.Error("parse local endpoint fail:", err)
+	}
 
 	adminCfg := &transport.TarsServerConf{
 		Proto:          "tcp",
@@ -209,6 +260,9 @@ func Run() {
 		}(obj)
 	}
 	go reportNotifyInfo("restart")
+	if opts.Registry() != nil {
+		registerAdapters(opts.Registry())
+	}
 
 	for _, fn := range opts.AfterStart {
 		if err := fn(); err != nil {
@@ -241,6 +295,9 @@ func mainloop() {
 			reportNotifyInfo("stop")
 
 			opts := getOptions()
+			if opts.Registry() != nil {
+				deregisterAdapters(opts.Registry())
+			}
 			for _, fn := range opts.AfterStop {
 				if err := fn(); err != nil {
 					if err != nil {
@@ -0,0 +1,25 @@
+package tars
+
+import "testing"
+
+func TestConfigArg(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{nil, ""},
+		{[]string{"-config", "app.conf"}, "app.conf"},
+		{[]string{"--config", "app.conf"}, "app.conf"},
+		{[]string{"-config=app.conf"}, "app.conf"},
+		{[]string{"--config=app.conf"}, "app.conf"},
+		{[]string{"-test.v", "-port", "8080", "-config", "app.conf"}, "app.conf"},
+		{[]string{"-config"}, ""},
+		{[]string{"--", "-config", "app.conf"}, ""},
+		{[]string{"-configs", "app.conf"}, ""},
+	}
+	for _, tt := range tests {
+		if got := configArg(tt.args); got != tt.expected {
+			t.Errorf("configArg %v: expected %q, got %q", tt.args, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,602 @@
+// Package file provides a durable broker on local disk, the messages of every topic are appended to
+// a segmented write-ahead log and the queues keep their offsets in it.
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/TarsCloud/TarsGo/tars/broker"
+	"github.com/TarsCloud/TarsGo/tars/codec/json"
+)
+
+// fileEvent is a message delivered from the log.
+type fileEvent struct {
+	g       *group
+	topic   string
+	off     int64
+	message *broker.Message
+}
+
+// Topic returns the topic of the message.
+func (e *fileEvent) Topic() string {
+	return e.topic
+}
+
+// Message returns the broker message.
+func (e *fileEvent) Message() *broker.Message {
+	return e.message
+}
+
+// Ack acknowledges the message, the queue does not deliver it again.
+func (e *fileEvent) Ack() error {
+	e.g.ack(e.off)
+	return nil
+}
+
+// EventOffset returns the offset of the message in the log of the topic, to replay the messages
+// from it with StartOffset.
+func EventOffset(e broker.Event) (int64, bool) {
+	fe, ok := e.(*fileEvent)
+	if !ok {
+		return 0, false
+	}
+	return fe.off, true
+}
+
+// pending is a message delivered and not acked.
+type pending struct {
+	off  int64
+	data []byte
+	// sub is the subscriber handling the message, or nil if it waits for the redelivery
+	sub *fileSubscriber
+	// deadline is the time the message is delivered again
+	deadline time.Time
+}
+
+// group is the subscribers of a queue, or a subscriber without a queue, reading a topic.
+type group struct {
+	sync.Mutex
+	b       *fileBroker
+	topic   string
+	log     *topicLog
+	reader  *reader
+	offsets *os.File
+	// committed is the offset all the messages before are acked
+	committed int64
+	pending   map[int64]*pending
+	subs      []*fileSubscriber
+	// wake is closed and replaced after an ack, a failure or an unsubscription
+	wake chan struct{}
+}
+
+func (g *group) signal() {
+	close(g.wake)
+	g.wake = make(chan struct{})
+}
+
+// commit saves the offset of the queue if it is moved.
+func (g *group) commit() {
+	low := g.reader.off
+	for off := range g.pending {
+		if off < low {
+			low = off
+		}
+	}
+	if low == g.committed {
+		return
+	}
+	g.committed = low
+	if g.offsets == nil {
+		return
+	}
+	g.offsets.WriteAt([]byte(fmt.Sprintf("%020d\n", low)), 0)
+	if g.b.bopts.sync {
+		g.offsets.Sync()
+	}
+}
+
+// event returns the event of the message for the subscriber, the message is decoded for every
+// delivery so the handlers do not share it.
+func (g *group) event(p *pending) (*fileEvent, error) {
+	var m broker.Message
+	if err := g.b.opts.Codec.Unmarshal(p.data, &m); err != nil {
+		return nil, err
+	}
+	return &fileEvent{g: g, topic: g.topic, off: p.off, message: &m}, nil
+}
+
+// next returns the message for the subscriber to handle, the ones to deliver again first, or nil
+// after the subscriber is unsubscribed.
+func (g *group) next(s *fileSubscriber) *fileEvent {
+	g.Lock()
+	defer g.Unlock()
+	for {
+		if s.stopped() {
+			return nil
+		}
+
+		now := time.Now()
+		var due *pending
+		var wait time.Time
+		for _, p := range g.pending {
+			if !p.deadline.After(now) {
+				if due == nil || p.off < due.off {
+					due = p
+				}
+			} else if wait.IsZero() || p.deadline.Before(wait) {
+				wait = p.deadline
+			}
+		}
+		if due != nil {
+			due.sub = s
+			due.deadline = now.Add(g.b.bopts.ackTimeout)
+			e, err := g.event(due)
+			if err == nil {
+				return e
+			}
+			delete(g.pending, due.off)
+			g.commit()
+			continue
+		}
+
+		// notify stays nil while the queue is full, the acks wake it up
+		var notify <-chan struct{}
+		if len(g.pending) < g.b.bopts.maxInFlight {
+			var off int64
+			var data []byte
+			var err error
+			off, data, notify, err = g.reader.read()
+			if err == nil {
+				p := &pending{off: off, data: data, sub: s, deadline: now.Add(g.b.bopts.ackTimeout)}
+				e, err := g.event(p)
+				if err != nil {
+					// never decodable, skip it instead of delivering it again and again
+					g.commit()
+					continue
+				}
+				g.pending[off] = p
+				return e
+			}
+			if err != errEnd && (wait.IsZero() || now.Add(time.Second).Before(wait)) {
+				// retry reading the log after a while
+				wait = now.Add(time.Second)
+			}
+		}
+
+		wake := g.wake
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if !wait.IsZero() {
+			timer = time.NewTimer(wait.Sub(now))
+			timeout = timer.C
+		}
+		g.Unlock()
+		select {
+		case <-notify:
+		case <-wake:
+		case <-s.exit:
+		case <-timeout:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		g.Lock()
+	}
+}
+
+func (g *group) ack(off int64) {
+	g.Lock()
+	defer g.Unlock()
+	if _, ok := g.pending[off]; !ok {
+		return
+	}
+	delete(g.pending, off)
+	g.commit()
+	g.signal()
+}
+
+// fail delivers the message again after the redelivery delay, unless it is delivered to another
+// subscriber after the ack timeout.
+func (g *group) fail(off int64, s *fileSubscriber) {
+	g.Lock()
+	defer g.Unlock()
+	p, ok := g.pending[off]
+	if !ok || p.sub != s {
+		return
+	}
+	p.sub = nil
+	p.deadline = time.Now().Add(g.b.bopts.redeliveryDelay)
+	g.signal()
+}
+
+// remove removes the subscriber, the messages it is handling are delivered to the others at once.
+// It returns the number of the subscribers left.
+func (g *group) remove(s *fileSubscriber) int {
+	g.Lock()
+	defer g.Unlock()
+	for i, sub := range g.subs {
+		if sub == s {
+			g.subs = append(g.subs[:i], g.subs[i+1:]...)
+			break
+		}
+	}
+	now := time.Now()
+	for _, p := range g.pending {
+		if p.sub == s {
+			p.sub = nil
+			p.deadline = now
+		}
+	}
+	g.signal()
+	return len(g.subs)
+}
+
+func (g *group) close() {
+	g.Lock()
+	defer g.Unlock()
+	g.reader.close()
+	if g.offsets != nil {
+		g.offsets.Close()
+		g.offsets = nil
+	}
+}
+
+// fileSubscriber handles the messages of its group in a goroutine.
+type fileSubscriber struct {
+	b       *fileBroker
+	g       *group
+	key     groupKey
+	topic   string
+	handler broker.Handler
+	opts    broker.SubscribeOptions
+
+	once sync.Once
+	exit chan struct{}
+	done chan struct{}
+}
+
+// Options returns the subscriber options.
+func (s *fileSubscriber) Options() broker.SubscribeOptions {
+	return s.opts
+}
+
+// Topic returns the topic of the subscriber.
+func (s *fileSubscriber) Topic() string {
+	return s.topic
+}
+
+// Unsubscribe stops the subscriber and waits for the message being handled. The messages it has
+// not acked are delivered to the other subscribers of the queue, or after it subscribes again.
+func (s *fileSubscriber) Unsubscribe() error {
+	s.once.Do(func() {
+		close(s.exit)
+	})
+	<-s.done
+	s.b.unsubscribe(s)
+	return nil
+}
+
+func (s *fileSubscriber) stopped() bool {
+	select {
+	case <-s.exit:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *fileSubscriber) run() {
+	defer close(s.done)
+	for {
+		e := s.g.next(s)
+		if e == nil {
+			return
+		}
+		if err := s.handler(e); err != nil {
+			s.g.fail(e.off, s)
+			continue
+		}
+		if s.opts.AutoAck {
+			e.Ack()
+		}
+	}
+}
+
+// groupKey is the topic and the queue of a group, the subscribers without a queue have the id of
+// their own.
+type groupKey struct {
+	topic string
+	queue string
+	id    string
+}
+
+// fileBroker is the broker on the logs of the topics in a directory.
+type fileBroker struct {
+	sync.Mutex
+	opts      broker.Options
+	bopts     *brokerOptions
+	dir       string
+	connected bool
+	logs      map[string]*topicLog
+	groups    map[groupKey]*group
+}
+
+// String returns the name of the broker implementation.
+func (b *fileBroker) String() string {
+	return "file"
+}
+
+// Options returns the options defined for the broker.
+func (b *fileBroker) Options() broker.Options {
+	return b.opts
+}
+
+// Address returns the directory of the logs, it is set after Connect is called.
+func (b *fileBroker) Address() string {
+	return b.dir
+}
+
+// Init sets or overrides broker options.
+func (b *fileBroker) Init(opts ...broker.Option) error {
+	b.Lock()
+	defer b.Unlock()
+	if b.connected {
+		return errors.New("file: cannot init while connected")
+	}
+
+	for _, o := range opts {
+		o(&b.opts)
+	}
+
+	return nil
+}
+
+// Connect creates the directory of the logs, the first address of the options or DefaultDir.
+func (b *fileBroker) Connect() error {
+	b.Lock()
+	defer b.Unlock()
+	if b.connected {
+		return nil
+	}
+
+	dir := DefaultDir
+	if len(b.opts.Addrs) > 0 && b.opts.Addrs[0] != "" {
+		dir = b.opts.Addrs[0]
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	b.dir = dir
+	b.connected = true
+
+	return nil
+}
+
+// Disconnect unsubscribes the subscribers and closes the logs.
+func (b *fileBroker) Disconnect() error {
+	b.Lock()
+	if !b.connected {
+		b.Unlock()
+		return nil
+	}
+	var subs []*fileSubscriber
+	for _, g := range b.groups {
+		g.Lock()
+		subs = append(subs, g.subs...)
+		g.Unlock()
+	}
+	b.Unlock()
+
+	for _, s := range subs {
+		s.Unsubscribe()
+	}
+
+	b.Lock()
+	defer b.Unlock()
+	var err error
+	for name, l := range b.logs {
+		if e := l.close(); e != nil {
+			err = e
+		}
+		delete(b.logs, name)
+	}
+	b.connected = false
+	b.dir = ""
+	return err
+}
+
+// topicDir returns the directory of the log of the topic.
+func (b *fileBroker) topicDir(topic string) (string, error) {
+	if topic == "" || topic == "." || topic == ".." {
+		return "", fmt.Errorf("file: invalid topic %q", topic)
+	}
+	return filepath.Join(b.dir, url.PathEscape(topic)), nil
+}
+
+// topicLog opens the log of the topic, b must be locked.
+func (b *fileBroker) topicLog(topic string) (*topicLog, error) {
+	if !b.connected {
+		return nil, errors.New("file: not connected")
+	}
+	if l, ok := b.logs[topic]; ok {
+		return l, nil
+	}
+	dir, err := b.topicDir(topic)
+	if err != nil {
+		return nil, err
+	}
+	l, err := openLog(dir, b.bopts)
+	if err != nil {
+		return nil, err
+	}
+	b.logs[topic] = l
+	return l, nil
+}
+
+// Publish appends the message to the log of the topic, it is synced to the disk unless NoSync is set.
+func (b *fileBroker) Publish(topic string, msg *broker.Message, opts ...broker.PublishOption) error {
+	b.Lock()
+	l, err := b.topicLog(topic)
+	b.Unlock()
+	if err != nil {
+		return err
+	}
+
+	data, err := b.opts.Codec.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = l.append(data)
+	return err
+}
+
+// newGroup creates the group of the key, the queues start from the offset they committed, and the
+// others from the end of the log. b must be locked.
+func (b *fileBroker) newGroup(key groupKey, l *topicLog, options broker.SubscribeOptions) (*group, error) {
+	g := &group{
+		b:       b,
+		topic:   key.topic,
+		log:     l,
+		pending: make(map[int64]*pending),
+		wake:    make(chan struct{}),
+	}
+	start, found := l.next, false
+	if key.queue != "" {
+		dir := filepath.Join(l.dir, "queues")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+		f, err := os.OpenFile(filepath.Join(dir, url.PathEscape(key.queue)+".offset"), os.O_RDWR|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, err
+		}
+		data, err := ioutil.ReadAll(f)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		if off, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64); err == nil {
+			start, found = off, true
+		}
+		g.offsets = f
+	}
+	if options.Context != nil {
+		if off, ok := options.Context.Value(startOffsetKey{}).(int64); ok {
+			start, found = off, false
+		}
+	}
+
+	g.reader = newReader(l, start)
+	g.committed = -1
+	if found {
+		g.committed = g.reader.off
+	}
+	g.commit()
+	return g, nil
+}
+
+// Subscribe delivers the messages of the topic to the handler in a goroutine. The subscribers with
+// the same queue share the offset of the queue, each message is delivered to one of them, and the
+// messages published while all of them are down are delivered after they subscribe again. The
+// subscribers without a queue receive the messages published after they subscribe.
+//
+// The messages are acked after the handler succeeds, or by Event.Ack with broker.DisableAutoAck.
+// The ones the handler fails on are delivered again after the redelivery delay, and the ones not
+// acked after the ack timeout.
+func (b *fileBroker) Subscribe(topic string, handler broker.Handler, opts ...broker.SubscribeOption) (broker.Subscriber, error) {
+	options := broker.NewSubscribeOptions(opts...)
+
+	b.Lock()
+	defer b.Unlock()
+	l, err := b.topicLog(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	key := groupKey{topic: topic, queue: options.Queue}
+	if options.Queue == "" {
+		key.id = uuid.New().String()
+	}
+	g, ok := b.groups[key]
+	if !ok {
+		if g, err = b.newGroup(key, l, options); err != nil {
+			return nil, err
+		}
+		b.groups[key] = g
+	}
+
+	s := &fileSubscriber{
+		b:       b,
+		g:       g,
+		key:     key,
+		topic:   topic,
+		handler: handler,
+		opts:    options,
+		exit:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	g.Lock()
+	g.subs = append(g.subs, s)
+	g.Unlock()
+
+	go s.run()
+
+	return s, nil
+}
+
+// unsubscribe removes the subscriber, and closes its group after the last subscriber leaves.
+func (b *fileBroker) unsubscribe(s *fileSubscriber) {
+	b.Lock()
+	defer b.Unlock()
+	if b.groups[s.key] != s.g {
+		return
+	}
+	if s.g.remove(s) == 0 {
+		s.g.close()
+		delete(b.groups, s.key)
+	}
+}
+
+// NewBroker returns a new broker writing the messages to the logs on the local disk.
+func NewBroker(opts ...broker.Option) broker.Broker {
+	bopts := &brokerOptions{
+		segmentSize:     DefaultSegmentSize,
+		retention:       DefaultRetention,
+		sync:            true,
+		ackTimeout:      DefaultAckTimeout,
+		redeliveryDelay: DefaultRedeliveryDelay,
+		maxInFlight:     DefaultMaxInFlight,
+	}
+
+	options := broker.Options{
+		Codec:   json.Marshaler{},
+		Context: context.WithValue(context.Background(), optionsKey, bopts),
+	}
+
+	for _, o := range opts {
+		o(&options)
+	}
+
+	if bopts.maxInFlight < 1 {
+		bopts.maxInFlight = 1
+	}
+
+	return &fileBroker{
+		opts:   options,
+		bopts:  bopts,
+		logs:   make(map[string]*topicLog),
+		groups: make(map[groupKey]*group),
+	}
+}
@@ -0,0 +1,306 @@
+package file
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/broker"
+)
+
+func newTestBroker(t *testing.T, dir string, opts ...broker.Option) broker.Broker {
+	b := NewBroker(append([]broker.Option{broker.Addrs(dir), NoSync()}, opts...)...)
+	if err := b.Connect(); err != nil {
+		t.Fatalf("Unexpected connect error %v", err)
+	}
+	return b
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "tars-broker-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func publishN(t *testing.T, b broker.Broker, topic string, from, to int) {
+	for i := from; i < to; i++ {
+		if err := b.Publish(topic, &broker.Message{
+			Header: map[string]string{"id": fmt.Sprintf("%d", i)},
+			Body:   []byte(fmt.Sprintf("hello %d", i)),
+		}); err != nil {
+			t.Fatalf("Unexpected error publishing %d: %v", i, err)
+		}
+	}
+}
+
+// collect receives n messages from the channel.
+func collect(t *testing.T, ch <-chan string, n int) []string {
+	var got []string
+	for len(got) < n {
+		select {
+		case m := <-ch:
+			got = append(got, m)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Expected %d messages, got %v", n, got)
+		}
+	}
+	sort.Strings(got)
+	return got
+}
+
+func expectNone(t *testing.T, ch <-chan string) {
+	select {
+	case m := <-ch:
+		t.Fatalf("Unexpected message %s", m)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func ids(from, to int) []string {
+	var s []string
+	for i := from; i < to; i++ {
+		s = append(s, fmt.Sprintf("%d", i))
+	}
+	sort.Strings(s)
+	return s
+}
+
+func TestFileBroker(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	b := newTestBroker(t, dir)
+	defer b.Disconnect()
+
+	all := make(chan string, 100)
+	queue := make(chan string, 100)
+	var mu sync.Mutex
+	counts := map[string]int{}
+	handler := func(name string) broker.Handler {
+		return func(e broker.Event) error {
+			mu.Lock()
+			counts[name]++
+			mu.Unlock()
+			queue <- e.Message().Header["id"]
+			return nil
+		}
+	}
+
+	if _, err := b.Subscribe("test", func(e broker.Event) error {
+		all <- e.Message().Header["id"]
+		return nil
+	}); err != nil {
+		t.Fatalf("Unexpected error subscribing %v", err)
+	}
+	for _, name := range []string{"q1", "q2"} {
+		if _, err := b.Subscribe("test", handler(name), broker.Queue("workers")); err != nil {
+			t.Fatalf("Unexpected error subscribing %v", err)
+		}
+	}
+
+	publishN(t, b, "test", 0, 20)
+	if got := collect(t, all, 20); !reflect.DeepEqual(got, ids(0, 20)) {
+		t.Fatalf("Expected all the messages, got %v", got)
+	}
+	if got := collect(t, queue, 20); !reflect.DeepEqual(got, ids(0, 20)) {
+		t.Fatalf("Expected every message once in the queue, got %v", got)
+	}
+	expectNone(t, queue)
+}
+
+func TestDurableQueue(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	b := newTestBroker(t, dir)
+
+	got := make(chan string, 100)
+	s, err := b.Subscribe("test", func(e broker.Event) error {
+		id := e.Message().Header["id"]
+		if id != "3" {
+			e.Ack()
+		}
+		got <- id
+		return nil
+	}, broker.Queue("workers"), broker.DisableAutoAck())
+	if err != nil {
+		t.Fatalf("Unexpected error subscribing %v", err)
+	}
+	publishN(t, b, "test", 0, 5)
+	collect(t, got, 5)
+	if err := s.Unsubscribe(); err != nil {
+		t.Fatalf("Unexpected error unsubscribing %v", err)
+	}
+
+	// published while the queue is down
+	publishN(t, b, "test", 5, 8)
+	if err := b.Disconnect(); err != nil {
+		t.Fatalf("Unexpected disconnect error %v", err)
+	}
+
+	b = newTestBroker(t, dir)
+	defer b.Disconnect()
+	if _, err := b.Subscribe("test", func(e broker.Event) error {
+		got <- e.Message().Header["id"]
+		return nil
+	}, broker.Queue("workers")); err != nil {
+		t.Fatalf("Unexpected error subscribing %v", err)
+	}
+	// the messages from the first one not acked are delivered again
+	if m := collect(t, got, 5); !reflect.DeepEqual(m, []string{"3", "4", "5", "6", "7"}) {
+		t.Fatalf("Unexpected messages after the restart %v", m)
+	}
+	expectNone(t, got)
+}
+
+func TestRedelivery(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	b := newTestBroker(t, dir, RedeliveryDelay(10*time.Millisecond), AckTimeout(100*time.Millisecond))
+	defer b.Disconnect()
+
+	got := make(chan string, 100)
+	failed := false
+	if _, err := b.Subscribe("test", func(e broker.Event) error {
+		got <- e.Message().Header["id"]
+		if !failed {
+			failed = true
+			return fmt.Errorf("failed")
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("Unexpected error subscribing %v", err)
+	}
+	publishN(t, b, "test", 0, 1)
+	if m := collect(t, got, 2); !reflect.DeepEqual(m, []string{"0", "0"}) {
+		t.Fatalf("Expected the failed message again, got %v", m)
+	}
+
+	// not acked in the ack timeout
+	acked := make(chan string, 100)
+	if _, err := b.Subscribe("test", func(e broker.Event) error {
+		acked <- e.Message().Header["id"]
+		return nil
+	}, broker.DisableAutoAck()); err != nil {
+		t.Fatalf("Unexpected error subscribing %v", err)
+	}
+	publishN(t, b, "test", 1, 2)
+	if m := collect(t, acked, 2); !reflect.DeepEqual(m, []string{"1", "1"}) {
+		t.Fatalf("Expected the message not acked again, got %v", m)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	b := newTestBroker(t, dir)
+	defer b.Disconnect()
+
+	publishN(t, b, "test", 0, 10)
+
+	got := make(chan string, 100)
+	offsets := make(chan int64, 100)
+	s, err := b.Subscribe("test", func(e broker.Event) error {
+		off, ok := EventOffset(e)
+		if !ok {
+			t.Error("Expected the offset of the event")
+		}
+		offsets <- off
+		got <- e.Message().Header["id"]
+		return nil
+	}, StartOffset(0))
+	if err != nil {
+		t.Fatalf("Unexpected error subscribing %v", err)
+	}
+	if m := collect(t, got, 10); !reflect.DeepEqual(m, ids(0, 10)) {
+		t.Fatalf("Unexpected replay %v", m)
+	}
+	for i := int64(0); i < 10; i++ {
+		if off := <-offsets; off != i {
+			t.Fatalf("Expected offset %d, got %d", i, off)
+		}
+	}
+	s.Unsubscribe()
+
+	if _, err := b.Subscribe("test", func(e broker.Event) error {
+		got <- e.Message().Header["id"]
+		return nil
+	}, broker.Queue("workers"), StartOffset(7)); err != nil {
+		t.Fatalf("Unexpected error subscribing %v", err)
+	}
+	if m := collect(t, got, 3); !reflect.DeepEqual(m, ids(7, 10)) {
+		t.Fatalf("Unexpected replay from offset 7 %v", m)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	b := newTestBroker(t, dir, SegmentSize(256))
+	publishN(t, b, "test", 0, 20)
+	b.Disconnect()
+
+	segments, _ := filepath.Glob(filepath.Join(dir, "test", "*.log"))
+	if len(segments) < 2 {
+		t.Fatalf("Expected the log to be rolled, got %v", segments)
+	}
+	// a partial record written by a crash
+	f, err := os.OpenFile(segments[len(segments)-1], os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{0, 0, 0, 100, 1, 2})
+	f.Close()
+
+	b = newTestBroker(t, dir, SegmentSize(256))
+	defer b.Disconnect()
+	publishN(t, b, "test", 20, 25)
+
+	got := make(chan string, 100)
+	if _, err := b.Subscribe("test", func(e broker.Event) error {
+		got <- e.Message().Header["id"]
+		return nil
+	}, StartOffset(0)); err != nil {
+		t.Fatalf("Unexpected error subscribing %v", err)
+	}
+	if m := collect(t, got, 25); !reflect.DeepEqual(m, ids(0, 25)) {
+		t.Fatalf("Unexpected messages after the recovery %v", m)
+	}
+	expectNone(t, got)
+}
+
+func TestReadNotify(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	l, err := openLog(dir, &brokerOptions{segmentSize: DefaultSegmentSize})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.close()
+	r := newReader(l, 0)
+	defer r.close()
+
+	_, _, notify, err := r.read()
+	if err != errEnd {
+		t.Fatalf("Expected the end of the log, got %v", err)
+	}
+	// appended after the read saw the end and before the subscriber waits
+	if _, err := l.append([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-notify:
+	default:
+		t.Fatal("Expected the append to close the channel of the read")
+	}
+	off, data, _, err := r.read()
+	if err != nil || off != 0 || string(data) != "hello" {
+		t.Fatalf("Unexpected record %d %q %v", off, data, err)
+	}
+}
@@ -0,0 +1,335 @@
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// the record is the length and the CRC-32 of the payload, followed by the payload
+	recordHeader = 8
+	// maxRecordSize limits the payload, a larger length is a corrupted record
+	maxRecordSize = 256 << 20
+)
+
+var (
+	errCorrupt = errors.New("file: corrupted record")
+	errEnd     = errors.New("file: end of log")
+	errClosed  = errors.New("file: log closed")
+)
+
+// segment is a file of the log, named after the offset of its first record.
+type segment struct {
+	base int64
+	path string
+}
+
+func segmentName(base int64) string {
+	return fmt.Sprintf("%020d.log", base)
+}
+
+// topicLog is the write-ahead log of a topic, the records are appended to the last segment and
+// numbered by their offsets from 0.
+type topicLog struct {
+	sync.Mutex
+	dir      string
+	opts     *brokerOptions
+	segments []segment
+	f        *os.File
+	size     int64
+	next     int64
+	// notify is closed and replaced after every append
+	notify chan struct{}
+}
+
+// openLog opens the log in the dir, the partial record written by a crash is truncated.
+func openLog(dir string, opts *brokerOptions) (*topicLog, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	names, err := filepath.Glob(filepath.Join(dir, "*.log"))
+	if err != nil {
+		return nil, err
+	}
+
+	l := &topicLog{dir: dir, opts: opts, notify: make(chan struct{})}
+	for _, name := range names {
+		base, err := strconv.ParseInt(strings.TrimSuffix(filepath.Base(name), ".log"), 10, 64)
+		if err != nil {
+			continue
+		}
+		l.segments = append(l.segments, segment{base: base, path: name})
+	}
+	sort.Slice(l.segments, func(i, j int) bool {
+		return l.segments[i].base < l.segments[j].base
+	})
+	if len(l.segments) == 0 {
+		return l, l.roll(0)
+	}
+
+	last := l.segments[len(l.segments)-1]
+	count, size, err := recoverSegment(last.path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(last.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	l.f, l.size, l.next = f, size, last.base+count
+	l.retain()
+	return l, nil
+}
+
+// recoverSegment counts the records of the segment, and truncates the segment after the last
+// valid one.
+func recoverSegment(path string) (int64, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return 0, 0, err
+	}
+	r := bufio.NewReader(f)
+	var count, size int64
+	for {
+		data, err := readRecord(r)
+		if err != nil {
+			break
+		}
+		count++
+		size += int64(recordHeader + len(data))
+	}
+	f.Close()
+
+	if size != fi.Size() {
+		if err := os.Truncate(path, size); err != nil {
+			return 0, 0, err
+		}
+	}
+	return count, size, nil
+}
+
+func readRecord(r io.Reader) ([]byte, error) {
+	var h [recordHeader]byte
+	if _, err := io.ReadFull(r, h[:]); err != nil {
+		return nil, err
+	}
+	n := binary.BigEndian.Uint32(h[:4])
+	if n > maxRecordSize {
+		return nil, errCorrupt
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(h[4:]) {
+		return nil, errCorrupt
+	}
+	return data, nil
+}
+
+// roll starts a new segment at the base offset.
+func (l *topicLog) roll(base int64) error {
+	path := filepath.Join(l.dir, segmentName(base))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	if l.f != nil {
+		l.f.Close()
+	}
+	l.segments = append(l.segments, segment{base: base, path: path})
+	l.f, l.size = f, 0
+	l.retain()
+	return nil
+}
+
+// retain deletes the segments older than the retention, except the last one.
+func (l *topicLog) retain() {
+	if l.opts.retention <= 0 {
+		return
+	}
+	i := 0
+	for ; i < len(l.segments)-1; i++ {
+		fi, err := os.Stat(l.segments[i].path)
+		if err == nil && time.Since(fi.ModTime()) < l.opts.retention {
+			break
+		}
+		os.Remove(l.segments[i].path)
+	}
+	l.segments = l.segments[i:]
+}
+
+// append writes the payload to the log and returns its offset.
+func (l *topicLog) append(data []byte) (int64, error) {
+	if len(data) > maxRecordSize {
+		return 0, fmt.Errorf("file: message of %d bytes is too large", len(data))
+	}
+	buf := make([]byte, recordHeader+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	binary.BigEndian.PutUint32(buf[4:], crc32.ChecksumIEEE(data))
+	copy(buf[recordHeader:], data)
+
+	l.Lock()
+	defer l.Unlock()
+	if l.f == nil {
+		return 0, errClosed
+	}
+	if l.size > 0 && l.size+int64(len(buf)) > l.opts.segmentSize {
+		if l.opts.sync {
+			if err := l.f.Sync(); err != nil {
+				return 0, err
+			}
+		}
+		if err := l.roll(l.next); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := l.f.Write(buf); err != nil {
+		// drop the partial record, the next ones are written after it otherwise
+		l.f.Truncate(l.size)
+		return 0, err
+	}
+	if l.opts.sync {
+		if err := l.f.Sync(); err != nil {
+			return 0, err
+		}
+	}
+
+	off := l.next
+	l.size += int64(len(buf))
+	l.next++
+	close(l.notify)
+	l.notify = make(chan struct{})
+	return off, nil
+}
+
+// end returns the offset of the next record, and the channel closed when it is appended.
+func (l *topicLog) end() (int64, <-chan struct{}) {
+	l.Lock()
+	defer l.Unlock()
+	return l.next, l.notify
+}
+
+// locate returns the segment of the offset and the base of the segment after it, or -1 for the
+// last segment. The offset is moved into the range of the log.
+func (l *topicLog) locate(off int64) (int64, segment, int64) {
+	l.Lock()
+	defer l.Unlock()
+	if off < l.segments[0].base {
+		off = l.segments[0].base
+	}
+	if off > l.next {
+		off = l.next
+	}
+	i := sort.Search(len(l.segments), func(i int) bool {
+		return l.segments[i].base > off
+	}) - 1
+	end := int64(-1)
+	if i+1 < len(l.segments) {
+		end = l.segments[i+1].base
+	}
+	return off, l.segments[i], end
+}
+
+func (l *topicLog) close() error {
+	l.Lock()
+	defer l.Unlock()
+	if l.f == nil {
+		return nil
+	}
+	err := l.f.Close()
+	l.f = nil
+	return err
+}
+
+// reader reads the records of the log in order.
+type reader struct {
+	l   *topicLog
+	f   *os.File
+	r   *bufio.Reader
+	off int64
+	// end is the base of the next segment, or -1 in the last one
+	end int64
+}
+
+func newReader(l *topicLog, off int64) *reader {
+	r := &reader{l: l}
+	r.off, _, _ = l.locate(off)
+	return r
+}
+
+// open opens the segment of the offset, and skips the records before it.
+func (r *reader) open() error {
+	r.close()
+	off, seg, end := r.l.locate(r.off)
+	f, err := os.Open(seg.path)
+	if err != nil {
+		return err
+	}
+	br := bufio.NewReader(f)
+	for i := seg.base; i < off; i++ {
+		if _, err := readRecord(br); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	r.f, r.r, r.off, r.end = f, br, off, end
+	return nil
+}
+
+// read returns the next record and its offset, or errEnd if all are read. The channel is closed
+// when a record is appended after the end the read saw, so a reader waiting on it misses none.
+func (r *reader) read() (int64, []byte, <-chan struct{}, error) {
+	next, notify := r.l.end()
+	reopened := false
+	for {
+		if r.off >= next {
+			return 0, nil, notify, errEnd
+		}
+		if r.f == nil || (r.end >= 0 && r.off >= r.end) {
+			if err := r.open(); err != nil {
+				return 0, nil, notify, err
+			}
+			reopened = true
+		}
+		data, err := readRecord(r.r)
+		if err == nil {
+			off := r.off
+			r.off++
+			return off, data, notify, nil
+		}
+		r.close()
+		if r.end < 0 {
+			// the segment may be rolled after it is opened
+			if reopened {
+				return 0, nil, notify, err
+			}
+			continue
+		}
+		// the segment is corrupted, skip the rest of it
+		r.off = r.end
+	}
+}
+
+func (r *reader) close() {
+	if r.f != nil {
+		r.f.Close()
+		r.f, r.r = nil, nil
+	}
+}
@@ -0,0 +1,95 @@
+package file
+
+import (
+	"context"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/broker"
+)
+
+var (
+	// DefaultDir is the directory of the logs if the broker has no address
+	DefaultDir             = "broker"
+	DefaultSegmentSize     = int64(64 << 20)
+	DefaultRetention       = 7 * 24 * time.Hour
+	DefaultAckTimeout      = 30 * time.Second
+	DefaultRedeliveryDelay = time.Second
+	DefaultMaxInFlight     = 64
+
+	optionsKey = optionsKeyType{}
+)
+
+// options contain additional options for the broker.
+type brokerOptions struct {
+	segmentSize     int64
+	retention       time.Duration
+	sync            bool
+	ackTimeout      time.Duration
+	redeliveryDelay time.Duration
+	maxInFlight     int
+}
+
+type optionsKeyType struct{}
+
+type startOffsetKey struct{}
+
+// SegmentSize sets the size the segments of the logs are rolled at.
+func SegmentSize(n int64) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.segmentSize = n
+	}
+}
+
+// Retention sets how long the segments are kept after they are rolled, 0 keeps them forever.
+func Retention(d time.Duration) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.retention = d
+	}
+}
+
+// NoSync disables the fsync of the logs and the offsets after every write. The messages written
+// in the last seconds before the host crashes may be lost, but not the ones before a crash of the process.
+func NoSync() broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.sync = false
+	}
+}
+
+// AckTimeout sets how long a delivered message is waited for the ack, before it is delivered again.
+func AckTimeout(d time.Duration) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.ackTimeout = d
+	}
+}
+
+// RedeliveryDelay sets the delay before a message the handler failed on is delivered again.
+func RedeliveryDelay(d time.Duration) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.redeliveryDelay = d
+	}
+}
+
+// MaxInFlight sets the max number of the messages delivered and not acked of a queue.
+func MaxInFlight(n int) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.maxInFlight = n
+	}
+}
+
+// StartOffset makes the subscription read the topic from the offset, to replay the messages.
+// With a queue, the offset of the queue is reset when the first subscriber of it in the process
+// subscribes. The offsets before the oldest segment start from the oldest message kept.
+func StartOffset(off int64) broker.SubscribeOption {
+	return func(o *broker.SubscribeOptions) {
+		if o.Context == nil {
+			o.Context = context.Background()
+		}
+		o.Context = context.WithValue(o.Context, startOffsetKey{}, off)
+	}
+}
@@ -2,7 +2,9 @@
 package memory
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"sync"
 	"time"
@@ -13,6 +15,22 @@ import (
 	mnet "github.com/TarsCloud/TarsGo/tars/transport"
 )
 
+var (
+	// DefaultBufferSize is the number of the messages a subscriber buffers by default.
+	DefaultBufferSize = 1024
+	// DefaultPublishTimeout is how long Publish waits for the full buffers of the subscribers,
+	// before the message is dropped for them.
+	DefaultPublishTimeout = time.Second
+
+	// ErrBufferFull is returned by Publish if a subscriber, or all the subscribers of a queue,
+	// still have a full buffer after the publish timeout. The others receive the message.
+	ErrBufferFull = errors.New("memory: subscriber buffer is full, message dropped")
+
+	errStopped = errors.New("memory: subscriber stopped")
+)
+
+type bufferSizeKey struct{}
+
 type memoryBroker struct {
 	opts broker.Options
 
@@ -31,10 +49,22 @@ type memorySubscriber struct {
 	id      string
 	topic   string
 	exit    chan bool
+	once    sync.Once
+	events  chan *memoryEvent
 	handler broker.Handler
 	opts    broker.SubscribeOptions
 }
 
+// BufferSize sets the number of the messages the subscriber buffers.
+func BufferSize(n int) broker.SubscribeOption {
+	return func(o *broker.SubscribeOptions) {
+		if o.Context == nil {
+			o.Context = context.Background()
+		}
+		o.Context = context.WithValue(o.Context, bufferSizeKey{}, n)
+	}
+}
+
 func (m *memoryBroker) Options() broker.Options {
 	return m.opts
 }
@@ -73,6 +103,12 @@ func (m *memoryBroker) Disconnect() error {
 		return nil
 	}
 
+	for _, subs := range m.Subscribers {
+		for _, sub := range subs {
+			sub.stop()
+		}
+	}
+	m.Subscribers = make(map[string][]*memorySubscriber)
 	m.connected = false
 
 	return nil
@@ -92,24 +128,70 @@ func (m *memoryBroker) Publish(topic string, message *broker.Message, opts ...br
 		return errors.New("not connected")
 	}
 
-	subs, ok := m.Subscribers[topic]
+	subs := m.Subscribers[topic]
 	m.RUnlock()
-	if !ok {
-		return nil
-	}
 
-	p := &memoryEvent{
-		topic:   topic,
-		message: message,
+	// the full buffers are waited for at most the publish timeout in total, the deadline stays
+	// closed after it passes, so the full buffers after it are given up at once
+	var deadline <-chan struct{}
+	var cancel context.CancelFunc
+	wait := func() <-chan struct{} {
+		if deadline == nil {
+			var ctx context.Context
+			ctx, cancel = context.WithTimeout(context.Background(), DefaultPublishTimeout)
+			deadline = ctx.Done()
+		}
+		return deadline
 	}
+	defer func() {
+		if cancel != nil {
+			cancel()
+		}
+	}()
 
+	// every subscriber without a queue receives the message, and one subscriber of every queue
+	var full []*memorySubscriber
+	queues := make(map[string][]*memorySubscriber)
 	for _, sub := range subs {
-		if err := sub.handler(p); err != nil {
-			return err
+		if q := sub.opts.Queue; len(q) > 0 {
+			queues[q] = append(queues[q], sub)
+			continue
+		}
+		if sub.deliver(topic, message, nil) == ErrBufferFull {
+			full = append(full, sub)
 		}
 	}
 
-	return nil
+	var dropped error
+	for _, sub := range full {
+		if sub.deliver(topic, message, wait()) == ErrBufferFull {
+			dropped = ErrBufferFull
+		}
+	}
+
+	for _, members := range queues {
+		// start from a random member, skip the ones with a full buffer, and wait for the first
+		// full one if all of them are
+		i := rand.Intn(len(members))
+		var first *memorySubscriber
+		delivered := false
+		for n := 0; n < len(members); n++ {
+			sub := members[(i+n)%len(members)]
+			err := sub.deliver(topic, message, nil)
+			if err == nil {
+				delivered = true
+				break
+			}
+			if err == ErrBufferFull && first == nil {
+				first = sub
+			}
+		}
+		if !delivered && first != nil && first.deliver(topic, message, wait()) == ErrBufferFull {
+			dropped = ErrBufferFull
+		}
+	}
+
+	return dropped
 }
 
 func (m *memoryBroker) Subscribe(topic string, handler broker.Handler, opts ...broker.SubscribeOption) (broker.Subscriber, error) {
@@ -120,13 +202,18 @@ func (m *memoryBroker) Subscribe(topic string, handler broker.Handler, opts ...b
 	}
 	m.RUnlock()
 
-	var options broker.SubscribeOptions
-	for _, o := range opts {
-		o(&options)
+	options := broker.NewSubscribeOptions(opts...)
+
+	size := DefaultBufferSize
+	if options.Context != nil {
+		if n, ok := options.Context.Value(bufferSizeKey{}).(int); ok && n > 0 {
+			size = n
+		}
 	}
 
 	sub := &memorySubscriber{
-		exit:    make(chan bool, 1),
+		exit:    make(chan bool),
+		events:  make(chan *memoryEvent, size),
 		id:      uuid.New().String(),
 		topic:   topic,
 		handler: handler,
@@ -137,6 +224,8 @@ func (m *memoryBroker) Subscribe(topic string, handler broker.Handler, opts ...b
 	m.Subscribers[topic] = append(m.Subscribers[topic], sub)
 	m.Unlock()
 
+	go sub.run()
+
 	go func() {
 		<-sub.exit
 		m.Lock()
@@ -179,10 +268,74 @@ func (m *memorySubscriber) Topic() string {
 }
 
 func (m *memorySubscriber) Unsubscribe() error {
-	m.exit <- true
+	m.stop()
 	return nil
 }
 
+func (m *memorySubscriber) stop() {
+	m.once.Do(func() {
+		close(m.exit)
+	})
+}
+
+// deliver buffers a copy of the message, so the handlers running at the same time do not share
+// the header. A full buffer is waited for till the timeout is closed, and ErrBufferFull is returned
+// after it or at once for a nil timeout.
+func (m *memorySubscriber) deliver(topic string, message *broker.Message, timeout <-chan struct{}) error {
+	header := make(map[string]string, len(message.Header))
+	for k, v := range message.Header {
+		header[k] = v
+	}
+	e := &memoryEvent{
+		topic:   topic,
+		message: &broker.Message{Header: header, Body: message.Body},
+	}
+
+	select {
+	case <-m.exit:
+		return errStopped
+	default:
+	}
+	if timeout == nil {
+		select {
+		case m.events <- e:
+			return nil
+		default:
+			return ErrBufferFull
+		}
+	}
+	select {
+	case m.events <- e:
+		return nil
+	case <-m.exit:
+		return errStopped
+	case <-timeout:
+		return ErrBufferFull
+	}
+}
+
+// run calls the handler with the buffered messages till the subscriber is stopped, the errors and
+// the panics of the handler do not affect the other subscribers.
+func (m *memorySubscriber) run() {
+	for {
+		select {
+		case <-m.exit:
+			return
+		case e := <-m.events:
+			m.handle(e)
+		}
+	}
+}
+
+func (m *memorySubscriber) handle(e *memoryEvent) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("memory: handler of %s panic: %v", e.topic, r)
+		}
+	}()
+	return m.handler(e)
+}
+
 func NewBroker(opts ...broker.Option) broker.Broker {
 	var options broker.Options
 	rand.Seed(time.Now().UnixNano())
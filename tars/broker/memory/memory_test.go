@@ -1,8 +1,11 @@
 package memory
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/TarsCloud/TarsGo/tars/broker"
 )
@@ -48,3 +51,191 @@ func TestMemoryBroker(t *testing.T) {
 		t.Fatalf("Unexpected connect error %v", err)
 	}
 }
+
+func TestMemoryBrokerQueue(t *testing.T) {
+	b := NewBroker()
+
+	if err := b.Connect(); err != nil {
+		t.Fatalf("Unexpected connect error %v", err)
+	}
+	defer b.Disconnect()
+
+	topic := "test"
+	count := 100
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	received := make(map[string]int)
+	fn := func(name string) broker.Handler {
+		return func(p broker.Event) error {
+			mu.Lock()
+			received[name]++
+			mu.Unlock()
+			wg.Done()
+			return nil
+		}
+	}
+
+	subscribe := func(name string, opts ...broker.SubscribeOption) {
+		if _, err := b.Subscribe(topic, fn(name), opts...); err != nil {
+			t.Fatalf("Unexpected error subscribing %v", err)
+		}
+	}
+	subscribe("all")
+	subscribe("q1", broker.Queue("workers"))
+	subscribe("q2", broker.Queue("workers"))
+	subscribe("other", broker.Queue("others"))
+
+	wg.Add(count * 3)
+	for i := 0; i < count; i++ {
+		if err := b.Publish(topic, &broker.Message{Header: map[string]string{"id": fmt.Sprintf("%d", i)}}); err != nil {
+			t.Fatalf("Unexpected error publishing %d", i)
+		}
+	}
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if received["all"] != count || received["other"] != count {
+		t.Fatalf("Expected %d messages for every subscriber and queue, got %v", count, received)
+	}
+	if received["q1"]+received["q2"] != count {
+		t.Fatalf("Expected %d messages in the queue, got %v", count, received)
+	}
+}
+
+func TestMemoryBrokerAsync(t *testing.T) {
+	defer func(d time.Duration) { DefaultPublishTimeout = d }(DefaultPublishTimeout)
+	DefaultPublishTimeout = 10 * time.Millisecond
+	b := NewBroker()
+
+	if err := b.Connect(); err != nil {
+		t.Fatalf("Unexpected connect error %v", err)
+	}
+	defer b.Disconnect()
+
+	topic := "test"
+	release := make(chan struct{})
+	if _, err := b.Subscribe(topic, func(p broker.Event) error {
+		<-release
+		return errors.New("failed")
+	}, BufferSize(1)); err != nil {
+		t.Fatalf("Unexpected error subscribing %v", err)
+	}
+
+	received := make(chan *broker.Message, 10)
+	if _, err := b.Subscribe(topic, func(p broker.Event) error {
+		p.Message().Header["handled"] = "true"
+		received <- p.Message()
+		return nil
+	}); err != nil {
+		t.Fatalf("Unexpected error subscribing %v", err)
+	}
+
+	// the blocked handler and its full buffer do not block the publisher or the other subscribers,
+	// the publisher sees the message dropped for the full buffer
+	msg := &broker.Message{Header: map[string]string{"id": "1"}}
+	dropped := 0
+	for i := 0; i < 3; i++ {
+		if err := b.Publish(topic, msg); err == ErrBufferFull {
+			dropped++
+		} else if err != nil {
+			t.Fatalf("Unexpected error publishing %v", err)
+		}
+	}
+	if dropped == 0 {
+		t.Fatal("Expected ErrBufferFull for the full buffer")
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case m := <-received:
+			if m.Header["id"] != "1" {
+				t.Fatalf("Unexpected message %v", m.Header)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Expected the message for the other subscriber")
+		}
+	}
+	close(release)
+
+	if _, ok := msg.Header["handled"]; ok {
+		t.Fatal("The published message is changed by the handler")
+	}
+}
+
+func TestMemoryBrokerPublishTimeout(t *testing.T) {
+	defer func(d time.Duration) { DefaultPublishTimeout = d }(DefaultPublishTimeout)
+	DefaultPublishTimeout = 50 * time.Millisecond
+	b := NewBroker()
+
+	if err := b.Connect(); err != nil {
+		t.Fatalf("Unexpected connect error %v", err)
+	}
+	defer b.Disconnect()
+
+	topic := "test"
+	release := make(chan struct{})
+	defer close(release)
+	handler := func(p broker.Event) error {
+		<-release
+		return nil
+	}
+	// two subscribers and a queue, each with a blocked handler and a full buffer
+	for _, opts := range [][]broker.SubscribeOption{
+		{BufferSize(1)},
+		{BufferSize(1)},
+		{BufferSize(1), broker.Queue("q")},
+	} {
+		if _, err := b.Subscribe(topic, handler, opts...); err != nil {
+			t.Fatalf("Unexpected error subscribing %v", err)
+		}
+	}
+
+	msg := &broker.Message{Header: map[string]string{"id": "1"}}
+	for i := 0; i < 3; i++ {
+		done := make(chan error, 1)
+		start := time.Now()
+		go func() {
+			done <- b.Publish(topic, msg)
+		}()
+		select {
+		case <-done:
+		case <-time.After(10 * DefaultPublishTimeout):
+			t.Fatalf("Publish %d not returned after %v", i, time.Since(start))
+		}
+	}
+}
+
+func TestMemoryBrokerPanic(t *testing.T) {
+	b := NewBroker()
+
+	if err := b.Connect(); err != nil {
+		t.Fatalf("Unexpected connect error %v", err)
+	}
+	defer b.Disconnect()
+
+	received := make(chan string, 10)
+	if _, err := b.Subscribe("test", func(p broker.Event) error {
+		if p.Message().Header["id"] == "1" {
+			panic("bad message")
+		}
+		received <- p.Message().Header["id"]
+		return nil
+	}); err != nil {
+		t.Fatalf("Unexpected error subscribing %v", err)
+	}
+
+	for _, id := range []string{"1", "2"} {
+		if err := b.Publish("test", &broker.Message{Header: map[string]string{"id": id}}); err != nil {
+			t.Fatalf("Unexpected error publishing %v", err)
+		}
+	}
+	select {
+	case id := <-received:
+		if id != "2" {
+			t.Fatalf("Unexpected message %s", id)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the subscriber to survive the panic")
+	}
+}
@@ -14,6 +14,12 @@ var (
 	DefaultReadTimeout    = time.Duration(0)
 	DefaultWriteTimeout   = 5 * time.Second
 
+	// the defaults of the stream broker
+	DefaultStreamBlock     = 2 * time.Second
+	DefaultStreamBatch     = 16
+	DefaultStreamClaimIdle = 30 * time.Second
+	DefaultStreamGroupTTL  = time.Hour
+
 	optionsKey = optionsKeyType{}
 )
 
@@ -25,10 +31,33 @@ type brokerOptions struct {
 	connectTimeout time.Duration
 	readTimeout    time.Duration
 	writeTimeout   time.Duration
+
+	// the options of the stream broker
+	maxLen    int64
+	block     time.Duration
+	batch     int
+	claimIdle time.Duration
+	startID   string
+	// maxDeliveries limits the deliveries of a message if it is positive
+	maxDeliveries int
+	deadLetter    string
+	groupTTL      time.Duration
 }
 
 type optionsKeyType struct{}
 
+func newBrokerOptions() *brokerOptions {
+	return &brokerOptions{
+		maxIdle:        DefaultMaxIdle,
+		maxActive:      DefaultMaxActive,
+		idleTimeout:    DefaultIdleTimeout,
+		connectTimeout: DefaultConnectTimeout,
+		readTimeout:    DefaultReadTimeout,
+		writeTimeout:   DefaultWriteTimeout,
+		startID:        "$",
+	}
+}
+
 func ConnectTimeout(d time.Duration) broker.Option {
 	return func(o *broker.Options) {
 		bo := o.Context.Value(optionsKey).(*brokerOptions)
@@ -70,3 +99,66 @@ func IdleTimeout(d time.Duration) broker.Option {
 		bo.idleTimeout = d
 	}
 }
+
+// StreamMaxLen caps the streams of the stream broker to about n messages, the oldest ones are trimmed.
+func StreamMaxLen(n int64) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.maxLen = n
+	}
+}
+
+// StreamBlock sets how long the subscribers of the stream broker wait for the new messages in a read.
+func StreamBlock(d time.Duration) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.block = d
+	}
+}
+
+// StreamBatch sets the max number of the messages the subscribers of the stream broker read at once.
+func StreamBatch(n int) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.batch = n
+	}
+}
+
+// StreamClaimIdle sets how long a message is pending before the other consumers of the group claim it,
+// the message is delivered again after the consumer fails on it or dies.
+func StreamClaimIdle(d time.Duration) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.claimIdle = d
+	}
+}
+
+// StreamStartID sets the ID the new consumer groups start from, "$" for the new messages by default,
+// or "0" for all the messages in the stream.
+func StreamStartID(id string) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.startID = id
+	}
+}
+
+// StreamMaxDeliveries stops delivering a message of the stream broker again after it is delivered
+// n times. It is published to the deadLetter topic with the failure headers, like
+// broker.HeaderDeadLetterError, or dropped without a deadLetter topic, and acknowledged.
+func StreamMaxDeliveries(n int, deadLetter string) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.maxDeliveries = n
+		bo.deadLetter = deadLetter
+	}
+}
+
+// StreamGroupTTL sets how long the consumers of the group of a subscriber without a queue are idle
+// before the group is destroyed by the other subscribers of the topic, the group of a process
+// crashed before it unsubscribed. 0 keeps the groups.
+func StreamGroupTTL(d time.Duration) broker.Option {
+	return func(o *broker.Options) {
+		bo := o.Context.Value(optionsKey).(*brokerOptions)
+		bo.groupTTL = d
+	}
+}
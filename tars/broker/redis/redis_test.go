@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/TarsCloud/TarsGo/tars/broker"
 )
@@ -106,3 +107,87 @@ func TestBroker(t *testing.T) {
 		t.Fatalf("expected %v, got %v", exp, actual)
 	}
 }
+
+func TestStreamBroker(t *testing.T) {
+	url := os.Getenv("REDIS_URL")
+	if url == "" {
+		t.Skip("REDIS_URL not defined")
+	}
+
+	b := NewStreamBroker(broker.Addrs(url), StreamMaxLen(1000), StreamBlock(100*time.Millisecond), StreamClaimIdle(time.Second))
+	if err := b.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer b.Disconnect()
+
+	topic := fmt.Sprintf("test-stream-%d", time.Now().UnixNano())
+	msgs := make(chan string, 10)
+	events := make(chan broker.Event, 10)
+
+	s1, err := b.Subscribe(topic, func(p broker.Event) error {
+		msgs <- fmt.Sprintf("s1:%s", string(p.Message().Body))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The queue keeps the messages published before the second subscriber of the queue is up.
+	q1, err := b.Subscribe(topic, func(p broker.Event) error {
+		msgs <- fmt.Sprintf("q:%s", string(p.Message().Body))
+		return nil
+	}, broker.Queue("workers"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsubscribe(t, q1)
+
+	publish(t, b, topic, &broker.Message{Body: []byte("hello")})
+
+	q2, err := b.Subscribe(topic, func(p broker.Event) error {
+		events <- p
+		return nil
+	}, broker.Queue("workers"), broker.DisableAutoAck())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var actual []string
+	for len(actual) < 1 {
+		select {
+		case m := <-msgs:
+			actual = append(actual, m)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout, got %v", actual)
+		}
+	}
+	if !reflect.DeepEqual(actual, []string{"s1:hello"}) {
+		t.Fatalf("expected [s1:hello], got %v", actual)
+	}
+
+	// The message not acknowledged is delivered again after the claim idle time.
+	for i := 0; i < 2; i++ {
+		select {
+		case e := <-events:
+			if string(e.Message().Body) != "hello" {
+				t.Fatalf("expected hello, got %s", string(e.Message().Body))
+			}
+			if i == 1 {
+				if err := e.Ack(); err != nil {
+					t.Fatal(err)
+				}
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout on delivery %d", i+1)
+		}
+	}
+
+	select {
+	case e := <-events:
+		t.Fatalf("unexpected delivery of %s after the ack", string(e.Message().Body))
+	case <-time.After(2 * time.Second):
+	}
+
+	unsubscribe(t, q2)
+	unsubscribe(t, s1)
+}
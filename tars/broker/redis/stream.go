@@ -0,0 +1,560 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+
+	"github.com/TarsCloud/TarsGo/tars/broker"
+	"github.com/TarsCloud/TarsGo/tars/codec/json"
+)
+
+const (
+	// streamField is the field of the encoded message in the stream entries.
+	streamField = "msg"
+	// privateGroupPrefix is the prefix of the groups of the subscribers without a queue.
+	privateGroupPrefix = "tars-"
+)
+
+// streamEvent is a message read from a stream by a consumer group.
+type streamEvent struct {
+	pool    *redis.Pool
+	topic   string
+	group   string
+	id      string
+	message *broker.Message
+}
+
+// Topic returns the topic of the message.
+func (e *streamEvent) Topic() string {
+	return e.topic
+}
+
+// Message returns the broker message.
+func (e *streamEvent) Message() *broker.Message {
+	return e.message
+}
+
+// Ack acknowledges the message with XACK, the group does not deliver it again.
+func (e *streamEvent) Ack() error {
+	conn := e.pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("XACK", e.topic, e.group, e.id)
+	return err
+}
+
+// streamSubscriber is a consumer of a consumer group reading a stream.
+type streamSubscriber struct {
+	b        *streamBroker
+	topic    string
+	group    string
+	consumer string
+	// shared is set if the group is the queue of the subscribers, or the group is of this subscriber only.
+	shared bool
+	handle broker.Handler
+	opts   broker.SubscribeOptions
+	// handling is set while the handler runs
+	handling int32
+
+	once sync.Once
+	exit chan struct{}
+	done chan struct{}
+}
+
+// Options returns the subscriber options.
+func (s *streamSubscriber) Options() broker.SubscribeOptions {
+	return s.opts
+}
+
+// Topic returns the topic of the subscriber.
+func (s *streamSubscriber) Topic() string {
+	return s.topic
+}
+
+// Unsubscribe stops reading the stream and waits for the subscriber to stop. If the handler is
+// running, like when the handler itself unsubscribes, it returns at once and the subscriber stops
+// after the handler returns. The pending messages of the consumer are claimed by the other
+// consumers of the group.
+func (s *streamSubscriber) Unsubscribe() error {
+	s.once.Do(func() {
+		close(s.exit)
+	})
+	if atomic.LoadInt32(&s.handling) == 0 {
+		<-s.done
+	}
+	return nil
+}
+
+func (s *streamSubscriber) stopped() bool {
+	select {
+	case <-s.exit:
+		return true
+	default:
+		return false
+	}
+}
+
+// event returns the event of the entry.
+func (s *streamSubscriber) event(id string) *streamEvent {
+	return &streamEvent{pool: s.b.pool, topic: s.topic, group: s.group, id: id}
+}
+
+// parseEntry returns the id and the message of the entry of a XREADGROUP or XCLAIM reply. The
+// message is nil if the entry is trimmed from the stream or cannot be decoded, ok is false if the
+// entry has no id.
+func (s *streamSubscriber) parseEntry(entry interface{}) (id string, m *broker.Message, ok bool) {
+	values, err := redis.Values(entry, nil)
+	if err != nil || len(values) < 1 {
+		return "", nil, false
+	}
+	if id, err = redis.String(values[0], nil); err != nil {
+		return "", nil, false
+	}
+	var fields []interface{}
+	if len(values) > 1 {
+		fields, _ = redis.Values(values[1], nil)
+	}
+	var data []byte
+	for i := 0; i+1 < len(fields); i += 2 {
+		if k, _ := redis.String(fields[i], nil); k == streamField {
+			data, _ = redis.Bytes(fields[i+1], nil)
+		}
+	}
+	if data == nil {
+		return id, nil, true
+	}
+	m = new(broker.Message)
+	if s.b.opts.Codec.Unmarshal(data, m) != nil {
+		return id, nil, true
+	}
+	return id, m, true
+}
+
+// deliver handles the message of the entry, the messages failed stay pending till they are claimed.
+func (s *streamSubscriber) deliver(e *streamEvent) {
+	atomic.StoreInt32(&s.handling, 1)
+	err := s.handle(e)
+	atomic.StoreInt32(&s.handling, 0)
+	if err != nil {
+		return
+	}
+	if s.opts.AutoAck {
+		e.Ack()
+	}
+}
+
+// deliverEntries delivers the entries of the XREADGROUP or XCLAIM reply, the ones trimmed from the
+// stream or never decodable are acknowledged instead of delivered again and again.
+func (s *streamSubscriber) deliverEntries(entries []interface{}) {
+	for _, entry := range entries {
+		if s.stopped() {
+			return
+		}
+		id, m, ok := s.parseEntry(entry)
+		if !ok {
+			continue
+		}
+		e := s.event(id)
+		if m == nil {
+			e.Ack()
+			continue
+		}
+		e.message = m
+		s.deliver(e)
+	}
+}
+
+// deadLetter publishes the messages of the XCLAIM reply delivered the max times to the dead-letter
+// topic with the failure headers, or drops them without a dead-letter topic, and acknowledges them.
+func (s *streamSubscriber) deadLetter(entries []interface{}, deliveries map[string]int64) {
+	bo := s.b.bopts
+	for _, entry := range entries {
+		id, m, ok := s.parseEntry(entry)
+		if !ok {
+			continue
+		}
+		if m != nil && bo.deadLetter != "" {
+			header := make(map[string]string, len(m.Header)+4)
+			for k, v := range m.Header {
+				header[k] = v
+			}
+			header[broker.HeaderDeadLetterTopic] = s.topic
+			header[broker.HeaderDeadLetterError] = "redis: max deliveries reached"
+			header[broker.HeaderDeadLetterAttempts] = strconv.FormatInt(deliveries[id], 10)
+			header[broker.HeaderDeadLetterTime] = time.Now().Format(time.RFC3339Nano)
+			if err := s.b.Publish(bo.deadLetter, &broker.Message{Header: header, Body: m.Body}); err != nil {
+				// stays pending, and is dead-lettered again after the claim idle time
+				continue
+			}
+		}
+		s.event(id).Ack()
+	}
+}
+
+// claim delivers the messages pending longer than the claim idle time in the group, of the consumers
+// dead or failed on them. The messages delivered the max deliveries are dead-lettered instead.
+func (s *streamSubscriber) claim(conn redis.Conn) error {
+	bo := s.b.bopts
+	pending, err := redis.Values(conn.Do("XPENDING", s.topic, s.group, "-", "+", bo.batch))
+	if err != nil {
+		return err
+	}
+	args := []interface{}{s.topic, s.group, s.consumer, int64(bo.claimIdle / time.Millisecond)}
+	dead := []interface{}{s.topic, s.group, s.consumer, int64(bo.claimIdle / time.Millisecond)}
+	deliveries := make(map[string]int64)
+	for _, p := range pending {
+		// id, consumer, idle milliseconds and deliveries
+		v, err := redis.Values(p, nil)
+		if err != nil || len(v) < 3 {
+			continue
+		}
+		idle, _ := redis.Int64(v[2], nil)
+		if time.Duration(idle)*time.Millisecond < bo.claimIdle {
+			continue
+		}
+		var n int64
+		if len(v) > 3 {
+			n, _ = redis.Int64(v[3], nil)
+		}
+		if bo.maxDeliveries > 0 && n >= int64(bo.maxDeliveries) {
+			id, _ := redis.String(v[0], nil)
+			deliveries[id] = n
+			dead = append(dead, v[0])
+			continue
+		}
+		args = append(args, v[0])
+	}
+	if len(dead) > 4 {
+		entries, err := redis.Values(conn.Do("XCLAIM", dead...))
+		if err != nil {
+			return err
+		}
+		s.deadLetter(entries, deliveries)
+	}
+	if len(args) == 4 {
+		return nil
+	}
+	entries, err := redis.Values(conn.Do("XCLAIM", args...))
+	if err != nil {
+		return err
+	}
+	s.deliverEntries(entries)
+	return nil
+}
+
+// infoMap returns the fields of a XINFO reply.
+func infoMap(reply interface{}) map[string]interface{} {
+	values, _ := redis.Values(reply, nil)
+	m := make(map[string]interface{}, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		if k, err := redis.String(values[i], nil); err == nil {
+			m[k] = values[i+1]
+		}
+	}
+	return m
+}
+
+// reapGroups destroys the groups of the subscribers without a queue left by the processes crashed
+// before they unsubscribed, the consumers of the groups are idle longer than the group TTL.
+func (s *streamSubscriber) reapGroups(conn redis.Conn) {
+	groups, err := redis.Values(conn.Do("XINFO", "GROUPS", s.topic))
+	if err != nil {
+		return
+	}
+	for _, g := range groups {
+		name, _ := redis.String(infoMap(g)["name"], nil)
+		if !strings.HasPrefix(name, privateGroupPrefix) || name == s.group {
+			continue
+		}
+		consumers, err := redis.Values(conn.Do("XINFO", "CONSUMERS", s.topic, name))
+		if err != nil || len(consumers) == 0 {
+			// the consumer is created by the first read after the group
+			continue
+		}
+		orphan := true
+		for _, c := range consumers {
+			idle, err := redis.Int64(infoMap(c)["idle"], nil)
+			if err != nil || time.Duration(idle)*time.Millisecond < s.b.bopts.groupTTL {
+				orphan = false
+				break
+			}
+		}
+		if orphan {
+			conn.Do("XGROUP", "DESTROY", s.topic, name)
+		}
+	}
+}
+
+// read delivers the new messages of the group.
+func (s *streamSubscriber) read(conn redis.Conn) error {
+	bo := s.b.bopts
+	reply, err := redis.DoWithTimeout(conn, bo.block+time.Second, "XREADGROUP", "GROUP", s.group, s.consumer,
+		"COUNT", bo.batch, "BLOCK", int64(bo.block/time.Millisecond), "STREAMS", s.topic, ">")
+	if err != nil || reply == nil {
+		return err
+	}
+	streams, err := redis.Values(reply, nil)
+	if err != nil {
+		return err
+	}
+	for _, stream := range streams {
+		v, err := redis.Values(stream, nil)
+		if err != nil || len(v) < 2 {
+			continue
+		}
+		entries, _ := redis.Values(v[1], nil)
+		s.deliverEntries(entries)
+	}
+	return nil
+}
+
+// recv reads the stream till the subscriber is unsubscribed, and claims the pending messages
+// every half of the claim idle time.
+func (s *streamSubscriber) recv() {
+	defer close(s.done)
+	defer s.cleanup()
+
+	conn := s.b.pool.Get()
+	defer func() {
+		conn.Close()
+	}()
+	var lastClaim time.Time
+	for !s.stopped() {
+		var err error
+		if time.Since(lastClaim) >= s.b.bopts.claimIdle/2 {
+			err = s.claim(conn)
+			if err == nil && s.b.bopts.groupTTL > 0 {
+				s.reapGroups(conn)
+			}
+			lastClaim = time.Now()
+		}
+		if err == nil {
+			err = s.read(conn)
+		}
+		if err == nil {
+			continue
+		}
+		if strings.HasPrefix(err.Error(), "NOGROUP") {
+			// the stream or the group is deleted
+			s.b.createGroup(conn, s.topic, s.group, "0")
+		}
+		// reconnect after a while
+		conn.Close()
+		select {
+		case <-s.exit:
+			return
+		case <-time.After(time.Second):
+		}
+		conn = s.b.pool.Get()
+	}
+}
+
+// cleanup removes the subscriber from the broker, and deletes the group of the subscriber, or the
+// consumer from the shared group if it has no pending messages.
+func (s *streamSubscriber) cleanup() {
+	s.b.Lock()
+	delete(s.b.subs, s)
+	s.b.Unlock()
+
+	conn := s.b.pool.Get()
+	defer conn.Close()
+	if !s.shared {
+		conn.Do("XGROUP", "DESTROY", s.topic, s.group)
+		return
+	}
+	pending, err := redis.Values(conn.Do("XPENDING", s.topic, s.group, "-", "+", 1, s.consumer))
+	if err == nil && len(pending) == 0 {
+		conn.Do("XGROUP", "DELCONSUMER", s.topic, s.group, s.consumer)
+	}
+}
+
+// streamBroker is the broker on Redis Streams, the messages are kept in the streams of the topics
+// and read by consumer groups.
+type streamBroker struct {
+	sync.Mutex
+	addr  string
+	pool  *redis.Pool
+	opts  broker.Options
+	bopts *brokerOptions
+	subs  map[*streamSubscriber]bool
+}
+
+// String returns the name of the broker implementation.
+func (b *streamBroker) String() string {
+	return "redis-stream"
+}
+
+// Options returns the options defined for the broker.
+func (b *streamBroker) Options() broker.Options {
+	return b.opts
+}
+
+// Address returns the address the broker will use to create new connections.
+// This will be set only after Connect is called.
+func (b *streamBroker) Address() string {
+	return b.addr
+}
+
+// Init sets or overrides broker options.
+func (b *streamBroker) Init(opts ...broker.Option) error {
+	if b.pool != nil {
+		return errors.New("redis: cannot init while connected")
+	}
+
+	for _, o := range opts {
+		o(&b.opts)
+	}
+
+	return nil
+}
+
+// Connect creates the connection pool to Redis.
+func (b *streamBroker) Connect() error {
+	if b.pool != nil {
+		return nil
+	}
+
+	b.addr, b.pool = newPool(b.opts, b.bopts)
+
+	return nil
+}
+
+// Disconnect unsubscribes the subscribers and closes the connection pool.
+func (b *streamBroker) Disconnect() error {
+	if b.pool == nil {
+		return nil
+	}
+	b.Lock()
+	subs := make([]*streamSubscriber, 0, len(b.subs))
+	for s := range b.subs {
+		subs = append(subs, s)
+	}
+	b.Unlock()
+	for _, s := range subs {
+		s.Unsubscribe()
+	}
+
+	err := b.pool.Close()
+	b.pool = nil
+	b.addr = ""
+	return err
+}
+
+// Publish appends the message to the stream of the topic with XADD, the stream is trimmed to about
+// the max length if it is set.
+func (b *streamBroker) Publish(topic string, msg *broker.Message, opts ...broker.PublishOption) error {
+	if b.pool == nil {
+		return errors.New("redis: not connected")
+	}
+	v, err := b.opts.Codec.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	args := []interface{}{topic}
+	if b.bopts.maxLen > 0 {
+		args = append(args, "MAXLEN", "~", b.bopts.maxLen)
+	}
+	args = append(args, "*", streamField, v)
+
+	conn := b.pool.Get()
+	_, err = conn.Do("XADD", args...)
+	conn.Close()
+
+	return err
+}
+
+// createGroup creates the consumer group and the stream if they do not exist.
+func (b *streamBroker) createGroup(conn redis.Conn, topic string, group string, start string) error {
+	_, err := conn.Do("XGROUP", "CREATE", topic, group, start, "MKSTREAM")
+	if err != nil && strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return nil
+	}
+	return err
+}
+
+// Subscribe reads the stream of the topic by a consumer group. The subscribers with the same
+// queue share the group named after the queue, and each of them receives a subset of the messages,
+// the messages published while all of them are down are received later. The subscribers without a
+// queue have groups of their own, which receive the messages published while they are subscribed.
+// The groups left by the crashed processes are destroyed after the group TTL.
+//
+// The messages are acknowledged after the handler succeeds, or by Event.Ack with
+// broker.DisableAutoAck. The ones not acknowledged are delivered again after the claim idle time,
+// till they are delivered the max deliveries.
+func (b *streamBroker) Subscribe(topic string, handler broker.Handler, opts ...broker.SubscribeOption) (broker.Subscriber, error) {
+	if b.pool == nil {
+		return nil, errors.New("redis: not connected")
+	}
+	options := broker.NewSubscribeOptions(opts...)
+
+	s := &streamSubscriber{
+		b:        b,
+		topic:    topic,
+		group:    options.Queue,
+		consumer: uuid.New().String(),
+		shared:   options.Queue != "",
+		handle:   handler,
+		opts:     options,
+		exit:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	start := b.bopts.startID
+	if !s.shared {
+		s.group = privateGroupPrefix + s.consumer
+		start = "$"
+	}
+
+	conn := b.pool.Get()
+	err := b.createGroup(conn, topic, s.group, start)
+	conn.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	b.Lock()
+	b.subs[s] = true
+	b.Unlock()
+
+	// Run the receiver routine.
+	go s.recv()
+
+	return s, nil
+}
+
+// NewStreamBroker returns a new broker implemented with Redis Streams, XADD, XREADGROUP and XACK,
+// which keeps the messages till they are acknowledged. It requires Redis 5.0 or later.
+func NewStreamBroker(opts ...broker.Option) broker.Broker {
+	bopts := newBrokerOptions()
+	bopts.block = DefaultStreamBlock
+	bopts.batch = DefaultStreamBatch
+	bopts.claimIdle = DefaultStreamClaimIdle
+	bopts.groupTTL = DefaultStreamGroupTTL
+
+	options := broker.Options{
+		Codec:   json.Marshaler{},
+		Context: context.WithValue(context.Background(), optionsKey, bopts),
+	}
+
+	for _, o := range opts {
+		o(&options)
+	}
+
+	if bopts.block < time.Millisecond {
+		// BLOCK 0 blocks forever
+		bopts.block = time.Millisecond
+	}
+
+	return &streamBroker{
+		opts:  options,
+		bopts: bopts,
+		subs:  make(map[*streamSubscriber]bool),
+	}
+}
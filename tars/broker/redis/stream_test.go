@@ -0,0 +1,302 @@
+package redis
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"github.com/TarsCloud/TarsGo/tars/broker"
+)
+
+// fakeConn records the commands and replies with the reply function, for the stream broker
+// without Redis.
+type fakeConn struct {
+	mu    sync.Mutex
+	cmds  [][]interface{}
+	reply func(cmd string, args []interface{}) (interface{}, error)
+}
+
+func (c *fakeConn) Close() error { return nil }
+func (c *fakeConn) Err() error   { return nil }
+func (c *fakeConn) Flush() error { return nil }
+
+func (c *fakeConn) Send(cmd string, args ...interface{}) error {
+	_, err := c.Do(cmd, args...)
+	return err
+}
+
+func (c *fakeConn) Receive() (interface{}, error) {
+	return nil, nil
+}
+
+func (c *fakeConn) Do(cmd string, args ...interface{}) (interface{}, error) {
+	if cmd == "" {
+		return nil, nil
+	}
+	c.mu.Lock()
+	c.cmds = append(c.cmds, append([]interface{}{cmd}, args...))
+	reply := c.reply
+	c.mu.Unlock()
+	if reply == nil {
+		return nil, nil
+	}
+	return reply(cmd, args)
+}
+
+func (c *fakeConn) DoWithTimeout(timeout time.Duration, cmd string, args ...interface{}) (interface{}, error) {
+	return c.Do(cmd, args...)
+}
+
+func (c *fakeConn) ReceiveWithTimeout(timeout time.Duration) (interface{}, error) {
+	return nil, nil
+}
+
+// commands returns the commands named cmd in their order.
+func (c *fakeConn) commands(cmd string) [][]interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var cmds [][]interface{}
+	for _, v := range c.cmds {
+		if v[0] == cmd {
+			cmds = append(cmds, v)
+		}
+	}
+	return cmds
+}
+
+func newFakeStreamBroker(c *fakeConn, opts ...broker.Option) *streamBroker {
+	b := NewStreamBroker(opts...).(*streamBroker)
+	b.pool = &redis.Pool{Dial: func() (redis.Conn, error) { return c, nil }}
+	return b
+}
+
+func newFakeSubscriber(b *streamBroker, handler broker.Handler, opts ...broker.SubscribeOption) *streamSubscriber {
+	return &streamSubscriber{
+		b:        b,
+		topic:    "test",
+		group:    "workers",
+		consumer: "c1",
+		shared:   true,
+		handle:   handler,
+		opts:     broker.NewSubscribeOptions(opts...),
+		exit:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// entry returns a stream entry of the XREADGROUP or XCLAIM reply with the encoded message.
+func entry(t *testing.T, b *streamBroker, id string, body string) interface{} {
+	data, err := b.opts.Codec.Marshal(&broker.Message{Header: map[string]string{"id": id}, Body: []byte(body)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return []interface{}{[]byte(id), []interface{}{[]byte(streamField), data}}
+}
+
+func TestStreamParseEntry(t *testing.T) {
+	b := newFakeStreamBroker(&fakeConn{})
+	s := newFakeSubscriber(b, nil)
+
+	id, m, ok := s.parseEntry(entry(t, b, "1-0", "hello"))
+	if !ok || id != "1-0" || m == nil || string(m.Body) != "hello" || m.Header["id"] != "1-0" {
+		t.Fatalf("Unexpected entry %s %v %v", id, m, ok)
+	}
+	// trimmed from the stream
+	id, m, ok = s.parseEntry([]interface{}{[]byte("2-0"), nil})
+	if !ok || id != "2-0" || m != nil {
+		t.Fatalf("Unexpected trimmed entry %s %v %v", id, m, ok)
+	}
+	// never decodable
+	id, m, ok = s.parseEntry([]interface{}{[]byte("3-0"), []interface{}{[]byte(streamField), []byte("{")}})
+	if !ok || id != "3-0" || m != nil {
+		t.Fatalf("Unexpected undecodable entry %s %v %v", id, m, ok)
+	}
+	if _, _, ok = s.parseEntry([]interface{}{}); ok {
+		t.Fatal("Expected the entry without an id to be skipped")
+	}
+}
+
+func TestStreamAck(t *testing.T) {
+	c := &fakeConn{}
+	b := newFakeStreamBroker(c)
+	fail := false
+	var handled []string
+	s := newFakeSubscriber(b, func(e broker.Event) error {
+		handled = append(handled, e.Message().Header["id"])
+		if fail {
+			return fmt.Errorf("failed")
+		}
+		return nil
+	})
+
+	s.deliverEntries([]interface{}{
+		entry(t, b, "1-0", "hello"),
+		[]interface{}{[]byte("2-0"), nil},
+	})
+	if !reflect.DeepEqual(handled, []string{"1-0"}) {
+		t.Fatalf("Unexpected handled messages %v", handled)
+	}
+	acks := c.commands("XACK")
+	if len(acks) != 2 || acks[0][3] != "1-0" || acks[1][3] != "2-0" {
+		t.Fatalf("Expected the handled and the trimmed entries acked, got %v", acks)
+	}
+
+	// the failed messages stay pending
+	fail = true
+	s.deliverEntries([]interface{}{entry(t, b, "3-0", "hello")})
+	if n := len(c.commands("XACK")); n != 2 {
+		t.Fatalf("Unexpected ack of the failed message, %d acks", n)
+	}
+
+	// acked by the handler with DisableAutoAck
+	var event broker.Event
+	s = newFakeSubscriber(b, func(e broker.Event) error {
+		event = e
+		return nil
+	}, broker.DisableAutoAck())
+	s.deliverEntries([]interface{}{entry(t, b, "4-0", "hello")})
+	if n := len(c.commands("XACK")); n != 2 {
+		t.Fatalf("Unexpected ack with DisableAutoAck, %d acks", n)
+	}
+	if err := event.Ack(); err != nil {
+		t.Fatal(err)
+	}
+	acks = c.commands("XACK")
+	if len(acks) != 3 || !reflect.DeepEqual(acks[2], []interface{}{"XACK", "test", "workers", "4-0"}) {
+		t.Fatalf("Unexpected acks %v", acks)
+	}
+}
+
+func TestStreamClaim(t *testing.T) {
+	c := &fakeConn{}
+	b := newFakeStreamBroker(c, StreamClaimIdle(time.Second), StreamMaxDeliveries(3, "test.dlq"))
+	c.reply = func(cmd string, args []interface{}) (interface{}, error) {
+		switch cmd {
+		case "XPENDING":
+			// id, consumer, idle milliseconds and deliveries
+			return []interface{}{
+				[]interface{}{[]byte("1-0"), []byte("c2"), int64(100), int64(1)},
+				[]interface{}{[]byte("2-0"), []byte("c2"), int64(2000), int64(1)},
+				[]interface{}{[]byte("3-0"), []byte("c2"), int64(2000), int64(3)},
+			}, nil
+		case "XCLAIM":
+			var entries []interface{}
+			for _, id := range args[4:] {
+				entries = append(entries, entry(t, b, string(id.([]byte)), "hello"))
+			}
+			return entries, nil
+		}
+		return nil, nil
+	}
+	var handled []string
+	s := newFakeSubscriber(b, func(e broker.Event) error {
+		handled = append(handled, e.Message().Header["id"])
+		return nil
+	})
+
+	if err := s.claim(c); err != nil {
+		t.Fatal(err)
+	}
+	claims := c.commands("XCLAIM")
+	if len(claims) != 2 || len(claims[0]) != 6 || string(claims[0][5].([]byte)) != "3-0" ||
+		len(claims[1]) != 6 || string(claims[1][5].([]byte)) != "2-0" {
+		t.Fatalf("Expected 3-0 claimed to be dead-lettered and 2-0 to be delivered, got %v", claims)
+	}
+	if !reflect.DeepEqual(handled, []string{"2-0"}) {
+		t.Fatalf("Unexpected handled messages %v", handled)
+	}
+
+	adds := c.commands("XADD")
+	if len(adds) != 1 || adds[0][1] != "test.dlq" {
+		t.Fatalf("Expected the message published to the dead-letter topic, got %v", adds)
+	}
+	var m broker.Message
+	if err := b.opts.Codec.Unmarshal(adds[0][len(adds[0])-1].([]byte), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Header["id"] != "3-0" || m.Header[broker.HeaderDeadLetterTopic] != "test" ||
+		m.Header[broker.HeaderDeadLetterAttempts] != "3" {
+		t.Fatalf("Unexpected dead letter %v", m.Header)
+	}
+	acks := c.commands("XACK")
+	if len(acks) != 2 || acks[0][3] != "3-0" || acks[1][3] != "2-0" {
+		t.Fatalf("Expected the dead letter and the delivered message acked, got %v", acks)
+	}
+}
+
+func TestStreamReapGroups(t *testing.T) {
+	c := &fakeConn{}
+	b := newFakeStreamBroker(c, StreamGroupTTL(time.Minute))
+	c.reply = func(cmd string, args []interface{}) (interface{}, error) {
+		if cmd != "XINFO" {
+			return nil, nil
+		}
+		if args[0] == "GROUPS" {
+			var groups []interface{}
+			for _, name := range []string{"tars-crashed", "tars-alive", "tars-self", "workers"} {
+				groups = append(groups, []interface{}{[]byte("name"), []byte(name), []byte("consumers"), int64(1)})
+			}
+			return groups, nil
+		}
+		idle := int64(time.Hour / time.Millisecond)
+		if args[2] == "tars-alive" {
+			idle = 100
+		}
+		return []interface{}{[]interface{}{[]byte("name"), []byte("c"), []byte("pending"), int64(0), []byte("idle"), idle}}, nil
+	}
+	s := newFakeSubscriber(b, nil)
+	s.group = "tars-self"
+
+	s.reapGroups(c)
+	destroyed := c.commands("XGROUP")
+	if len(destroyed) != 1 || !reflect.DeepEqual(destroyed[0], []interface{}{"XGROUP", "DESTROY", "test", "tars-crashed"}) {
+		t.Fatalf("Expected only the idle group without a queue destroyed, got %v", destroyed)
+	}
+}
+
+func TestStreamUnsubscribeInHandler(t *testing.T) {
+	c := &fakeConn{}
+	b := newFakeStreamBroker(c, StreamBlock(time.Millisecond))
+	var once sync.Once
+	c.reply = func(cmd string, args []interface{}) (interface{}, error) {
+		if cmd != "XREADGROUP" {
+			return nil, nil
+		}
+		var reply interface{}
+		once.Do(func() {
+			reply = []interface{}{[]interface{}{[]byte("test"), []interface{}{entry(t, b, "1-0", "hello")}}}
+		})
+		if reply == nil {
+			time.Sleep(time.Millisecond)
+		}
+		return reply, nil
+	}
+
+	subs := make(chan broker.Subscriber, 1)
+	unsubscribed := make(chan struct{})
+	sub, err := b.Subscribe("test", func(e broker.Event) error {
+		(<-subs).Unsubscribe()
+		close(unsubscribed)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	subs <- sub
+	select {
+	case <-unsubscribed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Unsubscribe in the handler deadlocks")
+	}
+	<-sub.(*streamSubscriber).done
+	b.Lock()
+	n := len(b.subs)
+	b.Unlock()
+	if n != 0 {
+		t.Fatalf("Expected the subscriber removed, %d left", n)
+	}
+}
@@ -0,0 +1,177 @@
+package broker
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The headers added to the messages published to a dead-letter topic.
+const (
+	// HeaderDeadLetterTopic is the topic the message failed on
+	HeaderDeadLetterTopic = "Tars-Dead-Letter-Topic"
+	// HeaderDeadLetterError is the error of the last attempt
+	HeaderDeadLetterError = "Tars-Dead-Letter-Error"
+	// HeaderDeadLetterAttempts is the number of the attempts
+	HeaderDeadLetterAttempts = "Tars-Dead-Letter-Attempts"
+	// HeaderDeadLetterTime is the time of the last attempt in RFC 3339
+	HeaderDeadLetterTime = "Tars-Dead-Letter-Time"
+
+	deadLetterPrefix = "Tars-Dead-Letter-"
+)
+
+var (
+	// ErrRetryStopped is returned by the retry handler if the subscriber stops during the backoff,
+	// the message is not acked and the broker delivers it again.
+	ErrRetryStopped = errors.New("broker: retry stopped")
+	// ErrReplayDone is returned for the dead letters published after the replay started, they
+	// are not acked and kept for the next replay.
+	ErrReplayDone = errors.New("broker: replay done")
+)
+
+// RetryPolicy is how a subscription retries the messages its handler fails on.
+type RetryPolicy struct {
+	// Attempts is the max number of the calls of the handler for a message, including the first one.
+	Attempts int
+	// Backoff is the delay before the first retry.
+	Backoff time.Duration
+	// MaxBackoff limits the delay if it is positive.
+	MaxBackoff time.Duration
+	// Multiplier multiplies the delay after every retry, it defaults to 2.
+	Multiplier float64
+}
+
+// Delay returns the delay before the retry after the attempt, counting from 1.
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	m := p.Multiplier
+	if m <= 0 {
+		m = 2
+	}
+	d := float64(p.Backoff)
+	for i := 1; i < attempt; i++ {
+		d *= m
+		if p.MaxBackoff > 0 && d >= float64(p.MaxBackoff) {
+			return p.MaxBackoff
+		}
+	}
+	return time.Duration(d)
+}
+
+// Total returns the sum of the delays of all the retries.
+func (p RetryPolicy) Total() time.Duration {
+	var total time.Duration
+	for i := 1; i < p.Attempts; i++ {
+		total += p.Delay(i)
+	}
+	return total
+}
+
+// RetryHandler wraps the handler to retry the failed messages by the policy. The messages still
+// failed are published to the deadLetter topic of b with the failure headers, and the handler
+// succeeds if they are published. Without a deadLetter topic the last error is returned.
+//
+// The backoff is cancelled when stop is closed, like after the subscriber is unsubscribed, and
+// ErrRetryStopped is returned. The message is not acked while it is retried, so policy.Total
+// should stay below the ack timeout of the broker, or the broker delivers it to another subscriber
+// at the same time.
+func RetryHandler(b Broker, h Handler, policy RetryPolicy, deadLetter string, stop <-chan struct{}) Handler {
+	return func(e Event) error {
+		var err error
+		attempt := 1
+		for ; ; attempt++ {
+			if err = h(e); err == nil {
+				return nil
+			}
+			if attempt >= policy.Attempts {
+				break
+			}
+			timer := time.NewTimer(policy.Delay(attempt))
+			select {
+			case <-timer.C:
+			case <-stop:
+				timer.Stop()
+				return ErrRetryStopped
+			}
+		}
+		if deadLetter == "" {
+			return err
+		}
+
+		msg := e.Message()
+		header := make(map[string]string, len(msg.Header)+4)
+		for k, v := range msg.Header {
+			header[k] = v
+		}
+		header[HeaderDeadLetterTopic] = e.Topic()
+		header[HeaderDeadLetterError] = err.Error()
+		header[HeaderDeadLetterAttempts] = strconv.Itoa(attempt)
+		header[HeaderDeadLetterTime] = time.Now().Format(time.RFC3339Nano)
+		return b.Publish(deadLetter, &Message{Header: header, Body: msg.Body})
+	}
+}
+
+// ReplayDeadLetters subscribes to the deadLetter topic and publishes the messages back to the
+// topics they failed on without the failure headers. Only the messages dead-lettered before the
+// replay starts are replayed. The first one dead-lettered after it, like a replayed message
+// failing again, ends the replay: it is not acked, ErrReplayDone is returned for it and the
+// subscriber is unsubscribed. Subscribe with a Queue to replay every dead letter once among
+// the instances replaying the topic.
+func ReplayDeadLetters(b Broker, deadLetter string, opts ...SubscribeOption) (Subscriber, error) {
+	start := time.Now()
+	var (
+		mu   sync.Mutex
+		sub  Subscriber
+		done bool
+	)
+	end := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if done {
+			return
+		}
+		done = true
+		if sub != nil {
+			// the handler runs in the goroutine of the subscriber, do not wait for it
+			go sub.Unsubscribe()
+		}
+	}
+
+	s, err := b.Subscribe(deadLetter, func(e Event) error {
+		msg := e.Message()
+		mu.Lock()
+		stopped := done
+		mu.Unlock()
+		if stopped {
+			return ErrReplayDone
+		}
+		if t, err := time.Parse(time.RFC3339Nano, msg.Header[HeaderDeadLetterTime]); err == nil && !t.Before(start) {
+			end()
+			return ErrReplayDone
+		}
+		topic := msg.Header[HeaderDeadLetterTopic]
+		if topic == "" {
+			return errors.New("broker: no " + HeaderDeadLetterTopic + " header in the dead letter")
+		}
+		header := make(map[string]string, len(msg.Header))
+		for k, v := range msg.Header {
+			if !strings.HasPrefix(k, deadLetterPrefix) {
+				header[k] = v
+			}
+		}
+		return b.Publish(topic, &Message{Header: header, Body: msg.Body})
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	mu.Lock()
+	sub = s
+	stopped := done
+	mu.Unlock()
+	if stopped {
+		go s.Unsubscribe()
+	}
+	return s, nil
+}
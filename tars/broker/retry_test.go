@@ -0,0 +1,222 @@
+package broker
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeBroker calls the handlers of the topic in Publish.
+type fakeBroker struct {
+	sync.Mutex
+	handlers map[string][]Handler
+}
+
+type fakeEvent struct {
+	topic string
+	msg   *Message
+}
+
+func (e *fakeEvent) Topic() string     { return e.topic }
+func (e *fakeEvent) Message() *Message { return e.msg }
+func (e *fakeEvent) Ack() error        { return nil }
+
+type fakeSubscriber struct {
+	b     *fakeBroker
+	topic string
+}
+
+func (s *fakeSubscriber) Options() SubscribeOptions { return SubscribeOptions{} }
+func (s *fakeSubscriber) Topic() string             { return s.topic }
+func (s *fakeSubscriber) Unsubscribe() error {
+	s.b.Lock()
+	delete(s.b.handlers, s.topic)
+	s.b.Unlock()
+	return nil
+}
+
+func (b *fakeBroker) Init(...Option) error { return nil }
+func (b *fakeBroker) Options() Options     { return Options{} }
+func (b *fakeBroker) Address() string      { return "" }
+func (b *fakeBroker) Connect() error       { return nil }
+func (b *fakeBroker) Disconnect() error    { return nil }
+func (b *fakeBroker) String() string       { return "fake" }
+
+func (b *fakeBroker) Publish(topic string, m *Message, opts ...PublishOption) error {
+	b.Lock()
+	hs := b.handlers[topic]
+	b.Unlock()
+	for _, h := range hs {
+		if err := h(&fakeEvent{topic: topic, msg: m}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *fakeBroker) Subscribe(topic string, h Handler, opts ...SubscribeOption) (Subscriber, error) {
+	b.Lock()
+	b.handlers[topic] = append(b.handlers[topic], h)
+	b.Unlock()
+	return &fakeSubscriber{b: b, topic: topic}, nil
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := RetryPolicy{Attempts: 5, Backoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	want := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond, 50 * time.Millisecond}
+	for i, w := range want {
+		if d := p.Delay(i + 1); d != w {
+			t.Errorf("Delay(%d) = %v, want %v", i+1, d, w)
+		}
+	}
+	p = RetryPolicy{Backoff: time.Millisecond, Multiplier: 3}
+	if d := p.Delay(3); d != 9*time.Millisecond {
+		t.Errorf("Delay(3) = %v, want 9ms", d)
+	}
+	p = RetryPolicy{Attempts: 4, Backoff: time.Millisecond}
+	if d := p.Total(); d != 7*time.Millisecond {
+		t.Errorf("Total() = %v, want 7ms", d)
+	}
+}
+
+func TestRetryStopped(t *testing.T) {
+	b := &fakeBroker{handlers: make(map[string][]Handler)}
+	stop := make(chan struct{})
+	calls := 0
+	b.Subscribe("test", RetryHandler(b, func(e Event) error {
+		calls++
+		return errors.New("failed")
+	}, RetryPolicy{Attempts: 3, Backoff: time.Hour}, "test.dlq", stop))
+
+	time.AfterFunc(10*time.Millisecond, func() { close(stop) })
+	if err := b.Publish("test", &Message{Header: map[string]string{}}); err != ErrRetryStopped {
+		t.Fatalf("Expected the retry to stop, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("Expected 1 call, got %d", calls)
+	}
+}
+
+func TestRetryHandler(t *testing.T) {
+	b := &fakeBroker{handlers: make(map[string][]Handler)}
+	calls := 0
+	h := RetryHandler(b, func(e Event) error {
+		calls++
+		if calls < 3 {
+			return errors.New("failed")
+		}
+		return nil
+	}, RetryPolicy{Attempts: 3, Backoff: time.Millisecond}, "", nil)
+	b.Subscribe("test", h)
+
+	if err := b.Publish("test", &Message{Header: map[string]string{}}); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("Expected 3 calls, got %d", calls)
+	}
+
+	calls = -10
+	if err := b.Publish("test", &Message{Header: map[string]string{}}); err == nil || err.Error() != "failed" {
+		t.Fatalf("Expected the error of the handler, got %v", err)
+	}
+}
+
+func TestDeadLetter(t *testing.T) {
+	b := &fakeBroker{handlers: make(map[string][]Handler)}
+	fail := true
+	var received []*Message
+	b.Subscribe("test", RetryHandler(b, func(e Event) error {
+		received = append(received, e.Message())
+		if fail {
+			return errors.New("bad message")
+		}
+		return nil
+	}, RetryPolicy{Attempts: 2}, "test.dlq", nil))
+
+	var dead []*Message
+	dlq, _ := b.Subscribe("test.dlq", func(e Event) error {
+		dead = append(dead, e.Message())
+		return nil
+	})
+
+	msg := &Message{Header: map[string]string{"id": "1"}, Body: []byte("hello")}
+	if err := b.Publish("test", msg); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if len(received) != 2 || len(dead) != 1 {
+		t.Fatalf("Expected 2 attempts and 1 dead letter, got %d and %d", len(received), len(dead))
+	}
+	d := dead[0]
+	if string(d.Body) != "hello" || d.Header["id"] != "1" || d.Header[HeaderDeadLetterTopic] != "test" ||
+		d.Header[HeaderDeadLetterError] != "bad message" || d.Header[HeaderDeadLetterAttempts] != "2" {
+		t.Fatalf("Unexpected dead letter %v", d.Header)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, d.Header[HeaderDeadLetterTime]); err != nil {
+		t.Fatalf("Unexpected time %v", err)
+	}
+	if _, ok := msg.Header[HeaderDeadLetterTopic]; ok {
+		t.Fatal("The original message is changed")
+	}
+
+	// replay after the handler is fixed
+	dlq.Unsubscribe()
+	fail = false
+	received = nil
+	replay, err := ReplayDeadLetters(b, "test.dlq")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	defer replay.Unsubscribe()
+	if err := b.Publish("test.dlq", d); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if len(received) != 1 || string(received[0].Body) != "hello" || received[0].Header["id"] != "1" {
+		t.Fatalf("Unexpected replay %v", received)
+	}
+	for k := range received[0].Header {
+		if k != "id" {
+			t.Fatalf("Unexpected header %s in the replayed message", k)
+		}
+	}
+	if err := b.Publish("test.dlq", &Message{Header: map[string]string{}}); err == nil {
+		t.Fatal("Expected an error for the message without the topic header")
+	}
+}
+
+func TestReplayBounded(t *testing.T) {
+	b := &fakeBroker{handlers: make(map[string][]Handler)}
+	calls := 0
+	b.Subscribe("test", RetryHandler(b, func(e Event) error {
+		calls++
+		return errors.New("still failing")
+	}, RetryPolicy{Attempts: 1}, "test.dlq", nil))
+
+	if _, err := ReplayDeadLetters(b, "test.dlq"); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	dead := &Message{Header: map[string]string{
+		HeaderDeadLetterTopic: "test",
+		HeaderDeadLetterTime:  time.Now().Add(-time.Minute).Format(time.RFC3339Nano),
+	}}
+	// the replayed message fails again and ends the replay instead of looping
+	if err := b.Publish("test.dlq", dead); err != ErrReplayDone {
+		t.Fatalf("Expected the replay to end, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("Expected the dead letter replayed once, got %d", calls)
+	}
+	for i := 0; ; i++ {
+		b.Lock()
+		n := len(b.handlers["test.dlq"])
+		b.Unlock()
+		if n == 0 {
+			break
+		}
+		if i == 100 {
+			t.Fatal("Expected the replay to unsubscribe")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,79 @@
+package tars
+
+import (
+	"hash/fnv"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/model"
+)
+
+//CallOption sets an option of a call of the proxies generated with tars2go -context-first, like
+//	rsp, err := app.Echo(ctx, req, tars.WithTimeout(time.Second), tars.WithHashKey(uid))
+type CallOption = model.CallOption
+
+//WithTimeout sets the timeout of the call, overriding TarsSetTimeout.
+func WithTimeout(timeout time.Duration) CallOption {
+	return func(o *model.CallOptions) {
+		o.Timeout = timeout
+	}
+}
+
+//WithHashCode calls the endpoint selected by the hash code, the calls with the same code go to the same endpoint
+//as long as the endpoints do not change.
+func WithHashCode(code int64) CallOption {
+	return func(o *model.CallOptions) {
+		o.HashCode = code
+		o.IsHash = true
+	}
+}
+
+//WithHashKey is WithHashCode with the FNV hash of the key.
+func WithHashKey(key string) CallOption {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return WithHashCode(int64(h.Sum64() >> 1))
+}
+
+//WithContext sends the context with the request, the context of the response is copied back into it.
+func WithContext(ctx map[string]string) CallOption {
+	return func(o *model.CallOptions) {
+		o.Context = ctx
+	}
+}
+
+//WithStatus sends the status with the request, the status of the response is copied back into it.
+func WithStatus(status map[string]string) CallOption {
+	return func(o *model.CallOptions) {
+		o.Status = status
+	}
+}
+
+//WithRetry retries the call at most n times if it fails before it is sent, like the connection failures.
+//The timed out calls are only retried with WithIdempotent.
+func WithRetry(n int) CallOption {
+	return func(o *model.CallOptions) {
+		o.Retries = n
+	}
+}
+
+//WithIdempotent marks the call idempotent, so WithRetry retries it on timeouts as well.
+//The call may have been executed by the server when it times out.
+func WithIdempotent() CallOption {
+	return func(o *model.CallOptions) {
+		o.Idempotent = true
+	}
+}
+
+//WithEndpoint calls the endpoint, like tcp -h 127.0.0.1 -p 10015, instead of the ones of the servant.
+func WithEndpoint(ep string) CallOption {
+	return func(o *model.CallOptions) {
+		o.Endpoint = ep
+	}
+}
+
+//WithSetName calls the endpoints in the set, the name is the set id like app.sz.1, or the prefix of it like app.sz.
+func WithSetName(name string) CallOption {
+	return func(o *model.CallOptions) {
+		o.SetName = name
+	}
+}
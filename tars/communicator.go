@@ -2,6 +2,7 @@ package tars
 
 import (
 	s "github.com/TarsCloud/TarsGo/tars/model"
+	"github.com/TarsCloud/TarsGo/tars/registry"
 	"sync"
 )
 
@@ -14,7 +15,9 @@ type ProxyPrx interface {
 func Dail(servant string) *ServantProxy {
 	c := new(Communicator)
 	c.init()
-	return c.s.GetServantProxy(servant)
+	s := c.s.GetServantProxy(servant)
+	s.ownComm = true
+	return s
 }
 
 var initCommunicator sync.Once
@@ -40,6 +43,8 @@ type Communicator struct {
 	s          *ServantProxyFactory
 	Client     *clientConfig
 	properties sync.Map
+	registry   registry.Registry
+	rlock      sync.Mutex
 }
 
 func (c *Communicator) init() {
@@ -49,13 +54,11 @@ func (c *Communicator) init() {
 		c.Client = GetClientConfig()
 	} else {
 		c.Client = &clientConfig{
-			"",
-			"",
-			"",
-			"",
-			refreshEndpointInterval,
-			reportInterval,
-			AsyncInvokeTimeout,
+			refreshEndpointInterval: refreshEndpointInterval,
+			reportInterval:          reportInterval,
+			AsyncInvokeTimeout:      AsyncInvokeTimeout,
+			ObjLimit:                ObjLimitInit,
+			AdapterLimit:            AdapterLimitInit,
 		}
 	}
 	c.SetProperty("netthread", 2)
@@ -73,6 +76,13 @@ func (c *Communicator) init() {
 		}
 	}
 
+	if c.Client.Registry != "" {
+		c.registry = newRegistry(c.Client.Registry)
+	} else if opts := getOptions(); opts != nil && opts.Registry() != nil {
+		// find the endpoints from the registry the server registers to
+		c.registry = opts.Registry()
+	}
+
 	c.s = new(ServantProxyFactory)
 	c.s.Init(c)
 }
@@ -88,6 +98,30 @@ func (c *Communicator) SetLocator(obj string) {
 	c.SetProperty("locator", obj)
 }
 
+// SetRegistry sets the registry for finding the endpoints of the servants.
+// It should be called before any proxy is created.
+func (c *Communicator) SetRegistry(r registry.Registry) {
+	c.rlock.Lock()
+	defer c.rlock.Unlock()
+	c.registry = r
+}
+
+// GetRegistry returns the registry of the communicator, the tars registry behind the locator by default.
+func (c *Communicator) GetRegistry() registry.Registry {
+	c.rlock.Lock()
+	defer c.rlock.Unlock()
+	if c.registry == nil {
+		c.registry = newTarsRegistry(c)
+	}
+	return c.registry
+}
+
+// Close closes all the servant proxies of the communicator, stopping their endpoint refreshing and connections.
+// The proxies should not be used after Close.
+func (c *Communicator) Close() {
+	c.s.Close()
+}
+
 // StringToProxy sets the servant of ProxyPrx p with a string servant
 func (c *Communicator) StringToProxy(servant string, p ProxyPrx) {
 	p.SetServant(c.s.GetServantProxy(servant))
@@ -58,4 +58,10 @@ type clientConfig struct {
 	refreshEndpointInterval int
 	reportInterval          int
 	AsyncInvokeTimeout      int
+	Registry                string
+	// ObjLimit and AdapterLimit are the initial concurrency limits of an obj proxy and of an endpoint
+	ObjLimit     int
+	AdapterLimit int
+	// DisableLimit turns the adaptive concurrency limiting off
+	DisableLimit bool
 }
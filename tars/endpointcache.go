@@ -0,0 +1,79 @@
+package tars
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/registry"
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
+)
+
+// endpointCache is the content of the cache file of the endpoints of an obj.
+type endpointCache struct {
+	Obj       string
+	Endpoints []endpoint.Endpoint
+	UpdatedAt time.Time
+}
+
+// cachePath returns the cache file of the obj under the data path, or empty string if there is no data path.
+func (e *EndpointManager) cachePath() string {
+	cfg := GetServerConfig()
+	if cfg == nil || cfg.DataPath == "" {
+		return ""
+	}
+	return filepath.Join(cfg.DataPath, "endpoints", e.objName+".json")
+}
+
+// saveCache persists the active endpoints, so that they are still known if the registry is down at the next start.
+func (e *EndpointManager) saveCache(eps []endpoint.Endpoint) {
+	path := e.cachePath()
+	if path == "" {
+		return
+	}
+	data, err := json.Marshal(endpointCache{Obj: e.objName, Endpoints: eps, UpdatedAt: time.Now()})
+	if err != nil {
+		TLOG.Error("encode endpoint cache fail:", e.objName, err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		TLOG.Error("create endpoint cache dir fail:", err)
+		return
+	}
+	// write to a temp file first, a half written cache is worse than an old one
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		TLOG.Error("write endpoint cache fail:", path, err)
+		return
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		TLOG.Error("rename endpoint cache fail:", path, err)
+	}
+}
+
+// loadCache sets the endpoints persisted by saveCache.
+func (e *EndpointManager) loadCache() {
+	path := e.cachePath()
+	if path == "" {
+		return
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			TLOG.Error("read endpoint cache fail:", path, err)
+		}
+		return
+	}
+	var cache endpointCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		TLOG.Error("decode endpoint cache fail:", path, err)
+		return
+	}
+	if cache.Obj != e.objName || len(cache.Endpoints) == 0 {
+		return
+	}
+	TLOG.Infof("use cached endpoints of %s updated at %v: %v", e.objName, cache.UpdatedAt, cache.Endpoints)
+	e.setEndpoints(&registry.Service{Name: e.objName, Endpoints: cache.Endpoints})
+}
@@ -1,12 +1,12 @@
 package tars
 
 import (
+	"errors"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/TarsCloud/TarsGo/tars/protocol/res/endpointf"
-	"github.com/TarsCloud/TarsGo/tars/protocol/res/queryf"
+	"github.com/TarsCloud/TarsGo/tars/registry"
 	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
 	"github.com/TarsCloud/TarsGo/tars/util/set"
 )
@@ -21,8 +21,14 @@ type EndpointManager struct {
 	comm            *Communicator
 	mlock           *sync.Mutex
 	refreshInterval int
+	registry        registry.Registry
+	watcher         registry.Watcher
+	done            chan struct{}
+	closeOnce       sync.Once
 	pos             int32
-	depth           int32
+	setPos          uint32
+	// outlierCheck is the unix nano time of the last outlier check
+	outlierCheck int64
 }
 
 func (e *EndpointManager) setObjName(objName string) {
@@ -36,7 +42,12 @@ func (e *EndpointManager) setObjName(objName string) {
 		endpoints := objName[pos+1:]
 		e.directproxy = true
 		for _, end := range strings.Split(endpoints, ":") {
-			e.pointsSet.Add(endpoint.Parse(end))
+			ep, err := endpoint.Parse(end)
+			if err != nil {
+				TLOG.Error("parse endpoint fail:", objName, err)
+				continue
+			}
+			e.pointsSet.Add(ep)
 		}
 		e.index = e.pointsSet.Slice()
 
@@ -44,19 +55,46 @@ func (e *EndpointManager) setObjName(objName string) {
 		//[proxy] TODO singleton
 		TLOG.Debug("proxy mode:", objName)
 		e.objName = objName
-		//comm := NewCommunicator()
-		//comm.SetProperty("netthread", 1)
-		obj, _ := e.comm.GetProperty("locator")
-		q := new(queryf.QueryF)
-		e.comm.StringToProxy(obj, q)
-		e.findAndSetObj(q)
-		go func() {
-			loop := time.NewTicker(time.Duration(e.refreshInterval) * time.Millisecond)
-			for range loop.C {
-				//TODO exit
-				e.findAndSetObj(q)
-			}
-		}()
+		e.registry = e.comm.GetRegistry()
+		w, err := e.registry.Watch(e.objName)
+		if err != nil {
+			// poll the registry which can not watch
+			w = registry.NewPollWatcher(func() (*registry.Service, error) {
+				return e.registry.GetService(e.objName)
+			}, time.Duration(e.refreshInterval)*time.Millisecond)
+		}
+		e.watcher = w
+		if err := e.findAndSetObj(w); err != nil {
+			// the registry is unreachable, start with the endpoints known last time
+			e.loadCache()
+		}
+		go e.watch(w)
+	}
+}
+
+// watch sets the endpoints of the obj whenever they change, backing off while the watcher fails.
+// It exits when Close stops the watcher.
+func (e *EndpointManager) watch(w registry.Watcher) {
+	backoff := WatchRetryMin
+	for {
+		err := e.findAndSetObj(w)
+		if err == registry.ErrWatcherStopped {
+			return
+		}
+		if err == nil {
+			backoff = WatchRetryMin
+			continue
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-e.done:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		if backoff *= 2; backoff > WatchRetryMax {
+			backoff = WatchRetryMax
+		}
 	}
 }
 
@@ -64,48 +102,63 @@ func (e *EndpointManager) setObjName(objName string) {
 func (e *EndpointManager) Init(objName string, comm *Communicator) error {
 	e.comm = comm
 	e.mlock = new(sync.Mutex)
+	e.done = make(chan struct{})
 	e.adapters = make(map[endpoint.Endpoint]*AdapterProxy)
 	e.pointsSet = set.NewSet()
 	e.directproxy = false
 	e.refreshInterval = comm.Client.refreshEndpointInterval
 	e.pos = 0
-	e.depth = 0
 	//ObjName要放到最后初始化
 	e.setObjName(objName)
 	return nil
 }
 
-// GetNextValidProxy returns polling adapter information.
-func (e *EndpointManager) GetNextValidProxy() *AdapterProxy {
-	e.mlock.Lock()
-	ep := e.GetNextEndpoint()
-	if ep == nil {
+// Close stops refreshing the endpoints and closes the connections to them.
+func (e *EndpointManager) Close() {
+	e.closeOnce.Do(func() {
+		close(e.done)
+		if e.watcher != nil {
+			e.watcher.Stop()
+		}
+		e.mlock.Lock()
+		for end, a := range e.adapters {
+			delete(e.adapters, end)
+			a.Close()
+		}
 		e.mlock.Unlock()
-		return nil
+	})
+}
+
+func (e *EndpointManager) isClosed() bool {
+	select {
+	case <-e.done:
+		return true
+	default:
+		return false
 	}
-	if adp, ok := e.adapters[*ep]; ok {
-		// returns nil if recursively all nodes have not found an available node.
-		if adp.status {
-			e.mlock.Unlock()
+}
+
+// GetNextValidProxy returns polling adapter information, skipping the inactive and ejected ones.
+// It returns nil if none of the endpoints is available.
+func (e *EndpointManager) GetNextValidProxy() *AdapterProxy {
+	e.mlock.Lock()
+	defer e.mlock.Unlock()
+	now := time.Now()
+	for i := 0; i < len(e.index); i++ {
+		ep := e.GetNextEndpoint()
+		adp, ok := e.adapters[*ep]
+		if !ok {
+			if err := e.createProxy(*ep); err != nil {
+				TLOG.Error("create adapter fail:", *ep, err)
+				return nil
+			}
+			return e.adapters[*ep]
+		}
+		if adp.status && !adp.isEjected(now) {
 			return adp
-		} else if e.depth > e.pointsSet.Len() {
-			e.mlock.Unlock()
-			return nil
-		} else {
-			e.depth++
-			e.mlock.Unlock()
-			return e.GetNextValidProxy()
 		}
 	}
-	err := e.createProxy(*ep)
-	if err != nil {
-		TLOG.Error("create adapter fail:", *ep, err)
-		e.mlock.Unlock()
-		return nil
-	}
-	a := e.adapters[*ep]
-	e.mlock.Unlock()
-	return a
+	return nil
 }
 
 // GetNextEndpoint returns the endpoint basic information.
@@ -133,6 +186,12 @@ func (e *EndpointManager) GetAllEndpoint() []*endpoint.Endpoint {
 }
 
 func (e *EndpointManager) createProxy(ep endpoint.Endpoint) error {
+	if e.isClosed() {
+		return errors.New("endpoint manager closed:" + e.objName)
+	}
+	if !endpoint.IsSupported(ep.Proto) {
+		return errors.New("unsupported endpoint protocol:" + ep.String())
+	}
 	TLOG.Debug("create adapter:", ep)
 	adp := new(AdapterProxy)
 	//TODO
@@ -145,22 +204,19 @@ func (e *EndpointManager) createProxy(ep endpoint.Endpoint) error {
 	return nil
 }
 
-// GetHashProxy returns hash adapter information.
+// GetHashProxy returns hash adapter information, the next endpoint takes the calls of an ejected one.
 func (e *EndpointManager) GetHashProxy(hashcode int64) *AdapterProxy {
-	// very unsafe.
-	ep := e.GetHashEndpoint(hashcode)
-	if ep == nil {
+	e.mlock.Lock()
+	defer e.mlock.Unlock()
+	length := len(e.index)
+	if length <= 0 {
 		return nil
 	}
-	if adp, ok := e.adapters[*ep]; ok {
-		return adp
-	}
-	err := e.createProxy(*ep)
-	if err != nil {
-		TLOG.Error("create adapter fail:", ep, err)
-		return nil
+	eps := make([]endpoint.Endpoint, length)
+	for i, v := range e.index {
+		eps[i] = v.(endpoint.Endpoint)
 	}
-	return e.adapters[*ep]
+	return e.adapterOf(e.availableFrom(eps, uint64(hashcode)%uint64(length), time.Now()))
 }
 
 // GetHashEndpoint returns hash endpoint information.
@@ -169,44 +225,121 @@ func (e *EndpointManager) GetHashEndpoint(hashcode int64) *endpoint.Endpoint {
 	if length <= 0 {
 		return nil
 	}
-	pos := hashcode % int64(length)
+	pos := uint64(hashcode) % uint64(length)
 	ep := e.index[pos].(endpoint.Endpoint)
 	return &ep
 }
 
+// GetEndpointProxy returns the adapter of the endpoint, which needs not be one of the obj,
+// or nil if the endpoint is ejected as an outlier.
+func (e *EndpointManager) GetEndpointProxy(ep endpoint.Endpoint) *AdapterProxy {
+	e.mlock.Lock()
+	defer e.mlock.Unlock()
+	// the endpoint given by host and port shares the adapter of the same one from the registry
+	for _, v := range e.index {
+		if end := v.(endpoint.Endpoint); end.Host == ep.Host && end.Port == ep.Port && end.Proto == ep.Proto {
+			ep = end
+			break
+		}
+	}
+	// there is no other endpoint to take the call of an ejected one
+	if adp, ok := e.adapters[ep]; ok && adp.isEjected(time.Now()) {
+		TLOG.Debug("endpoint ejected:", e.objName, ep)
+		return nil
+	}
+	return e.adapterOf(ep)
+}
+
+// GetSetProxy returns the adapter of an endpoint in the set, selected by the hash code if isHash,
+// or in turn otherwise, skipping the ejected ones. The set name is the set id like app.sz.1, or the
+// prefix of it like app.sz.
+func (e *EndpointManager) GetSetProxy(setName string, hashcode int64, isHash bool) *AdapterProxy {
+	e.mlock.Lock()
+	defer e.mlock.Unlock()
+	var eps []endpoint.Endpoint
+	for _, v := range e.index {
+		end := v.(endpoint.Endpoint)
+		if end.SetId == setName || strings.HasPrefix(end.SetId, setName+".") {
+			eps = append(eps, end)
+		}
+	}
+	if len(eps) == 0 {
+		return nil
+	}
+	var pos uint64
+	if isHash {
+		pos = uint64(hashcode) % uint64(len(eps))
+	} else {
+		e.setPos++
+		pos = uint64(e.setPos) % uint64(len(eps))
+	}
+	return e.adapterOf(e.availableFrom(eps, pos, time.Now()))
+}
+
+// availableFrom returns the first endpoint not ejected from pos on, so the calls of an ejected
+// endpoint go to the next one until it is back. It returns the one at pos if all are ejected,
+// with mlock held.
+func (e *EndpointManager) availableFrom(eps []endpoint.Endpoint, pos uint64, now time.Time) endpoint.Endpoint {
+	for i := 0; i < len(eps); i++ {
+		ep := eps[(pos+uint64(i))%uint64(len(eps))]
+		if adp, ok := e.adapters[ep]; !ok || !adp.isEjected(now) {
+			return ep
+		}
+	}
+	return eps[pos]
+}
+
+// adapterOf returns the adapter of the endpoint, creating it if not yet, with mlock held.
+func (e *EndpointManager) adapterOf(ep endpoint.Endpoint) *AdapterProxy {
+	if adp, ok := e.adapters[ep]; ok {
+		return adp
+	}
+	if err := e.createProxy(ep); err != nil {
+		TLOG.Error("create adapter fail:", ep, err)
+		return nil
+	}
+	return e.adapters[ep]
+}
+
 // SelectAdapterProxy returns selected adapter.
 func (e *EndpointManager) SelectAdapterProxy(msg *Message) *AdapterProxy {
+	e.checkOutliers(time.Now())
+	if msg.endpoint != nil {
+		return e.GetEndpointProxy(*msg.endpoint)
+	}
+	if msg.setName != "" {
+		return e.GetSetProxy(msg.setName, msg.hashCode, msg.isHash)
+	}
 	if msg.isHash {
 		return e.GetHashProxy(msg.hashCode)
 	}
 	return e.GetNextValidProxy()
 }
 
-func (e *EndpointManager) findAndSetObj(q *queryf.QueryF) {
-	activeEp := new([]endpointf.EndpointF)
-	inactiveEp := new([]endpointf.EndpointF)
-	var setable, ok bool
-	var setID string
-	var ret int32
-	var err error
-	if setable, ok = e.comm.GetPropertyBool("enableset"); ok {
-		setID, _ = e.comm.GetProperty("setdivision")
+// findAndSetObj waits for the next endpoints of the obj from the watcher and sets them.
+func (e *EndpointManager) findAndSetObj(w registry.Watcher) error {
+	svc, err := w.Next()
+	if err != nil {
+		if err != registry.ErrWatcherStopped {
+			TLOG.Error("find obj end fail:", e.objName, err.Error())
+		}
+		return err
 	}
-	if setable {
-		ret, err = q.FindObjectByIdInSameSet(e.objName, setID, activeEp, inactiveEp)
-	} else {
-		ret, err = q.FindObjectByIdInSameGroup(e.objName, activeEp, inactiveEp)
+	TLOG.Debug("find obj endpoint:", e.objName, e.registry.String(), svc.Endpoints, svc.Inactive)
+	e.setEndpoints(svc)
+	if len(svc.Endpoints) > 0 {
+		e.saveCache(svc.Endpoints)
 	}
-	if err != nil {
-		TLOG.Error("find obj end fail:", err.Error())
+	return nil
+}
+
+func (e *EndpointManager) setEndpoints(svc *registry.Service) {
+	if e.isClosed() {
 		return
 	}
-	TLOG.Debug("find obj endpoint:", e.objName, ret, *activeEp, *inactiveEp)
-
 	e.mlock.Lock()
-	if (len(*inactiveEp)) > 0 {
-		for _, ep := range *inactiveEp {
-			end := endpoint.Tars2endpoint(ep)
+	if (len(svc.Inactive)) > 0 {
+		for _, end := range svc.Inactive {
 			e.pointsSet.Remove(end)
 			if a, ok := e.adapters[end]; ok {
 				delete(e.adapters, end)
@@ -214,10 +347,9 @@ func (e *EndpointManager) findAndSetObj(q *queryf.QueryF) {
 			}
 		}
 	}
-	if (len(*activeEp)) > 0 {
+	if (len(svc.Endpoints)) > 0 {
 		e.pointsSet.Clear() // clean it first,then add back .this action must lead to add lock,but if don't clean may lead to leakage.it's better to use remove.
-		for _, ep := range *activeEp {
-			end := endpoint.Tars2endpoint(ep)
+		for _, end := range svc.Endpoints {
 			e.pointsSet.Add(end)
 		}
 		e.index = e.pointsSet.Slice()
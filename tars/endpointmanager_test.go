@@ -0,0 +1,52 @@
+package tars
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/registry"
+)
+
+// failWatcher fails every Next until stopped.
+type failWatcher struct {
+	calls int32
+	stop  chan struct{}
+}
+
+func (w *failWatcher) Next() (*registry.Service, error) {
+	atomic.AddInt32(&w.calls, 1)
+	select {
+	case <-w.stop:
+		return nil, registry.ErrWatcherStopped
+	default:
+		return nil, errors.New("registry unreachable")
+	}
+}
+
+func (w *failWatcher) Stop() {
+	close(w.stop)
+}
+
+func TestWatchBackoff(t *testing.T) {
+	e, _ := newTestManager(0)
+	e.done = make(chan struct{})
+	w := &failWatcher{stop: make(chan struct{})}
+	e.watcher = w
+	exited := make(chan struct{})
+	go func() {
+		e.watch(w)
+		close(exited)
+	}()
+	time.Sleep(WatchRetryMin / 2)
+	if calls := atomic.LoadInt32(&w.calls); calls != 1 {
+		t.Errorf("watcher called %d times within the backoff", calls)
+	}
+	e.Close()
+	select {
+	case <-exited:
+	case <-time.After(time.Second):
+		t.Fatal("watch not exited after Close")
+	}
+}
@@ -0,0 +1,296 @@
+package tars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+)
+
+// Kinds of injected faults.
+const (
+	FaultDelay   = "delay"
+	FaultError   = "error"
+	FaultDrop    = "drop"
+	FaultCorrupt = "corrupt"
+)
+
+// errFaultDrop makes TarsProtocol drop the response of the request.
+var errFaultDrop = errors.New("fault injection: drop response")
+
+// FaultRule describes a fault injected into the matching requests.
+// The rule is written as comma separated key=value pairs, for example:
+// side=client,type=delay,delay=200ms,servant=App.Server.Obj,method=add,percent=10,tag=canary:1
+type FaultRule struct {
+	// Side is client or server.
+	Side string
+	// Type is one of delay, error, drop and corrupt.
+	Type string
+	// Delay is the injected delay of the delay fault.
+	Delay time.Duration
+	// Servant and Method select the requests, empty or * matches all.
+	Servant string
+	Method  string
+	// Percent is the percentage of the matching requests injected with the fault.
+	Percent float64
+	// TagKey and TagValue select the requests by the request context.
+	TagKey   string
+	TagValue string
+}
+
+// ParseFaultRule parses a rule from its string form.
+func ParseFaultRule(s string) (*FaultRule, error) {
+	r := &FaultRule{Side: "client", Percent: 100}
+	for _, kv := range strings.Split(s, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		pos := strings.Index(kv, "=")
+		if pos <= 0 {
+			return nil, fmt.Errorf("invalid fault rule item: %s", kv)
+		}
+		key, value := kv[:pos], kv[pos+1:]
+		switch key {
+		case "side":
+			if value != "client" && value != "server" {
+				return nil, fmt.Errorf("invalid fault side: %s", value)
+			}
+			r.Side = value
+		case "type":
+			r.Type = value
+		case "delay":
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid fault delay: %s", value)
+			}
+			r.Delay = d
+		case "servant":
+			r.Servant = value
+		case "method":
+			r.Method = value
+		case "percent":
+			p, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+			if err != nil || p < 0 || p > 100 {
+				return nil, fmt.Errorf("invalid fault percent: %s", value)
+			}
+			r.Percent = p
+		case "tag":
+			pos := strings.Index(value, ":")
+			if pos <= 0 {
+				return nil, fmt.Errorf("invalid fault tag: %s", value)
+			}
+			r.TagKey, r.TagValue = value[:pos], value[pos+1:]
+		default:
+			return nil, fmt.Errorf("unknown fault rule item: %s", key)
+		}
+	}
+	switch r.Type {
+	case FaultDelay:
+		if r.Delay <= 0 {
+			return nil, errors.New("delay fault without delay")
+		}
+	case FaultError, FaultDrop, FaultCorrupt:
+	default:
+		return nil, fmt.Errorf("invalid fault type: %s", r.Type)
+	}
+	return r, nil
+}
+
+// String returns the rule in the form accepted by ParseFaultRule.
+func (r *FaultRule) String() string {
+	s := fmt.Sprintf("side=%s,type=%s", r.Side, r.Type)
+	if r.Type == FaultDelay {
+		s += ",delay=" + r.Delay.String()
+	}
+	if r.Servant != "" {
+		s += ",servant=" + r.Servant
+	}
+	if r.Method != "" {
+		s += ",method=" + r.Method
+	}
+	s += ",percent=" + strconv.FormatFloat(r.Percent, 'f', -1, 64)
+	if r.TagKey != "" {
+		s += ",tag=" + r.TagKey + ":" + r.TagValue
+	}
+	return s
+}
+
+func (r *FaultRule) match(side string, req *requestf.RequestPacket) bool {
+	if r.Side != side {
+		return false
+	}
+	if r.Servant != "" && r.Servant != "*" && r.Servant != req.SServantName {
+		return false
+	}
+	if r.Method != "" && r.Method != "*" && r.Method != req.SFuncName {
+		return false
+	}
+	if r.TagKey != "" && req.Context[r.TagKey] != r.TagValue {
+		return false
+	}
+	return rand.Float64()*100 < r.Percent
+}
+
+type faultInjector struct {
+	mu    sync.RWMutex
+	rules []*FaultRule
+}
+
+var faults faultInjector
+
+// AddFaultRules adds the rules separated by ';' to the fault injection.
+func AddFaultRules(rules string) error {
+	var parsed []*FaultRule
+	for _, s := range strings.Split(rules, ";") {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		r, err := ParseFaultRule(s)
+		if err != nil {
+			return err
+		}
+		parsed = append(parsed, r)
+	}
+	faults.mu.Lock()
+	faults.rules = append(faults.rules, parsed...)
+	faults.mu.Unlock()
+	return nil
+}
+
+// ClearFaultRules removes all the fault injection rules.
+func ClearFaultRules() {
+	faults.mu.Lock()
+	faults.rules = nil
+	faults.mu.Unlock()
+}
+
+// FaultRules returns the current fault injection rules.
+func FaultRules() []FaultRule {
+	faults.mu.RLock()
+	defer faults.mu.RUnlock()
+	rules := make([]FaultRule, len(faults.rules))
+	for i, r := range faults.rules {
+		rules[i] = *r
+	}
+	return rules
+}
+
+func (f *faultInjector) active() bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return len(f.rules) > 0
+}
+
+func (f *faultInjector) find(side string, req *requestf.RequestPacket) *FaultRule {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for _, r := range f.rules {
+		if r.match(side, req) {
+			return r
+		}
+	}
+	return nil
+}
+
+func corruptBuffer(buf []byte) {
+	if len(buf) == 0 {
+		return
+	}
+	for i := 0; i < len(buf)/8+1; i++ {
+		buf[rand.Intn(len(buf))] ^= byte(rand.Intn(255) + 1)
+	}
+}
+
+// FaultClientFilter injects the faults of the client side rules.
+func FaultClientFilter(ctx context.Context, msg *Message, invoke Invoke, timeout time.Duration) error {
+	r := faults.find("client", msg.Req)
+	if r == nil {
+		return invoke(ctx, msg, timeout)
+	}
+	TLOG.Debugf("inject fault %s to %s.%s", r.Type, msg.Req.SServantName, msg.Req.SFuncName)
+	switch r.Type {
+	case FaultDelay:
+		select {
+		case <-time.After(r.Delay):
+		case <-ctx.Done():
+		}
+	case FaultError:
+		return fmt.Errorf("%s|%s|%s", "fault injection error", msg.Req.SServantName, msg.Req.SFuncName)
+	case FaultDrop:
+		// the request never gets a response, just like a timeout
+		select {
+		case <-time.After(timeout):
+		case <-ctx.Done():
+		}
+		msg.Status = basef.TARSINVOKETIMEOUT
+		return fmt.Errorf("%s|%s|%d", "request timeout", msg.Req.SServantName, msg.Req.IRequestId)
+	case FaultCorrupt:
+		err := invoke(ctx, msg, timeout)
+		if err == nil && msg.Resp != nil {
+			corruptBuffer(msg.Resp.SBuffer)
+		}
+		return err
+	}
+	return invoke(ctx, msg, timeout)
+}
+
+// FaultServerFilter injects the faults of the server side rules.
+func FaultServerFilter(ctx context.Context, d Dispatch, f interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool) error {
+	r := faults.find("server", req)
+	if r == nil {
+		return d(ctx, f, req, resp, withContext)
+	}
+	TLOG.Debugf("inject fault %s to %s.%s", r.Type, req.SServantName, req.SFuncName)
+	switch r.Type {
+	case FaultDelay:
+		time.Sleep(r.Delay)
+	case FaultError:
+		return fmt.Errorf("%s|%s|%s", "fault injection error", req.SServantName, req.SFuncName)
+	case FaultDrop:
+		if err := d(ctx, f, req, resp, withContext); err != nil {
+			TLOG.Debug("dispatch error of dropped request:", err)
+		}
+		return errFaultDrop
+	case FaultCorrupt:
+		err := d(ctx, f, req, resp, withContext)
+		if err == nil {
+			corruptBuffer(resp.SBuffer)
+		}
+		return err
+	}
+	return d(ctx, f, req, resp, withContext)
+}
+
+// faultNotify handles the tars.fault admin command:
+// tars.fault add <rules>, tars.fault clear and tars.fault list.
+func faultNotify(args []string) (string, error) {
+	if len(args) == 0 {
+		return "", errors.New("usage: tars.fault add <rules>|clear|list")
+	}
+	switch args[0] {
+	case "add":
+		if err := AddFaultRules(strings.Join(args[1:], "")); err != nil {
+			return fmt.Sprintf("add fault rules fail: %s", err), err
+		}
+		return "tars.fault add succ", nil
+	case "clear":
+		ClearFaultRules()
+		return "tars.fault clear succ", nil
+	case "list":
+		rules := FaultRules()
+		lines := make([]string, len(rules))
+		for i := range rules {
+			lines[i] = rules[i].String()
+		}
+		return strings.Join(lines, "\n"), nil
+	}
+	return fmt.Sprintf("tars.fault %s not support now!", args[0]), nil
+}
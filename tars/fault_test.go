@@ -0,0 +1,225 @@
+package tars
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+)
+
+func TestParseFaultRule(t *testing.T) {
+	tests := []struct {
+		rule     string
+		expected *FaultRule
+	}{
+		{"type=error", &FaultRule{Side: "client", Type: FaultError, Percent: 100}},
+		{
+			"side=server,type=delay,delay=200ms,servant=App.Server.Obj,method=add,percent=10%,tag=canary:1",
+			&FaultRule{Side: "server", Type: FaultDelay, Delay: 200 * time.Millisecond, Servant: "App.Server.Obj",
+				Method: "add", Percent: 10, TagKey: "canary", TagValue: "1"},
+		},
+		{" type=drop , percent=0.5 ,", &FaultRule{Side: "client", Type: FaultDrop, Percent: 0.5}},
+		{"type=corrupt,tag=k:", &FaultRule{Side: "client", Type: FaultCorrupt, Percent: 100, TagKey: "k"}},
+		{"", nil},
+		{"type=unknown", nil},
+		{"type=delay", nil},
+		{"type=delay,delay=abc", nil},
+		{"type=error,side=both", nil},
+		{"type=error,percent=101", nil},
+		{"type=error,percent=-1", nil},
+		{"type=error,tag=canary", nil},
+		{"type=error,color=red", nil},
+		{"type", nil},
+	}
+	for _, tt := range tests {
+		r, err := ParseFaultRule(tt.rule)
+		if tt.expected == nil {
+			if err == nil {
+				t.Errorf("expected error parsing %q, got %+v", tt.rule, r)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parse %q: %v", tt.rule, err)
+			continue
+		}
+		if !reflect.DeepEqual(r, tt.expected) {
+			t.Errorf("parse %q: expected %+v, got %+v", tt.rule, tt.expected, r)
+		}
+		back, err := ParseFaultRule(r.String())
+		if err != nil || !reflect.DeepEqual(back, r) {
+			t.Errorf("parse %q back: expected %+v, got %+v, %v", r.String(), r, back, err)
+		}
+	}
+}
+
+func TestFaultRuleMatch(t *testing.T) {
+	req := &requestf.RequestPacket{SServantName: "App.Server.Obj", SFuncName: "add", Context: map[string]string{"canary": "1"}}
+	tests := []struct {
+		rule     string
+		side     string
+		expected bool
+	}{
+		{"type=error", "client", true},
+		{"type=error", "server", false},
+		{"side=server,type=error", "server", true},
+		{"type=error,servant=App.Server.Obj,method=add", "client", true},
+		{"type=error,servant=*,method=*", "client", true},
+		{"type=error,servant=App.Server.Other", "client", false},
+		{"type=error,method=sub", "client", false},
+		{"type=error,tag=canary:1", "client", true},
+		{"type=error,tag=canary:2", "client", false},
+		{"type=error,tag=gray:1", "client", false},
+		{"type=error,percent=0", "client", false},
+	}
+	for _, tt := range tests {
+		r, err := ParseFaultRule(tt.rule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := r.match(tt.side, req); got != tt.expected {
+			t.Errorf("match %q on %s: expected %v, got %v", tt.rule, tt.side, tt.expected, got)
+		}
+	}
+}
+
+func TestFaultClientFilter(t *testing.T) {
+	defer ClearFaultRules()
+	payload := []byte("0123456789abcdef")
+	tests := []struct {
+		name    string
+		rules   string
+		err     bool
+		invoked bool
+		status  int32
+		elapsed time.Duration
+		corrupt bool
+	}{
+		{name: "no match", rules: "type=error,method=sub", invoked: true},
+		{name: "error", rules: "type=error", err: true},
+		{name: "delay", rules: "type=delay,delay=50ms", invoked: true, elapsed: 50 * time.Millisecond},
+		{name: "drop", rules: "type=drop", err: true, status: basef.TARSINVOKETIMEOUT, elapsed: 20 * time.Millisecond},
+		{name: "corrupt", rules: "type=corrupt", invoked: true, corrupt: true},
+		{name: "server rule", rules: "side=server,type=error", invoked: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ClearFaultRules()
+			if err := AddFaultRules(tt.rules); err != nil {
+				t.Fatal(err)
+			}
+			invoked := false
+			invoke := func(ctx context.Context, msg *Message, timeout time.Duration) error {
+				invoked = true
+				msg.Resp = &requestf.ResponsePacket{SBuffer: append([]byte(nil), payload...)}
+				return nil
+			}
+			msg := &Message{Req: &requestf.RequestPacket{SServantName: "App.Server.Obj", SFuncName: "add"}}
+			start := time.Now()
+			err := FaultClientFilter(context.Background(), msg, invoke, 20*time.Millisecond)
+			if (err != nil) != tt.err {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+			if invoked != tt.invoked {
+				t.Errorf("expected invoked %v, got %v", tt.invoked, invoked)
+			}
+			if msg.Status != tt.status {
+				t.Errorf("expected status %d, got %d", tt.status, msg.Status)
+			}
+			if elapsed := time.Since(start); elapsed < tt.elapsed {
+				t.Errorf("expected %v elapsed, got %v", tt.elapsed, elapsed)
+			}
+			if invoked && bytes.Equal(msg.Resp.SBuffer, payload) == tt.corrupt {
+				t.Errorf("expected corrupt %v, got %v", tt.corrupt, msg.Resp.SBuffer)
+			}
+		})
+	}
+}
+
+func TestFaultServerFilter(t *testing.T) {
+	defer ClearFaultRules()
+	payload := []byte("0123456789abcdef")
+	tests := []struct {
+		name       string
+		rules      string
+		err        error
+		failed     bool
+		dispatched bool
+		elapsed    time.Duration
+		corrupt    bool
+	}{
+		{name: "no match", rules: "side=server,type=error,servant=App.Server.Other", dispatched: true},
+		{name: "error", rules: "side=server,type=error", failed: true},
+		{name: "delay", rules: "side=server,type=delay,delay=50ms", dispatched: true, elapsed: 50 * time.Millisecond},
+		{name: "drop", rules: "side=server,type=drop", err: errFaultDrop, failed: true, dispatched: true},
+		{name: "corrupt", rules: "side=server,type=corrupt", dispatched: true, corrupt: true},
+		{name: "client rule", rules: "type=error", dispatched: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ClearFaultRules()
+			if err := AddFaultRules(tt.rules); err != nil {
+				t.Fatal(err)
+			}
+			dispatched := false
+			d := func(ctx context.Context, f interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool) error {
+				dispatched = true
+				resp.SBuffer = append([]byte(nil), payload...)
+				return nil
+			}
+			req := &requestf.RequestPacket{SServantName: "App.Server.Obj", SFuncName: "add"}
+			resp := new(requestf.ResponsePacket)
+			start := time.Now()
+			err := FaultServerFilter(context.Background(), d, nil, req, resp, false)
+			if (err != nil) != tt.failed || (tt.err != nil && err != tt.err) {
+				t.Errorf("unexpected error %v", err)
+			}
+			if dispatched != tt.dispatched {
+				t.Errorf("expected dispatched %v, got %v", tt.dispatched, dispatched)
+			}
+			if elapsed := time.Since(start); elapsed < tt.elapsed {
+				t.Errorf("expected %v elapsed, got %v", tt.elapsed, elapsed)
+			}
+			if dispatched && bytes.Equal(resp.SBuffer, payload) == tt.corrupt {
+				t.Errorf("expected corrupt %v, got %v", tt.corrupt, resp.SBuffer)
+			}
+		})
+	}
+}
+
+func TestFaultNotify(t *testing.T) {
+	defer ClearFaultRules()
+	ClearFaultRules()
+	tests := []struct {
+		command  string
+		expected string
+		err      bool
+	}{
+		{"", "", true},
+		{"add type=error;side=server,type=delay,delay=1s", "tars.fault add succ", false},
+		{"add type=unknown", "add fault rules fail: invalid fault type: unknown", true},
+		{"list", "side=client,type=error,percent=100\nside=server,type=delay,delay=1s,percent=100", false},
+		{"clear", "tars.fault clear succ", false},
+		{"list", "", false},
+		{"remove", "tars.fault remove not support now!", false},
+	}
+	for _, tt := range tests {
+		var args []string
+		if tt.command != "" {
+			// split like Admin.Notify
+			args = strings.Split(tt.command, " ")
+		}
+		got, err := faultNotify(args)
+		if (err != nil) != tt.err {
+			t.Errorf("tars.fault %s: unexpected error %v", tt.command, err)
+		}
+		if got != tt.expected {
+			t.Errorf("tars.fault %s: expected %q, got %q", tt.command, tt.expected, got)
+		}
+	}
+}
@@ -20,4 +20,5 @@ require (
 	github.com/nats-io/nats.go v1.8.1
 	github.com/opentracing/opentracing-go v1.1.0
 	github.com/pkg/errors v0.8.1
+	gopkg.in/yaml.v2 v2.2.2
 )
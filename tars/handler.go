@@ -1,6 +1,10 @@
 package tars
 
-import "context"
+import (
+	"context"
+
+	"github.com/TarsCloud/TarsGo/tars/broker"
+)
 
 type HandlerOption func(*HandlerOptions)
 
@@ -18,6 +22,10 @@ type SubscriberOptions struct {
 	Queue    string
 	Internal bool
 	Context  context.Context
+	// Retry retries the messages the handler fails on.
+	Retry broker.RetryPolicy
+	// DeadLetter is the topic of the messages still failed after the retries.
+	DeadLetter string
 }
 
 // EndpointMetadata is a Handler option that allows metadata to be added to
@@ -78,3 +86,21 @@ func SubscriberContext(ctx context.Context) SubscriberOption {
 		o.Context = ctx
 	}
 }
+
+// SubscriberRetry retries the messages the handler fails on by the policy.
+// SubscriberRetry(broker.RetryPolicy{Attempts: 3, Backoff: time.Second}) calls the handler
+// at most 3 times, 1s and 2s after the failures. The total backoff should stay below the ack
+// timeout of the broker, or the message is delivered again while it is retried.
+func SubscriberRetry(p broker.RetryPolicy) SubscriberOption {
+	return func(o *SubscriberOptions) {
+		o.Retry = p
+	}
+}
+
+// SubscriberDeadLetter publishes the messages still failed after the retries to the topic,
+// with the failure headers like broker.HeaderDeadLetterError.
+func SubscriberDeadLetter(topic string) SubscriberOption {
+	return func(o *SubscriberOptions) {
+		o.DeadLetter = topic
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
 )
 
 // Message is a struct contains servant information
@@ -21,6 +22,9 @@ type Message struct {
 
 	hashCode int64
 	isHash   bool
+	// endpoint and setName limit the endpoints selected for the message
+	endpoint *endpoint.Endpoint
+	setName  string
 }
 
 // Init define the begintime
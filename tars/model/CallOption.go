@@ -0,0 +1,85 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+)
+
+//CallOptions are the options of a single call, set by the CallOption functions.
+type CallOptions struct {
+	//Timeout overrides the timeout of the servant if it is positive.
+	Timeout time.Duration
+	//HashCode selects the endpoint if IsHash is set.
+	HashCode int64
+	IsHash   bool
+	//Context and Status are sent with the request, the ones of the response are copied back into them.
+	Context map[string]string
+	Status  map[string]string
+	//Retries is the times to retry the call failed before it is sent, like the connection failures.
+	Retries int
+	//Idempotent also retries the timed out calls, which may have been executed by the server.
+	Idempotent bool
+	//Endpoint is the endpoint to call instead of the ones of the servant, like tcp -h 127.0.0.1 -p 10015.
+	Endpoint string
+	//SetName limits the endpoints to the ones in the set, like app.sz.1 or app.sz.
+	SetName string
+}
+
+//CallOption sets an option of the call.
+type CallOption func(*CallOptions)
+
+//NewCallOptions returns the options set by opts.
+func NewCallOptions(opts ...CallOption) *CallOptions {
+	o := new(CallOptions)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+//OptionServant is the Servant taking all the options of the calls.
+type OptionServant interface {
+	Servant
+	Tars_invokeWithOptions(ctx context.Context, ctype byte,
+		sFuncName string,
+		buf []byte,
+		opts *CallOptions,
+		Resp *requestf.ResponsePacket) error
+}
+
+//Invoke calls the servant with the options. The servants other than OptionServant only take
+//the timeout, the context and the status.
+func Invoke(ctx context.Context, s Servant, ctype byte, sFuncName string, buf []byte,
+	opts *CallOptions, resp *requestf.ResponsePacket) error {
+	if os, ok := s.(OptionServant); ok {
+		return os.Tars_invokeWithOptions(ctx, ctype, sFuncName, buf, opts, resp)
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	return s.Tars_invoke(ctx, ctype, sFuncName, buf, opts.Status, opts.Context, resp)
+}
+
+//CopyBack copies the context and the status of the response into the maps of the options.
+func (o *CallOptions) CopyBack(resp *requestf.ResponsePacket) {
+	if o.Context != nil {
+		for k := range o.Context {
+			delete(o.Context, k)
+		}
+		for k, v := range resp.Context {
+			o.Context[k] = v
+		}
+	}
+	if o.Status != nil {
+		for k := range o.Status {
+			delete(o.Status, k)
+		}
+		for k, v := range resp.Status {
+			o.Status[k] = v
+		}
+	}
+}
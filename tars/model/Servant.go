@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
 )
 
@@ -16,3 +17,19 @@ type Servant interface {
 		Resp *requestf.ResponsePacket) error
 	TarsSetTimeout(t int)
 }
+
+//VersionServant is the Servant speaking the protocol versions other than tars, like TUP and JSON.
+type VersionServant interface {
+	Servant
+	TarsSetVersion(v int16)
+	TarsGetVersion() int16
+}
+
+//GetVersion returns the protocol version of the servant, the servants other than VersionServant
+//only speak tars.
+func GetVersion(s Servant) int16 {
+	if vs, ok := s.(VersionServant); ok {
+		return vs.TarsGetVersion()
+	}
+	return basef.TARSVERSION
+}
@@ -5,56 +5,90 @@ import (
 	"errors"
 	"fmt"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/util/limit"
 	"github.com/TarsCloud/TarsGo/tars/util/rtimer"
 )
 
+// TarsInvokeCanceled is the message status of a call abandoned because its context was done.
+const TarsInvokeCanceled int32 = -13
+
 // ObjectProxy is struct contains proxy information
 type ObjectProxy struct {
-	manager  *EndpointManager
-	comm     *Communicator
-	queueLen int32
+	manager *EndpointManager
+	comm    *Communicator
+	limiter limit.Limiter
 }
 
 // Init proxy
 func (obj *ObjectProxy) Init(comm *Communicator, objName string) {
 	obj.comm = comm
+	obj.limiter = newLimiter(comm.Client, comm.Client.ObjLimit, ObjLimitMin, int(ObjQueueMax))
 	obj.manager = new(EndpointManager)
 	obj.manager.Init(objName, obj.comm)
 }
 
 // Invoke get proxy information
 func (obj *ObjectProxy) Invoke(ctx context.Context, msg *Message, timeout time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		msg.Status = TarsInvokeCanceled
+		return fmt.Errorf("%s|%s|%s", "request canceled", msg.Req.SServantName, err)
+	}
 	adp := obj.manager.SelectAdapterProxy(msg)
 	if adp == nil {
 		return errors.New("no adapter Proxy selected:" + msg.Req.SServantName)
 	}
-	if obj.queueLen > ObjQueueMax {
+	msg.Adp = adp
+	if msg.Req.CPacketType == basef.TARSONEWAY {
+		// oneway requests never get a response, so return once the packet is queued
+		return adp.Send(msg.Req)
+	}
+	if !obj.limiter.Acquire() {
 		return errors.New("invoke queue is full:" + msg.Req.SServantName)
 	}
-	msg.Adp = adp
-	atomic.AddInt32(&obj.queueLen, 1)
+	if !adp.limiter.Acquire() {
+		obj.limiter.Release(0, false)
+		return fmt.Errorf("%s|%s|%s:%d", "adapter queue is full", msg.Req.SServantName, adp.point.Host, adp.point.Port)
+	}
+	var rtt time.Duration
+	var dropped bool
 	readCh := make(chan *requestf.ResponsePacket, 1)
 	adp.resp.Store(msg.Req.IRequestId, readCh)
 	defer func() {
 		checkPanic()
-		atomic.AddInt32(&obj.queueLen, -1)
+		// the latency feeds the limiters, only completed or timed out calls are samples.
+		// The obj limiter is shared by all the endpoints, the timeouts of one endpoint only
+		// back off the limiter of the endpoint.
+		if dropped {
+			obj.limiter.Release(0, false)
+		} else {
+			obj.limiter.Release(rtt, false)
+		}
+		adp.limiter.Release(rtt, dropped)
 		adp.resp.Delete(msg.Req.IRequestId)
 		close(readCh)
 	}()
+	start := time.Now()
 	if err := adp.Send(msg.Req); err != nil {
 		return err
 	}
 	select {
 	case <-rtimer.After(timeout):
 		msg.Status = basef.TARSINVOKETIMEOUT
+		rtt, dropped = timeout, true
+		adp.latency.add(timeout)
 		adp.failAdd()
 		return fmt.Errorf("%s|%s|%d", "request timeout", msg.Req.SServantName, msg.Req.IRequestId)
+	case <-ctx.Done():
+		// the deferred cleanup releases the resp entry, a late response is dropped by Recv
+		msg.Status = TarsInvokeCanceled
+		return fmt.Errorf("%s|%s|%d|%s", "request canceled", msg.Req.SServantName, msg.Req.IRequestId, ctx.Err())
 	case msg.Resp = <-readCh:
+		rtt = time.Since(start)
+		adp.latency.add(rtt)
 		if msg.Resp.IRet != basef.TARSSERVERSUCCESS {
 			return errors.New(msg.Resp.SResultDesc)
 		}
@@ -63,6 +97,33 @@ func (obj *ObjectProxy) Invoke(ctx context.Context, msg *Message, timeout time.D
 	return nil
 }
 
+// newLimiter returns the adaptive limiter starting at the configured limit, or no limiter if the
+// client config disables it.
+func newLimiter(cfg *clientConfig, init, min, max int) limit.Limiter {
+	if cfg.DisableLimit {
+		return limit.NewUnlimited()
+	}
+	if init > max {
+		max = init
+	}
+	return limit.NewVegas(init, min, max)
+}
+
+// Limit returns the current concurrency limit of the proxy, 0 if the limiting is disabled.
+func (obj *ObjectProxy) Limit() int {
+	return obj.limiter.Limit()
+}
+
+// QueueLen returns the number of requests waiting for response.
+func (obj *ObjectProxy) QueueLen() int {
+	return obj.limiter.Inflight()
+}
+
+// Close stops the endpoint refreshing and closes the connections of the proxy.
+func (obj *ObjectProxy) Close() {
+	obj.manager.Close()
+}
+
 // ObjectProxyFactory is a struct contains proxy information(add)
 type ObjectProxyFactory struct {
 	objs map[string]*ObjectProxy
@@ -9,8 +9,11 @@ import (
 	redisLock "github.com/TarsCloud/TarsGo/tars/sync/lock/redis"
 
 	"github.com/TarsCloud/TarsGo/tars/broker"
+	fileBroker "github.com/TarsCloud/TarsGo/tars/broker/file"
 	"github.com/TarsCloud/TarsGo/tars/broker/redis"
 
+	"github.com/TarsCloud/TarsGo/tars/registry"
+
 	"github.com/TarsCloud/TarsGo/tars/data/store"
 	"github.com/TarsCloud/TarsGo/tars/data/store/memory"
 	redisStore "github.com/TarsCloud/TarsGo/tars/data/store/redis"
@@ -41,9 +44,15 @@ func ConfigureWithConfigs(configs map[string]string) {
 	brokerConfig := configs["broker"]
 	if len(brokerConfig) > 0 {
 		var b broker.Broker
-		if strings.HasPrefix(brokerConfig, "redis") {
+		if strings.HasPrefix(brokerConfig, "redis-stream") {
+			rawurl := strings.TrimPrefix(brokerConfig, "redis-stream"+"@")
+			b = redis.NewStreamBroker(broker.Addrs(rawurl))
+		} else if strings.HasPrefix(brokerConfig, "redis") {
 			rawurl := strings.TrimPrefix(brokerConfig, "redis"+"@")
 			b = redis.NewBroker(broker.Addrs(rawurl))
+		} else if strings.HasPrefix(brokerConfig, "file") {
+			dir := strings.TrimPrefix(brokerConfig, "file"+"@")
+			b = fileBroker.NewBroker(broker.Addrs(dir))
 		}
 
 		if b != nil {
@@ -68,6 +77,8 @@ func ConfigureWithConfigs(configs map[string]string) {
 			}))
 
 			opts = append(opts, AfterStop(func() error{
+				// stops the retries of the subscribers before the broker waits for them
+				BrokerHelper().unsubscribeAll()
 				err := b.Disconnect()
 				if err != nil {
 					TLOG.Errorf("unload broker error: %v", err)
@@ -93,6 +104,20 @@ func ConfigureWithConfigs(configs map[string]string) {
 
 	opts = append(opts, SyncOptions(&syncOpts))
 
+	registryConfig := configs["registry"]
+	if len(registryConfig) > 0 {
+		if r := newRegistry(registryConfig); r != nil {
+			opts = append(opts, Registry(r))
+		}
+	}
+
+	faultConfig := configs["fault"]
+	if len(faultConfig) > 0 {
+		if err := AddFaultRules(faultConfig); err != nil {
+			TLOG.Errorf("load fault rules error: %v", err)
+		}
+	}
+
 	sessionConfig := configs["session"]
 	if len(sessionConfig) > 0 {
 		if strings.HasPrefix(sessionConfig, "redis") {
@@ -122,6 +147,7 @@ type Options struct {
 	broker broker.Broker
 	store  store.Store
 	sessionManager session.Manager
+	registry       registry.Registry
 
 	// Before and After funcs
 	BeforeStart []func() error
@@ -187,6 +213,18 @@ func SessionManager(s session.Manager) Option {
 	}
 }
 
+func (o *Options) Registry() registry.Registry {
+	return o.registry
+}
+
+// Registry sets the registry which the adapters are registered to on Run,
+// and which the communicators find the endpoints from.
+func Registry(r registry.Registry) Option {
+	return func(o *Options) {
+		o.registry = r
+	}
+}
+
 func (o *Options) Lock() lock.Lock {
 	return o.Options.Lock
 }
@@ -0,0 +1,140 @@
+package tars
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const latencyWindow = 128
+
+// latencyStat keeps the EWMA and a window of recent samples of the latency of an endpoint.
+type latencyStat struct {
+	mu      sync.Mutex
+	ewma    float64
+	count   int
+	pos     int
+	samples [latencyWindow]time.Duration
+}
+
+func (l *latencyStat) add(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.count == 0 {
+		l.ewma = float64(d)
+	} else {
+		l.ewma = 0.9*l.ewma + 0.1*float64(d)
+	}
+	l.samples[l.pos] = d
+	l.pos = (l.pos + 1) % latencyWindow
+	l.count++
+}
+
+func (l *latencyStat) get() (time.Duration, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return time.Duration(l.ewma), l.count
+}
+
+// percentile returns the latency percentile of the window, p is in [0, 100].
+func (l *latencyStat) percentile(p float64) time.Duration {
+	l.mu.Lock()
+	n := l.count
+	if n > latencyWindow {
+		n = latencyWindow
+	}
+	window := make([]time.Duration, n)
+	copy(window, l.samples[:n])
+	l.mu.Unlock()
+	if n == 0 {
+		return 0
+	}
+	sort.Slice(window, func(i, j int) bool { return window[i] < window[j] })
+	idx := int(p / 100 * float64(n-1))
+	return window[idx]
+}
+
+func (l *latencyStat) reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.ewma = 0
+	l.count = 0
+	l.pos = 0
+}
+
+// checkOutliers ejects the outliers once every OutlierCheckInterval. It runs in the calls selecting
+// the endpoints, so the manager has no goroutine of its own to stop.
+func (e *EndpointManager) checkOutliers(now time.Time) {
+	last := atomic.LoadInt64(&e.outlierCheck)
+	if now.UnixNano()-last < int64(OutlierCheckInterval) || !atomic.CompareAndSwapInt64(&e.outlierCheck, last, now.UnixNano()) {
+		return
+	}
+	e.ejectOutliers(now)
+}
+
+// ejectOutliers ejects endpoints whose latency is far above the median of their peers.
+func (e *EndpointManager) ejectOutliers(now time.Time) {
+	e.mlock.Lock()
+	adps := make([]*AdapterProxy, 0, len(e.adapters))
+	for _, a := range e.adapters {
+		adps = append(adps, a)
+	}
+	e.mlock.Unlock()
+
+	type candidate struct {
+		adp  *AdapterProxy
+		ewma time.Duration
+	}
+	ejected := 0
+	cands := make([]candidate, 0, len(adps))
+	for _, a := range adps {
+		if a.isEjected(now) {
+			ejected++
+			continue
+		}
+		ewma, count := a.latency.get()
+		if count >= OutlierMinRequests {
+			cands = append(cands, candidate{a, ewma})
+		}
+	}
+	// the median is meaningless without enough peers
+	if len(cands) < 3 {
+		return
+	}
+	sort.Slice(cands, func(i, j int) bool { return cands[i].ewma > cands[j].ewma })
+	median := cands[len(cands)/2].ewma
+	maxEject := len(adps) * OutlierMaxEjectPercent / 100
+	for _, c := range cands {
+		if ejected >= maxEject {
+			break
+		}
+		if c.ewma < OutlierMinLatency || float64(c.ewma) < float64(median)*OutlierLatencyFactor {
+			break
+		}
+		TLOG.Infof("eject outlier %s:%d of %s, latency %v, median %v", c.adp.point.Host, c.adp.point.Port, e.objName, c.ewma, median)
+		c.adp.eject(now.Add(OutlierEjectTime))
+		ejected++
+	}
+}
+
+// LatencyEWMA returns the exponentially weighted moving average of the latency of the endpoint.
+func (c *AdapterProxy) LatencyEWMA() time.Duration {
+	ewma, _ := c.latency.get()
+	return ewma
+}
+
+// LatencyPercentile returns the p percentile of the recent latency of the endpoint, p is in [0, 100].
+func (c *AdapterProxy) LatencyPercentile(p float64) time.Duration {
+	return c.latency.percentile(p)
+}
+
+func (c *AdapterProxy) eject(until time.Time) {
+	// start over after ejection, otherwise the stale latency ejects it again
+	c.latency.reset()
+	atomic.StoreInt64(&c.ejectedUntil, until.UnixNano())
+}
+
+func (c *AdapterProxy) isEjected(now time.Time) bool {
+	return atomic.LoadInt64(&c.ejectedUntil) > now.UnixNano()
+}
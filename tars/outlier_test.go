@@ -0,0 +1,185 @@
+package tars
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/endpointf"
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
+)
+
+// newTestManager returns a manager of n endpoints with their adapters, which never connect.
+func newTestManager(n int) (*EndpointManager, []endpoint.Endpoint) {
+	e := &EndpointManager{
+		objName:  "App.Server.Obj",
+		adapters: make(map[endpoint.Endpoint]*AdapterProxy),
+		mlock:    new(sync.Mutex),
+	}
+	eps := make([]endpoint.Endpoint, n)
+	for i := range eps {
+		eps[i] = endpoint.Endpoint{Host: fmt.Sprintf("10.0.0.%d", i+1), Port: 10000, Proto: "tcp", SetId: "app.sz.1"}
+		e.adapters[eps[i]] = &AdapterProxy{point: &endpointf.EndpointF{Host: eps[i].Host, Port: eps[i].Port}, status: true}
+		e.index = append(e.index, eps[i])
+	}
+	return e, eps
+}
+
+func TestEjectOutliers(t *testing.T) {
+	ms := time.Millisecond
+	tests := []struct {
+		name     string
+		latency  []time.Duration
+		requests int
+		ejected  []int
+		expected []int
+	}{
+		{name: "no outlier", latency: []time.Duration{20 * ms, 20 * ms, 20 * ms, 20 * ms, 20 * ms}, requests: 50},
+		{name: "over median times factor", latency: []time.Duration{20 * ms, 20 * ms, 20 * ms, 20 * ms, 100 * ms}, requests: 50, expected: []int{4}},
+		{name: "under median times factor", latency: []time.Duration{20 * ms, 20 * ms, 20 * ms, 20 * ms, 50 * ms}, requests: 50},
+		{name: "under min latency", latency: []time.Duration{1 * ms, 1 * ms, 1 * ms, 1 * ms, 5 * ms}, requests: 50},
+		{name: "too few requests", latency: []time.Duration{20 * ms, 20 * ms, 20 * ms, 20 * ms, 100 * ms}, requests: 5},
+		{name: "too few peers", latency: []time.Duration{20 * ms, 100 * ms}, requests: 50},
+		{
+			name:     "max eject percent",
+			latency:  []time.Duration{20 * ms, 20 * ms, 20 * ms, 20 * ms, 20 * ms, 20 * ms, 200 * ms, 300 * ms, 400 * ms, 500 * ms},
+			requests: 50,
+			expected: []int{7, 8, 9},
+		},
+		{
+			name:     "max eject percent with ejected",
+			latency:  []time.Duration{20 * ms, 20 * ms, 20 * ms, 20 * ms, 20 * ms, 20 * ms, 200 * ms, 300 * ms, 400 * ms, 500 * ms},
+			requests: 50,
+			ejected:  []int{0, 1},
+			expected: []int{9},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, eps := newTestManager(len(tt.latency))
+			now := time.Now()
+			for i, d := range tt.latency {
+				for j := 0; j < tt.requests; j++ {
+					e.adapters[eps[i]].latency.add(d)
+				}
+			}
+			for _, i := range tt.ejected {
+				e.adapters[eps[i]].eject(now.Add(OutlierEjectTime))
+			}
+			e.ejectOutliers(now)
+			expected := make(map[int]bool)
+			for _, i := range append(tt.ejected, tt.expected...) {
+				expected[i] = true
+			}
+			for i, ep := range eps {
+				if got := e.adapters[ep].isEjected(now); got != expected[i] {
+					t.Errorf("endpoint %d ejected %v, expected %v", i, got, expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestEjectOutliersReinstate(t *testing.T) {
+	e, eps := newTestManager(5)
+	now := time.Now()
+	for i, ep := range eps {
+		d := 20 * time.Millisecond
+		if i == 4 {
+			d = 100 * time.Millisecond
+		}
+		for j := 0; j < OutlierMinRequests; j++ {
+			e.adapters[ep].latency.add(d)
+		}
+	}
+	e.ejectOutliers(now)
+	adp := e.adapters[eps[4]]
+	if !adp.isEjected(now) {
+		t.Fatal("outlier not ejected")
+	}
+	if _, count := adp.latency.get(); count != 0 {
+		t.Fatalf("latency of ejected endpoint not reset, %d samples", count)
+	}
+	back := now.Add(OutlierEjectTime)
+	if adp.isEjected(back) {
+		t.Fatal("endpoint still ejected after the eject time")
+	}
+	// the stale latency is gone, so the endpoint is not ejected again before new samples
+	e.ejectOutliers(back)
+	if adp.isEjected(back) {
+		t.Fatal("endpoint ejected again without new samples")
+	}
+}
+
+func TestCheckOutliersInterval(t *testing.T) {
+	e, eps := newTestManager(5)
+	now := time.Now()
+	e.checkOutliers(now)
+	for i, ep := range eps {
+		d := 20 * time.Millisecond
+		if i == 4 {
+			d = 100 * time.Millisecond
+		}
+		for j := 0; j < OutlierMinRequests; j++ {
+			e.adapters[ep].latency.add(d)
+		}
+	}
+	e.checkOutliers(now.Add(OutlierCheckInterval / 2))
+	if e.adapters[eps[4]].isEjected(now) {
+		t.Fatal("outliers checked within the interval")
+	}
+	e.checkOutliers(now.Add(OutlierCheckInterval))
+	if !e.adapters[eps[4]].isEjected(now) {
+		t.Fatal("outliers not checked after the interval")
+	}
+}
+
+func TestSelectEjected(t *testing.T) {
+	e, eps := newTestManager(3)
+	ejected := e.adapters[eps[1]]
+	ejected.eject(time.Now().Add(time.Hour))
+
+	if adp := e.GetHashProxy(1); adp != e.adapters[eps[2]] {
+		t.Errorf("hash to ejected endpoint selects %v, expected the next", adp.point)
+	}
+	if adp := e.GetHashProxy(2); adp != e.adapters[eps[2]] {
+		t.Errorf("hash to healthy endpoint selects %v", adp.point)
+	}
+	for i := 0; i < 6; i++ {
+		if adp := e.GetSetProxy("app.sz", 0, false); adp == ejected {
+			t.Fatal("set selects ejected endpoint")
+		}
+	}
+	for i := 0; i < 30; i++ {
+		adp := e.GetNextValidProxy()
+		if adp == nil {
+			t.Fatalf("round robin selects nothing at call %d", i)
+		}
+		if adp == ejected {
+			t.Fatal("round robin selects ejected endpoint")
+		}
+	}
+	if adp := e.GetEndpointProxy(endpoint.Endpoint{Host: eps[1].Host, Port: eps[1].Port, Proto: "tcp"}); adp != nil {
+		t.Error("ejected endpoint selected")
+	}
+
+	// all ejected, the hash keeps to its endpoint
+	for _, ep := range eps {
+		e.adapters[ep].eject(time.Now().Add(time.Hour))
+	}
+	if adp := e.GetHashProxy(1); adp != ejected {
+		t.Errorf("hash with all ejected selects %v", adp.point)
+	}
+	if adp := e.GetNextValidProxy(); adp != nil {
+		t.Errorf("round robin with all ejected selects %v", adp.point)
+	}
+
+	// reinstated, the hash is back to the endpoint
+	for _, ep := range eps {
+		e.adapters[ep].eject(time.Now())
+	}
+	if adp := e.GetHashProxy(1); adp != ejected {
+		t.Errorf("hash to reinstated endpoint selects %v", adp.point)
+	}
+}
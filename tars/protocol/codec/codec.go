@@ -6,9 +6,9 @@ package codec
 import (
 	"bytes"
 	"encoding/binary"
-	"fmt"
 	"io"
 	"math"
+	"sync"
 	"unsafe"
 )
 
@@ -37,8 +37,13 @@ type Buffer struct {
 
 //Reader is wapper of bytes.Reader
 type Reader struct {
-	ref []byte
-	buf *bytes.Reader
+	ref    []byte
+	buf    *bytes.Reader
+	limits Limits
+	depth  int
+	// the position and type of the length of the list or map found last, which is checked when read.
+	lenPos  int
+	lenType byte
 }
 
 //go:nosplit
@@ -81,7 +86,10 @@ func bWriteU64(w *bytes.Buffer, data uint64) error {
 func bReadU8(r *bytes.Reader, data *uint8) error {
 	var err error
 	*data, err = r.ReadByte()
-	return err
+	if err != nil {
+		return errTruncated
+	}
+	return nil
 }
 
 //go:nosplit
@@ -89,9 +97,11 @@ func bReadU16(r *bytes.Reader, data *uint16) error {
 	var b [2]byte
 	var bs []byte
 	bs = b[:]
-	_, err := r.Read(bs)
+	if _, err := io.ReadFull(r, bs); err != nil {
+		return errTruncated
+	}
 	*data = binary.BigEndian.Uint16(bs)
-	return err
+	return nil
 }
 
 //go:nosplit
@@ -99,9 +109,11 @@ func bReadU32(r *bytes.Reader, data *uint32) error {
 	var b [4]byte
 	var bs []byte
 	bs = b[:]
-	_, err := r.Read(bs)
+	if _, err := io.ReadFull(r, bs); err != nil {
+		return errTruncated
+	}
 	*data = binary.BigEndian.Uint32(bs)
-	return err
+	return nil
 }
 
 //go:nosplit
@@ -109,9 +121,11 @@ func bReadU64(r *bytes.Reader, data *uint64) error {
 	var b [8]byte
 	var bs []byte
 	bs = b[:]
-	_, err := r.Read(bs)
+	if _, err := io.ReadFull(r, bs); err != nil {
+		return errTruncated
+	}
 	*data = binary.BigEndian.Uint64(bs)
-	return err
+	return nil
 }
 
 //go:nosplit
@@ -340,19 +354,33 @@ func (b *Reader) Skip(n int) {
 	b.buf.Seek(int64(n), io.SeekCurrent)
 }
 
+// skip skips the next n bytes, fails if there are not enough.
+func (b *Reader) skip(n int) error {
+	if n > b.buf.Len() {
+		return errTruncated
+	}
+	b.Skip(n)
+	return nil
+}
+
 func (b *Reader) skipFieldMap() error {
 	var len int32
 	err := b.Read_int32(&len, 0, true)
 	if err != nil {
 		return err
 	}
+	if err = b.checkLength(len, MAP); err != nil {
+		return err
+	}
 
 	for i := int32(0); i < len*2; i++ {
 		tyCur, _, err := b.readHead()
 		if err != nil {
+			return errTruncated
+		}
+		if err = b.skipField(tyCur); err != nil {
 			return err
 		}
-		b.skipField(tyCur)
 	}
 	return nil
 }
@@ -362,99 +390,95 @@ func (b *Reader) skipFieldList() error {
 	if err != nil {
 		return err
 	}
+	if err = b.checkLength(len, LIST); err != nil {
+		return err
+	}
 	for i := int32(0); i < len; i++ {
 		tyCur, _, err := b.readHead()
 		if err != nil {
+			return errTruncated
+		}
+		if err = b.skipField(tyCur); err != nil {
 			return err
 		}
-		b.skipField(tyCur)
 	}
 	return nil
 }
 func (b *Reader) skipFieldSimpleList() error {
 	tyCur, _, err := b.readHead()
-	if tyCur != BYTE {
-		return fmt.Errorf("simple list need byte head. but get %d", tyCur)
-	}
 	if err != nil {
-		return err
+		return errTruncated
+	}
+	if tyCur != BYTE {
+		return decodeErrorf("simple list need byte head. but get %d", tyCur)
 	}
 	var len int32
 	err = b.Read_int32(&len, 0, true)
 	if err != nil {
 		return err
 	}
+	if len < 0 {
+		return decodeErrorf("invalid length %d", len)
+	}
 
-	b.Skip(int(len))
-	return nil
+	return b.skip(int(len))
 }
 
 func (b *Reader) skipField(ty byte) error {
 	switch ty {
 	case BYTE:
-		b.Skip(1)
-		break
+		return b.skip(1)
 	case SHORT:
-		b.Skip(2)
-		break
+		return b.skip(2)
 	case INT:
-		b.Skip(4)
-		break
+		return b.skip(4)
 	case LONG:
-		b.Skip(8)
-		break
+		return b.skip(8)
 	case FLOAT:
-		b.Skip(4)
-		break
+		return b.skip(4)
 	case DOUBLE:
-		b.Skip(8)
-		break
+		return b.skip(8)
 	case STRING1:
 		data, err := b.buf.ReadByte()
 		if err != nil {
-			return err
+			return errTruncated
 		}
 		l := int(data)
-		b.Skip(l)
-		break
+		return b.skip(l)
 	case STRING4:
 		var l uint32
 		err := bReadU32(b.buf, &l)
 		if err != nil {
 			return err
 		}
+		if l > uint32(b.buf.Len()) {
+			return errTruncated
+		}
 		b.Skip(int(l))
 		break
-	case MAP:
-		err := b.skipFieldMap()
-		if err != nil {
+	case MAP, LIST, STRUCT_BEGIN:
+		if err := b.enter(); err != nil {
 			return err
 		}
-		break
-	case LIST:
-		err := b.skipFieldList()
-		if err != nil {
-			return err
+		defer b.leave()
+		if ty == MAP {
+			return b.skipFieldMap()
+		} else if ty == LIST {
+			return b.skipFieldList()
 		}
-		break
+		return b.SkipToStructEnd()
 	case SIMPLE_LIST:
 		err := b.skipFieldSimpleList()
 		if err != nil {
 			return err
 		}
 		break
-	case STRUCT_BEGIN:
-		err := b.SkipToStructEnd()
-		if err != nil {
-			return err
-		}
-		break
 	case STRUCT_END:
 		break
 	case ZERO_TAG:
 		break
 	default:
-		return fmt.Errorf("invalid type")
+		return decodeErrorf("invalid type %d", ty)
 	}
 	return nil
 }
@@ -484,14 +508,14 @@ func (b *Reader) SkipToNoCheck(tag byte, require bool) (error, bool, byte) {
 		tyCur, tagCur, err := b.readHead()
 		if err != nil {
 			if require {
-				return fmt.Errorf("Can not find Tag %d. But require. %s", tag, err.Error()),
+				return decodeErrorf("Can not find Tag %d. But require. %s", tag, err.Error()),
 					false, tyCur
 			}
 			return nil, false, tyCur
 		}
 		if tyCur == STRUCT_END || tagCur > tag {
 			if require {
-				return fmt.Errorf("Can not find Tag %d. But require. tagCur: %d, tyCur: %d",
+				return decodeErrorf("Can not find Tag %d. But require. tagCur: %d, tyCur: %d",
 					tag, tagCur, tyCur), false, tyCur
 			}
 			// 多读了一个head, 退回去.
@@ -499,6 +523,9 @@ func (b *Reader) SkipToNoCheck(tag byte, require bool) (error, bool, byte) {
 			return nil, false, tyCur
 		}
 		if tagCur == tag {
+			if tyCur == LIST || tyCur == MAP {
+				b.lenPos, b.lenType = b.pos(), tyCur
+			}
 			return nil, true, tyCur
 		}
 
@@ -516,25 +543,54 @@ func (b *Reader) SkipTo(ty, tag byte, require bool) (error, bool) {
 		return err, false
 	}
 	if have && ty != tyCur {
-		return fmt.Errorf("type not match, need %d, bug %d", ty, tyCur), false
+		return decodeErrorf("type not match, need %d, bug %d", ty, tyCur), false
 	}
 	return nil, have
 }
 
 //Read_slice_int8 reads []int8 for the given length and the require or optional sign.
 func (b *Reader) Read_slice_int8(data *[]int8, len int32, require bool) error {
+	if err := b.checkSliceLength(len); err != nil {
+		return err
+	}
 	*data = make([]int8, len)
-	_, err := b.buf.Read(*(*[]uint8)(unsafe.Pointer(data)))
+	_, err := io.ReadFull(b.buf, *(*[]uint8)(unsafe.Pointer(data)))
 	return err
 }
 
 //Read_slice_uint8 reads []uint8 fore the given length and the require or optional sign.
 func (b *Reader) Read_slice_uint8(data *[]uint8, len int32, require bool) error {
+	if err := b.checkSliceLength(len); err != nil {
+		return err
+	}
 	*data = make([]uint8, len)
-	_, err := b.buf.Read(*data)
+	_, err := io.ReadFull(b.buf, *data)
 	return err
 }
 
+//Read_bytes reads []byte for the given length without copying,
+//the data shares the memory with the data of the reader.
+func (b *Reader) Read_bytes(data *[]byte, len int32, require bool) error {
+	if err := b.checkSliceLength(len); err != nil {
+		return err
+	}
+	*data = b.Next(int(len))
+	return nil
+}
+
+func (b *Reader) checkSliceLength(len int32) error {
+	if len < 0 {
+		return decodeErrorf("invalid length %d", len)
+	}
+	if len > b.limits.MaxLength {
+		return decodeErrorf("length %d exceeds the limit %d", len, b.limits.MaxLength)
+	}
+	if int(len) > b.buf.Len() {
+		return errTruncated
+	}
+	return nil
+}
+
 //Read_int8 reads the int8 data for the tag and the require or optional sign.
 func (b *Reader) Read_int8(data *int8, tag byte, require bool) error {
 	err, have, ty := b.SkipToNoCheck(tag, require)
@@ -551,6 +607,8 @@ func (b *Reader) Read_int8(data *int8, tag byte, require bool) error {
 		var tmp uint8
 		err = bReadU8(b.buf, &tmp)
 		*data = int8(tmp)
+	default:
+		err = decodeErrorf("need int8, but type is %d", ty)
 	}
 	return err
 }
@@ -598,6 +656,8 @@ func (b *Reader) Read_int16(data *int16, tag byte, require bool) error {
 		var tmp uint16
 		err = bReadU16(b.buf, &tmp)
 		*data = int16(tmp)
+	default:
+		err = decodeErrorf("need int16, but type is %d", ty)
 	}
 	return err
 }
@@ -612,6 +672,15 @@ func (b *Reader) Read_uint16(data *uint16, tag byte, require bool) error {
 
 //Read_int32 reads the int32 value for the tag and the require or optional sign.
 func (b *Reader) Read_int32(data *int32, tag byte, require bool) error {
+	if b.lenPos >= 0 && b.lenPos == b.pos() {
+		// the length of the list or map found by SkipTo is read
+		ty := b.lenType
+		b.lenPos = -1
+		if err := b.Read_int32(data, tag, require); err != nil {
+			return err
+		}
+		return b.checkLength(*data, ty)
+	}
 	err, have, ty := b.SkipToNoCheck(tag, require)
 	if err != nil {
 		return err
@@ -634,6 +703,8 @@ func (b *Reader) Read_int32(data *int32, tag byte, require bool) error {
 		var tmp uint32
 		err = bReadU32(b.buf, &tmp)
 		*data = int32(tmp)
+	default:
+		err = decodeErrorf("need int32, but type is %d", ty)
 	}
 	return err
 }
@@ -674,6 +745,8 @@ func (b *Reader) Read_int64(data *int64, tag byte, require bool) error {
 		var tmp uint64
 		err = bReadU64(b.buf, &tmp)
 		*data = int64(tmp)
+	default:
+		err = decodeErrorf("need int64, but type is %d", ty)
 	}
 
 	return err
@@ -725,6 +798,12 @@ func (b *Reader) Read_string(data *string, tag byte, require bool) error {
 		if err != nil {
 			return err
 		}
+		if len > b.limits.MaxStringLength {
+			return decodeErrorf("string length %d exceeds the limit %d", len, b.limits.MaxStringLength)
+		}
+		if len > uint32(b.buf.Len()) {
+			return errTruncated
+		}
 		buff := b.Next(int(len))
 		*data = string(buff)
 	} else if ty == STRING1 {
@@ -733,10 +812,16 @@ func (b *Reader) Read_string(data *string, tag byte, require bool) error {
 		if err != nil {
 			return err
 		}
+		if uint32(len) > b.limits.MaxStringLength {
+			return decodeErrorf("string length %d exceeds the limit %d", len, b.limits.MaxStringLength)
+		}
+		if int(len) > b.buf.Len() {
+			return errTruncated
+		}
 		buff := b.Next(int(len))
 		*data = string(buff)
 	} else {
-		return fmt.Errorf("need string, but type is %d", ty)
+		return decodeErrorf("need string, but type is %d", ty)
 	}
 	return nil
 }
@@ -753,7 +838,15 @@ func (b *Buffer) Grow(size int) {
 
 //NewReader returns *Reader
 func NewReader(data []byte) *Reader {
-	return &Reader{buf: bytes.NewReader(data), ref: data}
+	return &Reader{buf: bytes.NewReader(data), ref: data, limits: DefaultLimits, lenPos: -1}
+}
+
+//Reset resets the reader to read the data.
+func (b *Reader) Reset(data []byte) {
+	b.buf.Reset(data)
+	b.ref = data
+	b.depth = 0
+	b.lenPos = -1
 }
 
 //NewBuffer returns *Buffer
@@ -761,6 +854,29 @@ func NewBuffer() *Buffer {
 	return &Buffer{buf: &bytes.Buffer{}}
 }
 
+// buffers larger than this are not pooled to avoid holding the memory of rare huge packets.
+const maxPooledBufferSize = 1 << 20
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return NewBuffer()
+	},
+}
+
+//AcquireBuffer returns an empty *Buffer from the pool, return it by ReleaseBuffer when done.
+func AcquireBuffer() *Buffer {
+	return bufferPool.Get().(*Buffer)
+}
+
+//ReleaseBuffer returns the buffer to the pool, the buffer and its bytes must not be used after that.
+func ReleaseBuffer(b *Buffer) {
+	if b.buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	b.Reset()
+	bufferPool.Put(b)
+}
+
 //FromInt8 NewReader(FromInt8(vec))
 func FromInt8(vec []int8) []byte {
 	return *(*[]byte)(unsafe.Pointer(&vec))
@@ -0,0 +1,85 @@
+package codec
+
+import (
+	"fmt"
+)
+
+//Limits bounds the data a Reader accepts, so that malformed or hostile data is refused
+//with a DecodeError instead of causing huge allocations or panics.
+type Limits struct {
+	// MaxDepth is the max nesting of structs, lists and maps skipped or decoded by reflection.
+	MaxDepth int
+	// MaxLength is the max count of elements in a list or map, or bytes in a simple list.
+	MaxLength int32
+	// MaxStringLength is the max bytes of a string.
+	MaxStringLength uint32
+}
+
+//DefaultLimits is used by the readers created by NewReader.
+var DefaultLimits = Limits{
+	MaxDepth:        100,
+	MaxLength:       10 * 1024 * 1024,
+	MaxStringLength: 10 * 1024 * 1024,
+}
+
+//DecodeError is the error returned by the Reader for malformed data or data exceeding the limits.
+type DecodeError struct {
+	Msg string
+}
+
+func (e *DecodeError) Error() string {
+	return e.Msg
+}
+
+//IsDecodeError checks if the err is returned for the malformed data.
+func IsDecodeError(err error) bool {
+	_, ok := err.(*DecodeError)
+	return ok
+}
+
+func decodeErrorf(format string, a ...interface{}) error {
+	return &DecodeError{Msg: fmt.Sprintf(format, a...)}
+}
+
+var errTruncated = &DecodeError{Msg: "unexpected end of data"}
+
+//SetLimits sets the limits of the reader.
+func (b *Reader) SetLimits(l Limits) {
+	b.limits = l
+}
+
+func (b *Reader) pos() int {
+	return len(b.ref) - b.buf.Len()
+}
+
+// checkLength checks the length of the list or map against the limits and the remaining data,
+// every element takes at least a head byte.
+func (b *Reader) checkLength(length int32, ty byte) error {
+	if length < 0 {
+		return decodeErrorf("invalid length %d", length)
+	}
+	if length > b.limits.MaxLength {
+		return decodeErrorf("length %d exceeds the limit %d", length, b.limits.MaxLength)
+	}
+	min := int64(length)
+	if ty == MAP {
+		min *= 2
+	}
+	if min > int64(b.buf.Len()) {
+		return decodeErrorf("length %d exceeds the remaining %d bytes", length, b.buf.Len())
+	}
+	return nil
+}
+
+// enter is called before decoding a nested struct, list or map, and leave after it.
+func (b *Reader) enter() error {
+	if b.depth >= b.limits.MaxDepth {
+		return decodeErrorf("nesting exceeds the depth limit %d", b.limits.MaxDepth)
+	}
+	b.depth++
+	return nil
+}
+
+func (b *Reader) leave() {
+	b.depth--
+}
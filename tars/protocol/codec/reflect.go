@@ -0,0 +1,580 @@
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// Marshal encodes the struct v with the tars tags of its fields, like the WriteTo generated by tars2go.
+// A field is encoded with its tag written as `tars:"1"`, or `tars:"1,require"` for a required field,
+// fields without tars tag are ignored. Nested structs, slices, arrays, maps and []byte are supported.
+func Marshal(v interface{}) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, errors.New("tars: marshal nil pointer")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("tars: marshal %s, want a struct", rv.Type())
+	}
+	b := NewBuffer()
+	if err := planOf(rv.Type()).encode(b, rv); err != nil {
+		return nil, err
+	}
+	return b.ToBytes(), nil
+}
+
+// Unmarshal decodes the data encoded by Marshal or a generated WriteTo into the struct pointed by v.
+func Unmarshal(data []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("tars: unmarshal into %T, want a non-nil pointer", v)
+	}
+	rv = rv.Elem()
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("tars: unmarshal into %s, want a struct", rv.Type())
+	}
+	return planOf(rv.Type()).decode(NewReader(data), rv)
+}
+
+type encodeFunc func(b *Buffer, v reflect.Value, tag byte) error
+type decodeFunc func(r *Reader, v reflect.Value, tag byte, require bool) error
+
+type fieldPlan struct {
+	name    string
+	index   int
+	tag     byte
+	require bool
+	enc     encodeFunc
+	dec     decodeFunc
+}
+
+// structPlan is the cached way to encode and decode the fields of a struct type,
+// it is built on first use so that recursive types work.
+type structPlan struct {
+	typ    reflect.Type
+	once   sync.Once
+	fields []fieldPlan
+	err    error
+}
+
+var plans sync.Map // reflect.Type -> *structPlan
+
+func planOf(t reflect.Type) *structPlan {
+	if p, ok := plans.Load(t); ok {
+		return p.(*structPlan)
+	}
+	p, _ := plans.LoadOrStore(t, &structPlan{typ: t})
+	return p.(*structPlan)
+}
+
+func (p *structPlan) build() {
+	seen := make(map[byte]string)
+	for i := 0; i < p.typ.NumField(); i++ {
+		f := p.typ.Field(i)
+		s, ok := f.Tag.Lookup("tars")
+		if !ok || s == "-" || f.PkgPath != "" {
+			continue
+		}
+		opts := strings.Split(s, ",")
+		tag, err := strconv.ParseUint(opts[0], 10, 8)
+		if err != nil {
+			p.err = fmt.Errorf("tars: invalid tag %q of %s.%s", s, p.typ, f.Name)
+			return
+		}
+		if other, ok := seen[byte(tag)]; ok {
+			p.err = fmt.Errorf("tars: tag %d of %s.%s is used by %s", tag, p.typ, f.Name, other)
+			return
+		}
+		seen[byte(tag)] = f.Name
+		fp := fieldPlan{name: f.Name, index: i, tag: byte(tag)}
+		for _, o := range opts[1:] {
+			switch o {
+			case "require":
+				fp.require = true
+			case "optional":
+			default:
+				p.err = fmt.Errorf("tars: invalid option %q of %s.%s", o, p.typ, f.Name)
+				return
+			}
+		}
+		if fp.enc, fp.dec, err = codecOf(f.Type); err != nil {
+			p.err = fmt.Errorf("tars: field %s.%s: %v", p.typ, f.Name, err)
+			return
+		}
+		p.fields = append(p.fields, fp)
+	}
+	// the reader can only move forward
+	sort.Slice(p.fields, func(i, j int) bool { return p.fields[i].tag < p.fields[j].tag })
+}
+
+func (p *structPlan) encode(b *Buffer, v reflect.Value) error {
+	p.once.Do(p.build)
+	if p.err != nil {
+		return p.err
+	}
+	for _, f := range p.fields {
+		if err := f.enc(b, v.Field(f.index), f.tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *structPlan) decode(r *Reader, v reflect.Value) error {
+	p.once.Do(p.build)
+	if p.err != nil {
+		return p.err
+	}
+	for _, f := range p.fields {
+		if err := f.dec(r, v.Field(f.index), f.tag, f.require); err != nil {
+			return fmt.Errorf("%s: %v", f.name, err)
+		}
+	}
+	return nil
+}
+
+// the methods generated by tars2go, the generated types are encoded with them.
+type blockWriter interface {
+	WriteBlock(_os *Buffer, tag byte) error
+}
+
+type blockReader interface {
+	ReadBlock(_is *Reader, tag byte, require bool) error
+}
+
+var (
+	blockWriterType = reflect.TypeOf((*blockWriter)(nil)).Elem()
+	blockReaderType = reflect.TypeOf((*blockReader)(nil)).Elem()
+)
+
+func codecOf(t reflect.Type) (encodeFunc, decodeFunc, error) {
+	pt := reflect.PtrTo(t)
+	if t.Kind() == reflect.Struct && pt.Implements(blockWriterType) && pt.Implements(blockReaderType) {
+		return encodeBlock, decodeBlock, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return encodeBool, decodeBool, nil
+	case reflect.Int8:
+		return encodeInt8, decodeInt8, nil
+	case reflect.Uint8:
+		return encodeUint8, decodeUint8, nil
+	case reflect.Int16:
+		return encodeInt16, decodeInt16, nil
+	case reflect.Uint16:
+		return encodeUint16, decodeUint16, nil
+	case reflect.Int32:
+		return encodeInt32, decodeInt32, nil
+	case reflect.Uint32:
+		return encodeUint32, decodeUint32, nil
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return encodeInt64, decodeInt64, nil
+	case reflect.Float32:
+		return encodeFloat32, decodeFloat32, nil
+	case reflect.Float64:
+		return encodeFloat64, decodeFloat64, nil
+	case reflect.String:
+		return encodeString, decodeString, nil
+	case reflect.Slice:
+		if k := t.Elem().Kind(); k == reflect.Uint8 || k == reflect.Int8 {
+			return encodeBytes, decodeBytes, nil
+		}
+		return listCodec(t)
+	case reflect.Array:
+		return listCodec(t)
+	case reflect.Map:
+		return mapCodec(t)
+	case reflect.Struct:
+		p := planOf(t)
+		return p.encodeStruct, p.decodeStruct, nil
+	case reflect.Ptr:
+		return ptrCodec(t)
+	}
+	return nil, nil, fmt.Errorf("unsupported type %s", t)
+}
+
+func encodeBlock(b *Buffer, v reflect.Value, tag byte) error {
+	if !v.CanAddr() {
+		// values in a map are not addressable
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		v = c
+	}
+	return v.Addr().Interface().(blockWriter).WriteBlock(b, tag)
+}
+
+func decodeBlock(r *Reader, v reflect.Value, tag byte, require bool) error {
+	return v.Addr().Interface().(blockReader).ReadBlock(r, tag, require)
+}
+
+func encodeBool(b *Buffer, v reflect.Value, tag byte) error {
+	return b.Write_bool(v.Bool(), tag)
+}
+
+func decodeBool(r *Reader, v reflect.Value, tag byte, require bool) error {
+	d := v.Bool()
+	err := r.Read_bool(&d, tag, require)
+	v.SetBool(d)
+	return err
+}
+
+func encodeInt8(b *Buffer, v reflect.Value, tag byte) error {
+	return b.Write_int8(int8(v.Int()), tag)
+}
+
+func decodeInt8(r *Reader, v reflect.Value, tag byte, require bool) error {
+	d := int8(v.Int())
+	err := r.Read_int8(&d, tag, require)
+	v.SetInt(int64(d))
+	return err
+}
+
+func encodeUint8(b *Buffer, v reflect.Value, tag byte) error {
+	return b.Write_uint8(uint8(v.Uint()), tag)
+}
+
+func decodeUint8(r *Reader, v reflect.Value, tag byte, require bool) error {
+	d := uint8(v.Uint())
+	err := r.Read_uint8(&d, tag, require)
+	v.SetUint(uint64(d))
+	return err
+}
+
+func encodeInt16(b *Buffer, v reflect.Value, tag byte) error {
+	return b.Write_int16(int16(v.Int()), tag)
+}
+
+func decodeInt16(r *Reader, v reflect.Value, tag byte, require bool) error {
+	d := int16(v.Int())
+	err := r.Read_int16(&d, tag, require)
+	v.SetInt(int64(d))
+	return err
+}
+
+func encodeUint16(b *Buffer, v reflect.Value, tag byte) error {
+	return b.Write_uint16(uint16(v.Uint()), tag)
+}
+
+func decodeUint16(r *Reader, v reflect.Value, tag byte, require bool) error {
+	d := uint16(v.Uint())
+	err := r.Read_uint16(&d, tag, require)
+	v.SetUint(uint64(d))
+	return err
+}
+
+func encodeInt32(b *Buffer, v reflect.Value, tag byte) error {
+	return b.Write_int32(int32(v.Int()), tag)
+}
+
+func decodeInt32(r *Reader, v reflect.Value, tag byte, require bool) error {
+	d := int32(v.Int())
+	err := r.Read_int32(&d, tag, require)
+	v.SetInt(int64(d))
+	return err
+}
+
+func encodeUint32(b *Buffer, v reflect.Value, tag byte) error {
+	return b.Write_uint32(uint32(v.Uint()), tag)
+}
+
+func decodeUint32(r *Reader, v reflect.Value, tag byte, require bool) error {
+	d := uint32(v.Uint())
+	err := r.Read_uint32(&d, tag, require)
+	v.SetUint(uint64(d))
+	return err
+}
+
+func encodeInt64(b *Buffer, v reflect.Value, tag byte) error {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint64:
+		return b.Write_int64(int64(v.Uint()), tag)
+	}
+	return b.Write_int64(v.Int(), tag)
+}
+
+func decodeInt64(r *Reader, v reflect.Value, tag byte, require bool) error {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint64:
+		d := int64(v.Uint())
+		err := r.Read_int64(&d, tag, require)
+		v.SetUint(uint64(d))
+		return err
+	}
+	d := v.Int()
+	err := r.Read_int64(&d, tag, require)
+	v.SetInt(d)
+	return err
+}
+
+func encodeFloat32(b *Buffer, v reflect.Value, tag byte) error {
+	return b.Write_float32(float32(v.Float()), tag)
+}
+
+func decodeFloat32(r *Reader, v reflect.Value, tag byte, require bool) error {
+	d := float32(v.Float())
+	err := r.Read_float32(&d, tag, require)
+	v.SetFloat(float64(d))
+	return err
+}
+
+func encodeFloat64(b *Buffer, v reflect.Value, tag byte) error {
+	return b.Write_float64(v.Float(), tag)
+}
+
+func decodeFloat64(r *Reader, v reflect.Value, tag byte, require bool) error {
+	d := v.Float()
+	err := r.Read_float64(&d, tag, require)
+	v.SetFloat(d)
+	return err
+}
+
+func encodeString(b *Buffer, v reflect.Value, tag byte) error {
+	return b.Write_string(v.String(), tag)
+}
+
+func decodeString(r *Reader, v reflect.Value, tag byte, require bool) error {
+	d := v.String()
+	err := r.Read_string(&d, tag, require)
+	v.SetString(d)
+	return err
+}
+
+// bytes are written as SIMPLE_LIST, like vector<byte> in the generated code.
+func encodeBytes(b *Buffer, v reflect.Value, tag byte) error {
+	if err := b.WriteHead(SIMPLE_LIST, tag); err != nil {
+		return err
+	}
+	if err := b.WriteHead(BYTE, 0); err != nil {
+		return err
+	}
+	if err := b.Write_int32(int32(v.Len()), 0); err != nil {
+		return err
+	}
+	if v.Type().Elem().Kind() == reflect.Int8 {
+		return b.Write_slice_int8(v.Convert(reflect.TypeOf([]int8(nil))).Interface().([]int8))
+	}
+	return b.Write_slice_uint8(v.Convert(reflect.TypeOf([]byte(nil))).Bytes())
+}
+
+func decodeBytes(r *Reader, v reflect.Value, tag byte, require bool) error {
+	err, have, ty := r.SkipToNoCheck(tag, require)
+	if err != nil || !have {
+		return err
+	}
+	var length int32
+	var data []byte
+	switch ty {
+	case SIMPLE_LIST:
+		if err, _ = r.SkipTo(BYTE, 0, true); err != nil {
+			return err
+		}
+		if err = r.Read_int32(&length, 0, true); err != nil {
+			return err
+		}
+		if err = r.Read_slice_uint8(&data, length, true); err != nil {
+			return err
+		}
+	case LIST:
+		if err = r.Read_int32(&length, 0, true); err != nil {
+			return err
+		}
+		data = make([]byte, length)
+		for i := range data {
+			if err = r.Read_uint8(&data[i], 0, true); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("require vector<byte>, but type %d", ty)
+	}
+	if v.Type().Elem().Kind() == reflect.Int8 {
+		v.Set(reflect.ValueOf(*(*[]int8)(unsafe.Pointer(&data))).Convert(v.Type()))
+		return nil
+	}
+	v.Set(reflect.ValueOf(data).Convert(v.Type()))
+	return nil
+}
+
+func listCodec(t reflect.Type) (encodeFunc, decodeFunc, error) {
+	elemEnc, elemDec, err := codecOf(t.Elem())
+	if err != nil {
+		return nil, nil, err
+	}
+	enc := func(b *Buffer, v reflect.Value, tag byte) error {
+		if err := b.WriteHead(LIST, tag); err != nil {
+			return err
+		}
+		if err := b.Write_int32(int32(v.Len()), 0); err != nil {
+			return err
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := elemEnc(b, v.Index(i), 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	dec := func(r *Reader, v reflect.Value, tag byte, require bool) error {
+		err, have := r.SkipTo(LIST, tag, require)
+		if err != nil || !have {
+			return err
+		}
+		var length int32
+		if err = r.Read_int32(&length, 0, true); err != nil {
+			return err
+		}
+		if t.Kind() == reflect.Array {
+			if int(length) != t.Len() {
+				return fmt.Errorf("require %d elements, but %d", t.Len(), length)
+			}
+		} else {
+			if length < 0 {
+				return fmt.Errorf("invalid list length %d", length)
+			}
+			v.Set(reflect.MakeSlice(t, int(length), int(length)))
+		}
+		for i := 0; i < int(length); i++ {
+			if err = elemDec(r, v.Index(i), 0, true); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return enc, dec, nil
+}
+
+func mapCodec(t reflect.Type) (encodeFunc, decodeFunc, error) {
+	keyEnc, keyDec, err := codecOf(t.Key())
+	if err != nil {
+		return nil, nil, err
+	}
+	valEnc, valDec, err := codecOf(t.Elem())
+	if err != nil {
+		return nil, nil, err
+	}
+	enc := func(b *Buffer, v reflect.Value, tag byte) error {
+		if err := b.WriteHead(MAP, tag); err != nil {
+			return err
+		}
+		if err := b.Write_int32(int32(v.Len()), 0); err != nil {
+			return err
+		}
+		keys := v.MapKeys()
+		sortKeys(keys)
+		for _, k := range keys {
+			if err := keyEnc(b, k, 0); err != nil {
+				return err
+			}
+			if err := valEnc(b, v.MapIndex(k), 1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	dec := func(r *Reader, v reflect.Value, tag byte, require bool) error {
+		err, have := r.SkipTo(MAP, tag, require)
+		if err != nil || !have {
+			return err
+		}
+		var length int32
+		if err = r.Read_int32(&length, 0, true); err != nil {
+			return err
+		}
+		if length < 0 {
+			return fmt.Errorf("invalid map length %d", length)
+		}
+		m := reflect.MakeMap(t)
+		for i := int32(0); i < length; i++ {
+			k := reflect.New(t.Key()).Elem()
+			e := reflect.New(t.Elem()).Elem()
+			if err = keyDec(r, k, 0, true); err != nil {
+				return err
+			}
+			if err = valDec(r, e, 1, true); err != nil {
+				return err
+			}
+			m.SetMapIndex(k, e)
+		}
+		v.Set(m)
+		return nil
+	}
+	return enc, dec, nil
+}
+
+// sortKeys sorts the keys of basic kinds so that the encoding is stable.
+func sortKeys(keys []reflect.Value) {
+	if len(keys) == 0 {
+		return
+	}
+	switch keys[0].Kind() {
+	case reflect.String:
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		sort.Slice(keys, func(i, j int) bool { return keys[i].Int() < keys[j].Int() })
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		sort.Slice(keys, func(i, j int) bool { return keys[i].Uint() < keys[j].Uint() })
+	case reflect.Float32, reflect.Float64:
+		sort.Slice(keys, func(i, j int) bool { return keys[i].Float() < keys[j].Float() })
+	}
+}
+
+func (p *structPlan) encodeStruct(b *Buffer, v reflect.Value, tag byte) error {
+	if err := b.WriteHead(STRUCT_BEGIN, tag); err != nil {
+		return err
+	}
+	if err := p.encode(b, v); err != nil {
+		return err
+	}
+	return b.WriteHead(STRUCT_END, 0)
+}
+
+func (p *structPlan) decodeStruct(r *Reader, v reflect.Value, tag byte, require bool) error {
+	err, have := r.SkipTo(STRUCT_BEGIN, tag, require)
+	if err != nil || !have {
+		return err
+	}
+	if err = r.enter(); err != nil {
+		return err
+	}
+	defer r.leave()
+	if err = p.decode(r, v); err != nil {
+		return err
+	}
+	return r.SkipToStructEnd()
+}
+
+// pointers are encoded as the values they point to, nil pointers are omitted.
+func ptrCodec(t reflect.Type) (encodeFunc, decodeFunc, error) {
+	elemEnc, elemDec, err := codecOf(t.Elem())
+	if err != nil {
+		return nil, nil, err
+	}
+	enc := func(b *Buffer, v reflect.Value, tag byte) error {
+		if v.IsNil() {
+			return nil
+		}
+		return elemEnc(b, v.Elem(), tag)
+	}
+	dec := func(r *Reader, v reflect.Value, tag byte, require bool) error {
+		err, have, _ := r.SkipToNoCheck(tag, require)
+		if err != nil || !have {
+			return err
+		}
+		// let the decoder of the value read the head again
+		r.unreadHead(tag)
+		if v.IsNil() {
+			v.Set(reflect.New(t.Elem()))
+		}
+		return elemDec(r, v.Elem(), tag, true)
+	}
+	return enc, dec, nil
+}
@@ -0,0 +1,173 @@
+package codec_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+)
+
+type inner struct {
+	ID   int32  `tars:"0,require"`
+	Name string `tars:"1"`
+}
+
+type node struct {
+	Value int64 `tars:"0"`
+	Next  *node `tars:"1"`
+}
+
+type outer struct {
+	B      bool               `tars:"0"`
+	I8     int8               `tars:"1"`
+	U8     uint8              `tars:"2"`
+	I16    int16              `tars:"3"`
+	U16    uint16             `tars:"4"`
+	I32    int32              `tars:"5"`
+	U32    uint32             `tars:"6"`
+	I64    int64              `tars:"7"`
+	F32    float32            `tars:"8"`
+	F64    float64            `tars:"9"`
+	S      string             `tars:"10"`
+	Bytes  []byte             `tars:"11"`
+	Int8s  []int8             `tars:"12"`
+	Inner  inner              `tars:"13"`
+	List   []inner            `tars:"14"`
+	Map    map[string]inner   `tars:"15"`
+	IntMap map[int32][]string `tars:"16"`
+	Node   *node              `tars:"20"`
+	Skip   string
+	Arr    [2]int32 `tars:"200"`
+}
+
+func TestMarshal(t *testing.T) {
+	in := outer{
+		B: true, I8: -8, U8: 200, I16: -1600, U16: 60000, I32: -320000, U32: 4000000000, I64: -1 << 40,
+		F32: 1.5, F64: 2.25, S: "hello", Bytes: []byte{1, 2, 255}, Int8s: []int8{-1, 2},
+		Inner:  inner{ID: 1, Name: "a"},
+		List:   []inner{{ID: 2}, {ID: 3, Name: "c"}},
+		Map:    map[string]inner{"x": {ID: 4}, "y": {ID: 5}},
+		IntMap: map[int32][]string{1: {"a", "b"}, 2: nil},
+		Node:   &node{Value: 1, Next: &node{Value: 2}},
+		Skip:   "ignored",
+		Arr:    [2]int32{7, 8},
+	}
+	data, err := codec.Marshal(&in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out outer
+	if err := codec.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	in.Skip = ""
+	in.IntMap[2] = []string{}
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("Expected %+v, got %+v", in, out)
+	}
+	again, _ := codec.Marshal(out)
+	if !bytes.Equal(data, again) {
+		t.Error("Expected stable encoding")
+	}
+}
+
+func TestMarshalCompatible(t *testing.T) {
+	type packet struct {
+		IVersion     int16             `tars:"1,require"`
+		CPacketType  int8              `tars:"2,require"`
+		IMessageType int32             `tars:"3,require"`
+		IRequestId   int32             `tars:"4,require"`
+		SServantName string            `tars:"5,require"`
+		SFuncName    string            `tars:"6,require"`
+		SBuffer      []byte            `tars:"7,require"`
+		ITimeout     int32             `tars:"8,require"`
+		Context      map[string]string `tars:"9,require"`
+		Status       map[string]string `tars:"10,require"`
+	}
+	req := requestf.RequestPacket{IVersion: 1, IRequestId: 10, SServantName: "App.Server.Obj", SFuncName: "add",
+		SBuffer: []byte{1, 2, 3}, ITimeout: 3000, Context: map[string]string{"k": "v"}}
+	os := codec.NewBuffer()
+	req.WriteTo(os)
+
+	var p packet
+	if err := codec.Unmarshal(os.ToBytes(), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.SFuncName != "add" || p.IRequestId != 10 || !bytes.Equal(p.SBuffer, []byte{1, 2, 3}) || p.Context["k"] != "v" {
+		t.Fatalf("Unexpected packet %+v", p)
+	}
+	data, err := codec.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, os.ToBytes()) {
+		t.Error("Expected the same encoding as the generated code")
+	}
+
+	// generated types are encoded with their own methods
+	type wrapper struct {
+		Req requestf.RequestPacket `tars:"0"`
+	}
+	data, err = codec.Marshal(wrapper{Req: req})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var w wrapper
+	if err := codec.Unmarshal(data, &w); err != nil {
+		t.Fatal(err)
+	}
+	if w.Req.SFuncName != "add" || len(w.Req.SBuffer) != 3 {
+		t.Errorf("Unexpected request %+v", w.Req)
+	}
+}
+
+func TestUnmarshalOptional(t *testing.T) {
+	type v1 struct {
+		A int32 `tars:"0,require"`
+	}
+	type v2 struct {
+		A int32  `tars:"0,require"`
+		B string `tars:"1"`
+		C *inner `tars:"2"`
+	}
+	data, _ := codec.Marshal(v1{A: 1})
+	out := v2{B: "default"}
+	if err := codec.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.A != 1 || out.B != "default" || out.C != nil {
+		t.Errorf("Unexpected %+v", out)
+	}
+
+	type v3 struct {
+		A int32 `tars:"0,require"`
+		D int32 `tars:"3,require"`
+	}
+	if err := codec.Unmarshal(data, &v3{}); err == nil {
+		t.Error("Expected error for missing required field")
+	}
+}
+
+func TestMarshalInvalid(t *testing.T) {
+	type dup struct {
+		A int32 `tars:"1"`
+		B int32 `tars:"1"`
+	}
+	if _, err := codec.Marshal(dup{}); err == nil {
+		t.Error("Expected error for duplicated tag")
+	}
+	type unsupported struct {
+		C chan int `tars:"1"`
+	}
+	if _, err := codec.Marshal(unsupported{}); err == nil {
+		t.Error("Expected error for unsupported type")
+	}
+	if _, err := codec.Marshal(1); err == nil {
+		t.Error("Expected error for non struct")
+	}
+	if err := codec.Unmarshal(nil, inner{}); err == nil {
+		t.Error("Expected error for non pointer")
+	}
+}
@@ -1,31 +1,62 @@
-//Package adminf comment
+// Package adminf comment
 // This file war generated by tars2go 1.1
 // Generated from AdminF.tars
 package adminf
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	m "github.com/TarsCloud/TarsGo/tars/model"
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/tup"
 	"github.com/TarsCloud/TarsGo/tars/util/current"
-	"github.com/TarsCloud/TarsGo/tars/util/tools"
 )
 
-//AdminF struct
+// AdminF struct
 type AdminF struct {
 	s m.Servant
 }
 
-//Shutdown is the proxy function for the method defined in the tars file, with the context
+// Shutdown is the proxy function for the method defined in the tars file, with the context
 func (_obj *AdminF) Shutdown(_opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -35,26 +66,59 @@ func (_obj *AdminF) Shutdown(_opt ...map[string]string) (err error) {
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
 	err = _obj.s.Tars_invoke(ctx, 0, "shutdown", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return nil
 }
 
-//ShutdownWithContext is the proxy function for the method defined in the tars file, with the context
+// ShutdownWithContext is the proxy function for the method defined in the tars file, with the context
 func (_obj *AdminF) ShutdownWithContext(ctx context.Context, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -68,24 +132,107 @@ func (_obj *AdminF) ShutdownWithContext(ctx context.Context, _opt ...map[string]
 	if err != nil {
 		return err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return nil
 }
 
-//Notify is the proxy function for the method defined in the tars file, with the context
-func (_obj *AdminF) Notify(Command string, _opt ...map[string]string) (ret string, err error) {
+// ShutdownOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *AdminF) ShutdownOneway(_opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(Command, 1)
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "shutdown", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// ShutdownOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *AdminF) ShutdownOnewayWithContext(ctx context.Context, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
 	var _status map[string]string
@@ -96,35 +243,188 @@ func (_obj *AdminF) Notify(Command string, _opt ...map[string]string) (ret strin
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "shutdown", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// Notify is the proxy function for the method defined in the tars file, with the context
+func (_obj *AdminF) Notify(Command string, _opt ...map[string]string) (ret string, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["command"] = Command
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Command, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("command", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Command, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
 	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
 	err = _obj.s.Tars_invoke(ctx, 0, "notify", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_string(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_string(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//NotifyWithContext is the proxy function for the method defined in the tars file, with the context
+// NotifyWithContext is the proxy function for the method defined in the tars file, with the context
 func (_obj *AdminF) NotifyWithContext(ctx context.Context, Command string, _opt ...map[string]string) (ret string, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(Command, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["command"] = Command
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Command, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("command", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Command, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
 	var _status map[string]string
@@ -140,44 +440,236 @@ func (_obj *AdminF) NotifyWithContext(ctx context.Context, Command string, _opt
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_string(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
 	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_string(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//SetServant sets servant for the service.
+// NotifyOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *AdminF) NotifyOneway(Command string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["command"] = Command
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Command, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("command", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Command, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "notify", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// NotifyOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *AdminF) NotifyOnewayWithContext(ctx context.Context, Command string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["command"] = Command
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Command, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("command", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Command, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "notify", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// SetServant sets servant for the service.
 func (_obj *AdminF) SetServant(s m.Servant) {
 	_obj.s = s
 }
 
-//TarsSetTimeout sets the timeout for the servant which is in ms.
+// TarsSetTimeout sets the timeout for the servant which is in ms.
 func (_obj *AdminF) TarsSetTimeout(t int) {
 	_obj.s.TarsSetTimeout(t)
 }
-func setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
+
+// TarsSetVersion sets the protocol version of the servant, basef.TUPVERSION for the servants speaking TUP,
+// basef.JSONVERSION for encoding the arguments as a JSON object. The servants other than m.VersionServant
+// only speak tars, it takes no effect on them.
+func (_obj *AdminF) TarsSetVersion(v int16) {
+	if vs, ok := _obj.s.(m.VersionServant); ok {
+		vs.TarsSetVersion(v)
+	}
+}
+func (_obj *AdminF) setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
 	if l == 1 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
 	} else if l == 2 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
-		for k := range sts {
+		for k, _ := range sts {
 			delete(sts, k)
 		}
 		for k, v := range res.Status {
@@ -195,10 +687,11 @@ type _impAdminFWithContext interface {
 	Notify(ctx context.Context, Command string) (ret string, err error)
 }
 
-func shutdown(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func shutdown(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	if withContext == false {
 		_imp := _val.(_impAdminF)
 		err = _imp.Shutdown()
@@ -216,16 +709,37 @@ func shutdown(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *cod
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func notify(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func notify(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var Command string
-	err = _is.Read_string(&Command, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["command"]; ok {
+			err = json.Unmarshal(_raw_, &Command)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("command", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Command, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Command, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	if withContext == false {
 		_imp := _val.(_impAdminF)
@@ -233,10 +747,21 @@ func notify(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_string(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_string(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_string(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impAdminFWithContext)
@@ -244,31 +769,70 @@ func notify(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_string(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_string(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_string(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
 
-//Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
+// Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
 func (_obj *AdminF) Dispatch(ctx context.Context, _val interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool) (err error) {
-	_is := codec.NewReader(tools.Int8ToByte(req.SBuffer))
+	_is := codec.NewReader(req.SBuffer)
 	_os := codec.NewBuffer()
+	var _reqTup_, _rspTup_ *tup.UniAttribute
+	var _reqJson_ map[string]json.RawMessage
+	var _rspJson_ map[string]interface{}
+	switch req.IVersion {
+	case basef.TUPVERSION:
+		// TUP requests carry the arguments by name
+		_reqTup_ = tup.NewUniAttribute()
+		err = _reqTup_.Decode(_is)
+		if err != nil {
+			return err
+		}
+		_rspTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		// JSON requests carry the arguments as an object by name
+		_reqJson_ = make(map[string]json.RawMessage)
+		if len(req.SBuffer) > 0 {
+			err = json.Unmarshal(req.SBuffer, &_reqJson_)
+			if err != nil {
+				return err
+			}
+			if _reqJson_ == nil {
+				// a null object has no vars
+				_reqJson_ = make(map[string]json.RawMessage)
+			}
+		}
+		_rspJson_ = make(map[string]interface{})
+	}
 	switch req.SFuncName {
 	case "shutdown":
-		err := shutdown(ctx, _val, _os, _is, withContext)
+		err := shutdown(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "notify":
-		err := notify(ctx, _val, _os, _is, withContext)
+		err := notify(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
@@ -276,6 +840,25 @@ func (_obj *AdminF) Dispatch(ctx context.Context, _val interface{}, req *request
 	default:
 		return fmt.Errorf("func mismatch")
 	}
+	if _rspTup_ != nil {
+		_os.Reset()
+		err = _rspTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _rspJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_rspJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
 	var _status map[string]string
 	s, ok := current.GetResponseStatus(ctx)
 	if ok && s != nil {
@@ -287,12 +870,12 @@ func (_obj *AdminF) Dispatch(ctx context.Context, _val interface{}, req *request
 		_context = c
 	}
 	*resp = requestf.ResponsePacket{
-		IVersion:     1,
+		IVersion:     req.IVersion,
 		CPacketType:  0,
 		IRequestId:   req.IRequestId,
 		IMessageType: 0,
 		IRet:         0,
-		SBuffer:      tools.ByteToInt8(_os.ToBytes()),
+		SBuffer:      _os.ToBytes(),
 		Status:       _status,
 		SResultDesc:  "",
 		Context:      _context,
@@ -1,12 +1,13 @@
-//Package basef comment
+// Package basef comment
 // This file war generated by tars2go 1.1
 // Generated from BaseF.tars
 package basef
 
-//const as define in tars file
+// const as define in tars file
 const (
 	TARSVERSION             int16 = 0x01
 	TUPVERSION              int16 = 0x03
+	JSONVERSION             int16 = 0x05
 	TARSNORMAL              int8  = 0x00
 	TARSONEWAY              int8  = 0x01
 	TARSSERVERSUCCESS       int32 = 0
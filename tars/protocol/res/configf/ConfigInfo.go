@@ -1,6 +1,6 @@
-//Package configf comment
+// Package configf comment
 // This file war generated by tars2go 1.1
-// Generated from Config.tars
+// Generated from ConfigF.tars
 package configf
 
 import (
@@ -8,23 +8,21 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//ConfigInfo strcut implement
+// ConfigInfo strcut implement
 type ConfigInfo struct {
-	Appname       string `json:"appname"`
-	Servername    string `json:"servername"`
-	Filename      string `json:"filename"`
-	BAppOnly      bool   `json:"bAppOnly"`
-	Host          string `json:"host"`
-	Setdivision   string `json:"setdivision"`
-	Containername string `json:"containername"`
+	Appname     string `json:"appname"`
+	Servername  string `json:"servername"`
+	Filename    string `json:"filename"`
+	BAppOnly    bool   `json:"bAppOnly"`
+	Host        string `json:"host"`
+	Setdivision string `json:"setdivision"`
 }
 
 func (st *ConfigInfo) resetDefault() {
 	st.BAppOnly = false
-	st.Containername = ""
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *ConfigInfo) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -62,18 +60,13 @@ func (st *ConfigInfo) ReadFrom(_is *codec.Reader) error {
 		return err
 	}
 
-	err = _is.Read_string(&st.Containername, 6, false)
-	if err != nil {
-		return err
-	}
-
 	_ = length
 	_ = have
 	_ = ty
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *ConfigInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -101,7 +94,7 @@ func (st *ConfigInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *ConfigInfo) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -135,15 +128,10 @@ func (st *ConfigInfo) WriteTo(_os *codec.Buffer) error {
 		return err
 	}
 
-	err = _os.Write_string(st.Containername, 6)
-	if err != nil {
-		return err
-	}
-
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *ConfigInfo) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
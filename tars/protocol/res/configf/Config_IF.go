@@ -1,41 +1,92 @@
-//Package configf comment
+// Package configf comment
 // This file war generated by tars2go 1.1
-// Generated from Config.tars
+// Generated from ConfigF.tars
 package configf
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-
 	m "github.com/TarsCloud/TarsGo/tars/model"
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/tup"
 	"github.com/TarsCloud/TarsGo/tars/util/current"
-	"github.com/TarsCloud/TarsGo/tars/util/tools"
 )
 
-//Config struct
+// Config struct
 type Config struct {
 	s m.Servant
 }
 
-//ListConfig is the proxy function for the method defined in the tars file, with the context
+// ListConfig is the proxy function for the method defined in the tars file, with the context
 func (_obj *Config) ListConfig(App string, Server string, Vf *[]string, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(App, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err = _os.Write_string(Server, 2)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -45,69 +96,204 @@ func (_obj *Config) ListConfig(App string, Server string, Vf *[]string, _opt ...
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
 	err = _obj.s.Tars_invoke(ctx, 0, "ListConfig", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err, _, ty = _is.SkipToNoCheck(3, true)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
 	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-		(*Vf) = make([]string, length, length)
-		for i0, e0 := int32(0), length; i0 < e0; i0++ {
-
-			err = _is.Read_string(&(*Vf)[i0], 0, false)
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["vf"]; ok {
+			err = json.Unmarshal(_raw_, &(*Vf))
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("vf", &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i0, e0 := int32(0), length; i0 < e0; i0++ {
+
+				err = _is.Read_string(&(*Vf)[i0], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err, _, ty = _is.SkipToNoCheck(3, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i1, e1 := int32(0), length; i1 < e1; i1++ {
+
+				err = _is.Read_string(&(*Vf)[i1], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//ListConfigWithContext is the proxy function for the method defined in the tars file, with the context
+// ListConfigWithContext is the proxy function for the method defined in the tars file, with the context
 func (_obj *Config) ListConfigWithContext(ctx context.Context, App string, Server string, Vf *[]string, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(App, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err = _os.Write_string(Server, 2)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
 	var _status map[string]string
@@ -123,71 +309,202 @@ func (_obj *Config) ListConfigWithContext(ctx context.Context, App string, Serve
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err, _, ty = _is.SkipToNoCheck(3, true)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
 	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-		(*Vf) = make([]string, length, length)
-		for i1, e1 := int32(0), length; i1 < e1; i1++ {
-
-			err = _is.Read_string(&(*Vf)[i1], 0, false)
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["vf"]; ok {
+			err = json.Unmarshal(_raw_, &(*Vf))
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("vf", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i2, e2 := int32(0), length; i2 < e2; i2++ {
+
+				err = _is.Read_string(&(*Vf)[i2], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err, _, ty = _is.SkipToNoCheck(3, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i3, e3 := int32(0), length; i3 < e3; i3++ {
+
+				err = _is.Read_string(&(*Vf)[i3], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//LoadConfig is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) LoadConfig(App string, Server string, Filename string, Config *string, _opt ...map[string]string) (ret int32, err error) {
+// ListConfigOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Config) ListConfigOneway(App string, Server string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(App, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _os.Write_string(Server, 2)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _os.Write_string(Filename, 3)
-	if err != nil {
-		return ret, err
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -196,50 +513,355 @@ func (_obj *Config) LoadConfig(App string, Server string, Filename string, Confi
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "loadConfig", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err = _is.Read_string(&(*Config), 4, true)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "ListConfig", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//LoadConfigWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) LoadConfigWithContext(ctx context.Context, App string, Server string, Filename string, Config *string, _opt ...map[string]string) (ret int32, err error) {
+// ListConfigOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Config) ListConfigOnewayWithContext(ctx context.Context, App string, Server string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(App, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _os.Write_string(Server, 2)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _os.Write_string(Filename, 3)
-	if err != nil {
-		return ret, err
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "ListConfig", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// LoadConfig is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) LoadConfig(App string, Server string, Filename string, Config *string, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 3)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "loadConfig", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["config"]; ok {
+			err = json.Unmarshal(_raw_, &(*Config))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("config", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&(*Config), 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_string(&(*Config), 4, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// LoadConfigWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) LoadConfigWithContext(ctx context.Context, App string, Server string, Filename string, Config *string, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 3)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
 	var _status map[string]string
@@ -255,46 +877,1961 @@ func (_obj *Config) LoadConfigWithContext(ctx context.Context, App string, Serve
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["config"]; ok {
+			err = json.Unmarshal(_raw_, &(*Config))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("config", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&(*Config), 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_string(&(*Config), 4, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// LoadConfigOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Config) LoadConfigOneway(App string, Server string, Filename string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "loadConfig", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// LoadConfigOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Config) LoadConfigOnewayWithContext(ctx context.Context, App string, Server string, Filename string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "loadConfig", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// LoadConfigByHost is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) LoadConfigByHost(AppServerName string, Filename string, Host string, Config *string, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["appServerName"] = AppServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(AppServerName, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("appServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(AppServerName, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["host"] = Host
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Host, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("host", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Host, 3)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "loadConfigByHost", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["config"]; ok {
+			err = json.Unmarshal(_raw_, &(*Config))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("config", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&(*Config), 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_string(&(*Config), 4, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// LoadConfigByHostWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) LoadConfigByHostWithContext(ctx context.Context, AppServerName string, Filename string, Host string, Config *string, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["appServerName"] = AppServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(AppServerName, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("appServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(AppServerName, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["host"] = Host
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Host, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("host", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Host, 3)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "loadConfigByHost", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["config"]; ok {
+			err = json.Unmarshal(_raw_, &(*Config))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("config", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&(*Config), 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_string(&(*Config), 4, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// LoadConfigByHostOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Config) LoadConfigByHostOneway(AppServerName string, Filename string, Host string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["appServerName"] = AppServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(AppServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("appServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(AppServerName, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["host"] = Host
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Host, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("host", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Host, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "loadConfigByHost", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// LoadConfigByHostOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Config) LoadConfigByHostOnewayWithContext(ctx context.Context, AppServerName string, Filename string, Host string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["appServerName"] = AppServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(AppServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("appServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(AppServerName, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["host"] = Host
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Host, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("host", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Host, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "loadConfigByHost", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// CheckConfig is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) CheckConfig(AppServerName string, Filename string, Host string, Result *string, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["appServerName"] = AppServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(AppServerName, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("appServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(AppServerName, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["host"] = Host
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Host, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("host", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Host, 3)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "checkConfig", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["result"]; ok {
+			err = json.Unmarshal(_raw_, &(*Result))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("result", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&(*Result), 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_string(&(*Result), 4, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// CheckConfigWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) CheckConfigWithContext(ctx context.Context, AppServerName string, Filename string, Host string, Result *string, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["appServerName"] = AppServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(AppServerName, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("appServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(AppServerName, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["host"] = Host
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Host, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("host", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Host, 3)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "checkConfig", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["result"]; ok {
+			err = json.Unmarshal(_raw_, &(*Result))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("result", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&(*Result), 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_string(&(*Result), 4, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// CheckConfigOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Config) CheckConfigOneway(AppServerName string, Filename string, Host string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["appServerName"] = AppServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(AppServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("appServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(AppServerName, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["host"] = Host
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Host, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("host", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Host, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "checkConfig", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// CheckConfigOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Config) CheckConfigOnewayWithContext(ctx context.Context, AppServerName string, Filename string, Host string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["appServerName"] = AppServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(AppServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("appServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(AppServerName, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["filename"] = Filename
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Filename, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("filename", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Filename, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["host"] = Host
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Host, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("host", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Host, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "checkConfig", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// ListConfigByInfo is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) ListConfigByInfo(ConfigInfo *ConfigInfo, Vf *[]string, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "ListConfigByInfo", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["vf"]; ok {
+			err = json.Unmarshal(_raw_, &(*Vf))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("vf", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i4, e4 := int32(0), length; i4 < e4; i4++ {
+
+				err = _is.Read_string(&(*Vf)[i4], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(2, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i5, e5 := int32(0), length; i5 < e5; i5++ {
+
+				err = _is.Read_string(&(*Vf)[i5], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// ListConfigByInfoWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) ListConfigByInfoWithContext(ctx context.Context, ConfigInfo *ConfigInfo, Vf *[]string, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "ListConfigByInfo", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["vf"]; ok {
+			err = json.Unmarshal(_raw_, &(*Vf))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("vf", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i6, e6 := int32(0), length; i6 < e6; i6++ {
+
+				err = _is.Read_string(&(*Vf)[i6], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(2, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i7, e7 := int32(0), length; i7 < e7; i7++ {
+
+				err = _is.Read_string(&(*Vf)[i7], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// ListConfigByInfoOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Config) ListConfigByInfoOneway(ConfigInfo *ConfigInfo, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = _is.Read_string(&(*Config), 4, true)
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "ListConfigByInfo", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//LoadConfigByHost is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) LoadConfigByHost(AppServerName string, Filename string, Host string, Config *string, _opt ...map[string]string) (ret int32, err error) {
+// ListConfigByInfoOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Config) ListConfigByInfoOnewayWithContext(ctx context.Context, ConfigInfo *ConfigInfo, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(AppServerName, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = _os.Write_string(Filename, 2)
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "ListConfigByInfo", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	err = _os.Write_string(Host, 3)
-	if err != nil {
-		return ret, err
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// LoadConfigByInfo is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) LoadConfigByInfo(ConfigInfo *ConfigInfo, Config *string, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -304,49 +2841,139 @@ func (_obj *Config) LoadConfigByHost(AppServerName string, Filename string, Host
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "loadConfigByHost", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, 0, "loadConfigByInfo", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err = _is.Read_string(&(*Config), 4, true)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["config"]; ok {
+			err = json.Unmarshal(_raw_, &(*Config))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("config", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&(*Config), 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_string(&(*Config), 2, true)
+		if err != nil {
+			return ret, err
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//LoadConfigByHostWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) LoadConfigByHostWithContext(ctx context.Context, AppServerName string, Filename string, Host string, Config *string, _opt ...map[string]string) (ret int32, err error) {
+// LoadConfigByInfoWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) LoadConfigByInfoWithContext(ctx context.Context, ConfigInfo *ConfigInfo, Config *string, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(AppServerName, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err = _os.Write_string(Filename, 2)
-	if err != nil {
-		return ret, err
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err = _os.Write_string(Host, 3)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
 	var _status map[string]string
@@ -358,50 +2985,141 @@ func (_obj *Config) LoadConfigByHostWithContext(ctx context.Context, AppServerNa
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "loadConfigByHost", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, 0, "loadConfigByInfo", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err = _is.Read_string(&(*Config), 4, true)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["config"]; ok {
+			err = json.Unmarshal(_raw_, &(*Config))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("config", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&(*Config), 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_string(&(*Config), 2, true)
+		if err != nil {
+			return ret, err
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//CheckConfig is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) CheckConfig(AppServerName string, Filename string, Host string, Result *string, _opt ...map[string]string) (ret int32, err error) {
+// LoadConfigByInfoOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Config) LoadConfigByInfoOneway(ConfigInfo *ConfigInfo, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(AppServerName, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _os.Write_string(Filename, 2)
-	if err != nil {
-		return ret, err
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _os.Write_string(Host, 3)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -410,50 +3128,65 @@ func (_obj *Config) CheckConfig(AppServerName string, Filename string, Host stri
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "checkConfig", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err = _is.Read_string(&(*Result), 4, true)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "loadConfigByInfo", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//CheckConfigWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) CheckConfigWithContext(ctx context.Context, AppServerName string, Filename string, Host string, Result *string, _opt ...map[string]string) (ret int32, err error) {
+// LoadConfigByInfoOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Config) LoadConfigByInfoOnewayWithContext(ctx context.Context, ConfigInfo *ConfigInfo, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(AppServerName, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _os.Write_string(Filename, 2)
-	if err != nil {
-		return ret, err
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _os.Write_string(Host, 3)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
 	var _status map[string]string
@@ -464,41 +3197,69 @@ func (_obj *Config) CheckConfigWithContext(ctx context.Context, AppServerName st
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "checkConfig", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err = _is.Read_string(&(*Result), 4, true)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "loadConfigByInfo", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//ListConfigByInfo is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) ListConfigByInfo(ConfigInfo *ConfigInfo, Vf *[]string, _opt ...map[string]string) (ret int32, err error) {
+// CheckConfigByInfo is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) CheckConfigByInfo(ConfigInfo *ConfigInfo, Result *string, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = ConfigInfo.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -508,64 +3269,139 @@ func (_obj *Config) ListConfigByInfo(ConfigInfo *ConfigInfo, Vf *[]string, _opt
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "ListConfigByInfo", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, 0, "checkConfigByInfo", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err, _, ty = _is.SkipToNoCheck(2, true)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
 	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-		(*Vf) = make([]string, length, length)
-		for i2, e2 := int32(0), length; i2 < e2; i2++ {
-
-			err = _is.Read_string(&(*Vf)[i2], 0, false)
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["result"]; ok {
+			err = json.Unmarshal(_raw_, &(*Result))
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("result", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&(*Result), 0, true)
 		if err != nil {
 			return ret, err
 		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err = _is.Read_string(&(*Result), 2, true)
 		if err != nil {
 			return ret, err
 		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//ListConfigByInfoWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) ListConfigByInfoWithContext(ctx context.Context, ConfigInfo *ConfigInfo, Vf *[]string, _opt ...map[string]string) (ret int32, err error) {
+// CheckConfigByInfoWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) CheckConfigByInfoWithContext(ctx context.Context, ConfigInfo *ConfigInfo, Result *string, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = ConfigInfo.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
 	var _status map[string]string
@@ -577,109 +3413,141 @@ func (_obj *Config) ListConfigByInfoWithContext(ctx context.Context, ConfigInfo
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "ListConfigByInfo", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, 0, "checkConfigByInfo", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err, _, ty = _is.SkipToNoCheck(2, true)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
 	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-		(*Vf) = make([]string, length, length)
-		for i3, e3 := int32(0), length; i3 < e3; i3++ {
-
-			err = _is.Read_string(&(*Vf)[i3], 0, false)
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["result"]; ok {
+			err = json.Unmarshal(_raw_, &(*Result))
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("result", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&(*Result), 0, true)
 		if err != nil {
 			return ret, err
 		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err = _is.Read_string(&(*Result), 2, true)
 		if err != nil {
 			return ret, err
 		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//LoadConfigByInfo is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) LoadConfigByInfo(ConfigInfo *ConfigInfo, Config *string, _opt ...map[string]string) (ret int32, err error) {
+// CheckConfigByInfoOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Config) CheckConfigByInfoOneway(ConfigInfo *ConfigInfo, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = ConfigInfo.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
-	}
-
-	var _status map[string]string
-	var _context map[string]string
-	if len(_opt) == 1 {
-		_context = _opt[0]
-	} else if len(_opt) == 2 {
-		_context = _opt[0]
-		_status = _opt[1]
-	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "loadConfigByInfo", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _is.Read_string(&(*Config), 2, true)
-	if err != nil {
-		return ret, err
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
 	}
-
-	setMap(len(_opt), _resp, _context, _status)
-	_ = length
-	_ = have
-	_ = ty
-	return ret, nil
-}
-
-//LoadConfigByInfoWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) LoadConfigByInfoWithContext(ctx context.Context, ConfigInfo *ConfigInfo, Config *string, _opt ...map[string]string) (ret int32, err error) {
-
-	var length int32
-	var have bool
-	var ty byte
-	_os := codec.NewBuffer()
-	err = ConfigInfo.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -688,39 +3556,65 @@ func (_obj *Config) LoadConfigByInfoWithContext(ctx context.Context, ConfigInfo
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "loadConfigByInfo", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err = _is.Read_string(&(*Config), 2, true)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "checkConfigByInfo", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//CheckConfigByInfo is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) CheckConfigByInfo(ConfigInfo *ConfigInfo, Result *string, _opt ...map[string]string) (ret int32, err error) {
+// CheckConfigByInfoOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Config) CheckConfigByInfoOnewayWithContext(ctx context.Context, ConfigInfo *ConfigInfo, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = ConfigInfo.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
 	var _status map[string]string
@@ -731,42 +3625,69 @@ func (_obj *Config) CheckConfigByInfo(ConfigInfo *ConfigInfo, Result *string, _o
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "checkConfigByInfo", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err = _is.Read_string(&(*Result), 2, true)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "checkConfigByInfo", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//CheckConfigByInfoWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) CheckConfigByInfoWithContext(ctx context.Context, ConfigInfo *ConfigInfo, Result *string, _opt ...map[string]string) (ret int32, err error) {
+// ListAllConfigByInfo is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) ListAllConfigByInfo(ConfigInfo *GetConfigListInfo, Vf *[]string, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = ConfigInfo.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -776,38 +3697,189 @@ func (_obj *Config) CheckConfigByInfoWithContext(ctx context.Context, ConfigInfo
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "checkConfigByInfo", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, 0, "ListAllConfigByInfo", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
 	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["vf"]; ok {
+			err = json.Unmarshal(_raw_, &(*Vf))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("vf", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
 
-	err = _is.Read_string(&(*Result), 2, true)
-	if err != nil {
-		return ret, err
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i8, e8 := int32(0), length; i8 < e8; i8++ {
+
+				err = _is.Read_string(&(*Vf)[i8], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(2, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i9, e9 := int32(0), length; i9 < e9; i9++ {
+
+				err = _is.Read_string(&(*Vf)[i9], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//ListAllConfigByInfo is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) ListAllConfigByInfo(ConfigInfo *GetConfigListInfo, Vf *[]string, _opt ...map[string]string) (ret int32, err error) {
+// ListAllConfigByInfoWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Config) ListAllConfigByInfoWithContext(ctx context.Context, ConfigInfo *GetConfigListInfo, Vf *[]string, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = ConfigInfo.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
 	var _status map[string]string
@@ -819,66 +3891,191 @@ func (_obj *Config) ListAllConfigByInfo(ConfigInfo *GetConfigListInfo, Vf *[]str
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
 	err = _obj.s.Tars_invoke(ctx, 0, "ListAllConfigByInfo", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err, _, ty = _is.SkipToNoCheck(2, true)
-	if err != nil {
-		return ret, err
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
 	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-		(*Vf) = make([]string, length, length)
-		for i4, e4 := int32(0), length; i4 < e4; i4++ {
-
-			err = _is.Read_string(&(*Vf)[i4], 0, false)
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["vf"]; ok {
+			err = json.Unmarshal(_raw_, &(*Vf))
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("vf", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i10, e10 := int32(0), length; i10 < e10; i10++ {
+
+				err = _is.Read_string(&(*Vf)[i10], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err, _, ty = _is.SkipToNoCheck(2, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*Vf) = make([]string, length, length)
+			for i11, e11 := int32(0), length; i11 < e11; i11++ {
+
+				err = _is.Read_string(&(*Vf)[i11], 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//ListAllConfigByInfoWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Config) ListAllConfigByInfoWithContext(ctx context.Context, ConfigInfo *GetConfigListInfo, Vf *[]string, _opt ...map[string]string) (ret int32, err error) {
+// ListAllConfigByInfoOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Config) ListAllConfigByInfoOneway(ConfigInfo *GetConfigListInfo, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = ConfigInfo.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -887,79 +4084,120 @@ func (_obj *Config) ListAllConfigByInfoWithContext(ctx context.Context, ConfigIn
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "ListAllConfigByInfo", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "ListAllConfigByInfo", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	err, _, ty = _is.SkipToNoCheck(2, true)
-	if err != nil {
-		return ret, err
-	}
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// ListAllConfigByInfoOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Config) ListAllConfigByInfoOnewayWithContext(ctx context.Context, ConfigInfo *GetConfigListInfo, _opt ...map[string]string) (err error) {
 
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["configInfo"] = ConfigInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ConfigInfo.WriteBlock(_os, 0)
 		if err != nil {
-			return ret, err
+			return err
 		}
-		(*Vf) = make([]string, length, length)
-		for i5, e5 := int32(0), length; i5 < e5; i5++ {
-
-			err = _is.Read_string(&(*Vf)[i5], 0, false)
-			if err != nil {
-				return ret, err
-			}
+		_reqTup_.PutBuffer("configInfo", _os.ToBytes())
+	} else {
+		err = ConfigInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return err
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
 		if err != nil {
-			return ret, err
+			return err
 		}
-	} else {
-		err = fmt.Errorf("require vector, but not")
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
 		if err != nil {
-			return ret, err
+			return err
 		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "ListAllConfigByInfo", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//SetServant sets servant for the service.
+// SetServant sets servant for the service.
 func (_obj *Config) SetServant(s m.Servant) {
 	_obj.s = s
 }
 
-//TarsSetTimeout sets the timeout for the servant which is in ms.
+// TarsSetTimeout sets the timeout for the servant which is in ms.
 func (_obj *Config) TarsSetTimeout(t int) {
 	_obj.s.TarsSetTimeout(t)
 }
-func setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
+
+// TarsSetVersion sets the protocol version of the servant, basef.TUPVERSION for the servants speaking TUP,
+// basef.JSONVERSION for encoding the arguments as a JSON object. The servants other than m.VersionServant
+// only speak tars, it takes no effect on them.
+func (_obj *Config) TarsSetVersion(v int16) {
+	if vs, ok := _obj.s.(m.VersionServant); ok {
+		vs.TarsSetVersion(v)
+	}
+}
+func (_obj *Config) setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
 	if l == 1 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
 	} else if l == 2 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
-		for k := range sts {
+		for k, _ := range sts {
 			delete(sts, k)
 		}
 		for k, v := range res.Status {
@@ -989,31 +4227,82 @@ type _impConfigWithContext interface {
 	ListAllConfigByInfo(ctx context.Context, ConfigInfo *GetConfigListInfo, Vf *[]string) (ret int32, err error)
 }
 
-func ListConfig(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func ListConfig(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var App string
-	err = _is.Read_string(&App, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["app"]; ok {
+			err = json.Unmarshal(_raw_, &App)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("app", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&App, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&App, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Server string
-	err = _is.Read_string(&Server, 2, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["server"]; ok {
+			err = json.Unmarshal(_raw_, &Server)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("server", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Server, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Server, 2, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Vf []string
+
 	if withContext == false {
 		_imp := _val.(_impConfig)
 		ret, err := _imp.ListConfig(App, Server, &Vf)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impConfigWithContext)
@@ -1021,64 +4310,167 @@ func ListConfig(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *c
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["vf"] = Vf
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.WriteHead(codec.LIST, 3)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(Vf)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range Vf {
+		err = _os.Write_int32(int32(len(Vf)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Vf {
 
-		err = _os.Write_string(v, 0)
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_rspTup_.PutBuffer("vf", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 3)
 		if err != nil {
 			return err
 		}
+		err = _os.Write_int32(int32(len(Vf)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Vf {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func loadConfig(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func loadConfig(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var App string
-	err = _is.Read_string(&App, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["app"]; ok {
+			err = json.Unmarshal(_raw_, &App)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("app", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&App, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&App, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Server string
-	err = _is.Read_string(&Server, 2, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["server"]; ok {
+			err = json.Unmarshal(_raw_, &Server)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("server", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Server, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Server, 2, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Filename string
-	err = _is.Read_string(&Filename, 3, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["filename"]; ok {
+			err = json.Unmarshal(_raw_, &Filename)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("filename", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Filename, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Filename, 3, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Config string
+
 	if withContext == false {
 		_imp := _val.(_impConfig)
 		ret, err := _imp.LoadConfig(App, Server, Filename, &Config)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impConfigWithContext)
@@ -1086,107 +4478,291 @@ func loadConfig(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *c
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["config"] = Config
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Config, 0)
+		if err != nil {
+			return err
+		}
+		_rspTup_.PutBuffer("config", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Config, 4)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.Write_string(Config, 4)
-	if err != nil {
-		return err
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func loadConfigByHost(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func loadConfigByHost(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var AppServerName string
-	err = _is.Read_string(&AppServerName, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["appServerName"]; ok {
+			err = json.Unmarshal(_raw_, &AppServerName)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("appServerName", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&AppServerName, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&AppServerName, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Filename string
-	err = _is.Read_string(&Filename, 2, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["filename"]; ok {
+			err = json.Unmarshal(_raw_, &Filename)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("filename", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Filename, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Filename, 2, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Host string
-	err = _is.Read_string(&Host, 3, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["host"]; ok {
+			err = json.Unmarshal(_raw_, &Host)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("host", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Host, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Host, 3, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Config string
+
 	if withContext == false {
 		_imp := _val.(_impConfig)
 		ret, err := _imp.LoadConfigByHost(AppServerName, Filename, Host, &Config)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		_imp := _val.(_impConfigWithContext)
+		ret, err := _imp.LoadConfigByHost(ctx, AppServerName, Filename, Host, &Config)
+		if err != nil {
+			return err
+		}
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["config"] = Config
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Config, 0)
+		if err != nil {
+			return err
+		}
+		_rspTup_.PutBuffer("config", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Config, 4)
+		if err != nil {
+			return err
+		}
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	return nil
+}
+func checkConfig(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	var AppServerName string
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["appServerName"]; ok {
+			err = json.Unmarshal(_raw_, &AppServerName)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("appServerName", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&AppServerName, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&AppServerName, 1, true)
+		if err != nil {
+			return err
+		}
+	}
+	var Filename string
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["filename"]; ok {
+			err = json.Unmarshal(_raw_, &Filename)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("filename", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Filename, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Filename, 2, true)
+		if err != nil {
+			return err
+		}
+	}
+	var Host string
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["host"]; ok {
+			err = json.Unmarshal(_raw_, &Host)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("host", &_tupBuffer_)
 		if err != nil {
 			return err
 		}
-	} else {
-		_imp := _val.(_impConfigWithContext)
-		ret, err := _imp.LoadConfigByHost(ctx, AppServerName, Filename, Host, &Config)
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Host, 0, true)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+	} else {
+		err = _is.Read_string(&Host, 3, true)
 		if err != nil {
 			return err
 		}
 	}
-
-	err = _os.Write_string(Config, 4)
-	if err != nil {
-		return err
-	}
-
-	_ = length
-	_ = have
-	_ = ty
-	return nil
-}
-func checkConfig(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
-	var length int32
-	var have bool
-	var ty byte
-	var AppServerName string
-	err = _is.Read_string(&AppServerName, 1, true)
-	if err != nil {
-		return err
-	}
-	var Filename string
-	err = _is.Read_string(&Filename, 2, true)
-	if err != nil {
-		return err
-	}
-	var Host string
-	err = _is.Read_string(&Host, 3, true)
-	if err != nil {
-		return err
-	}
 	var Result string
+
 	if withContext == false {
 		_imp := _val.(_impConfig)
 		ret, err := _imp.CheckConfig(AppServerName, Filename, Host, &Result)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impConfigWithContext)
@@ -1194,45 +4770,97 @@ func checkConfig(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["result"] = Result
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Result, 0)
+		if err != nil {
+			return err
+		}
+		_rspTup_.PutBuffer("result", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Result, 4)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.Write_string(Result, 4)
-	if err != nil {
-		return err
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-
-//ListConfigByInfo body function
-func ListConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func ListConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var ConfigInfo ConfigInfo
-	err = ConfigInfo.ReadBlock(_is, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["configInfo"]; ok {
+			err = json.Unmarshal(_raw_, &ConfigInfo)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("configInfo", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = ConfigInfo.ReadBlock(_is, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = ConfigInfo.ReadBlock(_is, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Vf []string
+
 	if withContext == false {
 		_imp := _val.(_impConfig)
 		ret, err := _imp.ListConfigByInfo(&ConfigInfo, &Vf)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impConfigWithContext)
@@ -1240,54 +4868,119 @@ func ListConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffer,
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["vf"] = Vf
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.WriteHead(codec.LIST, 2)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(Vf)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range Vf {
+		err = _os.Write_int32(int32(len(Vf)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Vf {
 
-		err = _os.Write_string(v, 0)
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_rspTup_.PutBuffer("vf", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 2)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Vf)), 0)
 		if err != nil {
 			return err
 		}
+		for _, v := range Vf {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func loadConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func loadConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var ConfigInfo ConfigInfo
-	err = ConfigInfo.ReadBlock(_is, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["configInfo"]; ok {
+			err = json.Unmarshal(_raw_, &ConfigInfo)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("configInfo", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = ConfigInfo.ReadBlock(_is, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = ConfigInfo.ReadBlock(_is, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Config string
+
 	if withContext == false {
 		_imp := _val.(_impConfig)
 		ret, err := _imp.LoadConfigByInfo(&ConfigInfo, &Config)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impConfigWithContext)
@@ -1295,43 +4988,97 @@ func loadConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffer,
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["config"] = Config
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Config, 0)
+		if err != nil {
+			return err
+		}
+		_rspTup_.PutBuffer("config", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Config, 2)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.Write_string(Config, 2)
-	if err != nil {
-		return err
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func checkConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func checkConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var ConfigInfo ConfigInfo
-	err = ConfigInfo.ReadBlock(_is, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["configInfo"]; ok {
+			err = json.Unmarshal(_raw_, &ConfigInfo)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("configInfo", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = ConfigInfo.ReadBlock(_is, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = ConfigInfo.ReadBlock(_is, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Result string
+
 	if withContext == false {
 		_imp := _val.(_impConfig)
 		ret, err := _imp.CheckConfigByInfo(&ConfigInfo, &Result)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impConfigWithContext)
@@ -1339,45 +5086,97 @@ func checkConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffer,
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["result"] = Result
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Result, 0)
+		if err != nil {
+			return err
+		}
+		_rspTup_.PutBuffer("result", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Result, 2)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.Write_string(Result, 2)
-	if err != nil {
-		return err
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-
-//ListAllConfigByInfo body function
-func ListAllConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func ListAllConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var ConfigInfo GetConfigListInfo
-	err = ConfigInfo.ReadBlock(_is, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["configInfo"]; ok {
+			err = json.Unmarshal(_raw_, &ConfigInfo)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("configInfo", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = ConfigInfo.ReadBlock(_is, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = ConfigInfo.ReadBlock(_is, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Vf []string
+
 	if withContext == false {
 		_imp := _val.(_impConfig)
 		ret, err := _imp.ListAllConfigByInfo(&ConfigInfo, &Vf)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impConfigWithContext)
@@ -1385,77 +5184,137 @@ func ListAllConfigByInfo(ctx context.Context, _val interface{}, _os *codec.Buffe
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["vf"] = Vf
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.WriteHead(codec.LIST, 2)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(Vf)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range Vf {
+		err = _os.Write_int32(int32(len(Vf)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Vf {
 
-		err = _os.Write_string(v, 0)
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_rspTup_.PutBuffer("vf", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 2)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Vf)), 0)
 		if err != nil {
 			return err
 		}
+		for _, v := range Vf {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
 
-//Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
+// Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
 func (_obj *Config) Dispatch(ctx context.Context, _val interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool) (err error) {
-	_is := codec.NewReader(tools.Int8ToByte(req.SBuffer))
+	_is := codec.NewReader(req.SBuffer)
 	_os := codec.NewBuffer()
+	var _reqTup_, _rspTup_ *tup.UniAttribute
+	var _reqJson_ map[string]json.RawMessage
+	var _rspJson_ map[string]interface{}
+	switch req.IVersion {
+	case basef.TUPVERSION:
+		// TUP requests carry the arguments by name
+		_reqTup_ = tup.NewUniAttribute()
+		err = _reqTup_.Decode(_is)
+		if err != nil {
+			return err
+		}
+		_rspTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		// JSON requests carry the arguments as an object by name
+		_reqJson_ = make(map[string]json.RawMessage)
+		if len(req.SBuffer) > 0 {
+			err = json.Unmarshal(req.SBuffer, &_reqJson_)
+			if err != nil {
+				return err
+			}
+			if _reqJson_ == nil {
+				// a null object has no vars
+				_reqJson_ = make(map[string]json.RawMessage)
+			}
+		}
+		_rspJson_ = make(map[string]interface{})
+	}
 	switch req.SFuncName {
 	case "ListConfig":
-		err := ListConfig(ctx, _val, _os, _is, withContext)
+		err := ListConfig(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "loadConfig":
-		err := loadConfig(ctx, _val, _os, _is, withContext)
+		err := loadConfig(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "loadConfigByHost":
-		err := loadConfigByHost(ctx, _val, _os, _is, withContext)
+		err := loadConfigByHost(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "checkConfig":
-		err := checkConfig(ctx, _val, _os, _is, withContext)
+		err := checkConfig(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "ListConfigByInfo":
-		err := ListConfigByInfo(ctx, _val, _os, _is, withContext)
+		err := ListConfigByInfo(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "loadConfigByInfo":
-		err := loadConfigByInfo(ctx, _val, _os, _is, withContext)
+		err := loadConfigByInfo(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "checkConfigByInfo":
-		err := checkConfigByInfo(ctx, _val, _os, _is, withContext)
+		err := checkConfigByInfo(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "ListAllConfigByInfo":
-		err := ListAllConfigByInfo(ctx, _val, _os, _is, withContext)
+		err := ListAllConfigByInfo(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
@@ -1463,6 +5322,25 @@ func (_obj *Config) Dispatch(ctx context.Context, _val interface{}, req *request
 	default:
 		return fmt.Errorf("func mismatch")
 	}
+	if _rspTup_ != nil {
+		_os.Reset()
+		err = _rspTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _rspJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_rspJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
 	var _status map[string]string
 	s, ok := current.GetResponseStatus(ctx)
 	if ok && s != nil {
@@ -1474,12 +5352,12 @@ func (_obj *Config) Dispatch(ctx context.Context, _val interface{}, req *request
 		_context = c
 	}
 	*resp = requestf.ResponsePacket{
-		IVersion:     1,
+		IVersion:     req.IVersion,
 		CPacketType:  0,
 		IRequestId:   req.IRequestId,
 		IMessageType: 0,
 		IRet:         0,
-		SBuffer:      tools.ByteToInt8(_os.ToBytes()),
+		SBuffer:      _os.ToBytes(),
 		Status:       _status,
 		SResultDesc:  "",
 		Context:      _context,
@@ -1,6 +1,6 @@
-//Package configf comment
+// Package configf comment
 // This file war generated by tars2go 1.1
-// Generated from Config.tars
+// Generated from ConfigF.tars
 package configf
 
 import (
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//GetConfigListInfo strcut implement
+// GetConfigListInfo strcut implement
 type GetConfigListInfo struct {
 	Appname       string `json:"appname"`
 	Servername    string `json:"servername"`
@@ -25,7 +25,7 @@ func (st *GetConfigListInfo) resetDefault() {
 	st.Containername = ""
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *GetConfigListInfo) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -69,7 +69,7 @@ func (st *GetConfigListInfo) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *GetConfigListInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -97,7 +97,7 @@ func (st *GetConfigListInfo) ReadBlock(_is *codec.Reader, tag byte, require bool
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *GetConfigListInfo) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -134,7 +134,7 @@ func (st *GetConfigListInfo) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *GetConfigListInfo) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
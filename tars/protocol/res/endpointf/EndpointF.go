@@ -1,4 +1,4 @@
-//Package endpointf comment
+// Package endpointf comment
 // This file war generated by tars2go 1.1
 // Generated from EndpointF.tars
 package endpointf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//EndpointF strcut implement
+// EndpointF strcut implement
 type EndpointF struct {
 	Host        string `json:"host"`
 	Port        int32  `json:"port"`
@@ -23,12 +23,13 @@ type EndpointF struct {
 	Weight      int32  `json:"weight"`
 	WeightType  int32  `json:"weightType"`
 	AuthType    int32  `json:"authType"`
+	Encrypt     int32  `json:"encrypt"`
 }
 
 func (st *EndpointF) resetDefault() {
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *EndpointF) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -101,13 +102,18 @@ func (st *EndpointF) ReadFrom(_is *codec.Reader) error {
 		return err
 	}
 
+	err = _is.Read_int32(&st.Encrypt, 14, false)
+	if err != nil {
+		return err
+	}
+
 	_ = length
 	_ = have
 	_ = ty
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *EndpointF) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -135,7 +141,7 @@ func (st *EndpointF) ReadBlock(_is *codec.Reader, tag byte, require bool) error
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *EndpointF) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -204,10 +210,15 @@ func (st *EndpointF) WriteTo(_os *codec.Buffer) error {
 		return err
 	}
 
+	err = _os.Write_int32(st.Encrypt, 14)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *EndpointF) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
@@ -1,4 +1,4 @@
-//Package logf comment
+// Package logf comment
 // This file war generated by tars2go 1.1
 // Generated from LogF.tars
 package logf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//LogInfo strcut implement
+// LogInfo strcut implement
 type LogInfo struct {
 	Appname           string `json:"appname"`
 	Servername        string `json:"servername"`
@@ -32,7 +32,7 @@ func (st *LogInfo) resetDefault() {
 	st.SLogType = ""
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *LogInfo) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -101,7 +101,7 @@ func (st *LogInfo) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *LogInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -129,7 +129,7 @@ func (st *LogInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *LogInfo) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -191,7 +191,7 @@ func (st *LogInfo) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *LogInfo) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
@@ -1,66 +1,736 @@
-//Package logf comment
+// Package logf comment
 // This file war generated by tars2go 1.1
 // Generated from LogF.tars
 package logf
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	m "github.com/TarsCloud/TarsGo/tars/model"
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/tup"
 	"github.com/TarsCloud/TarsGo/tars/util/current"
-	"github.com/TarsCloud/TarsGo/tars/util/tools"
 )
 
-//Log struct
+// Log struct
 type Log struct {
 	s m.Servant
 }
 
-//Logger is the proxy function for the method defined in the tars file, with the context
+// Logger is the proxy function for the method defined in the tars file, with the context
 func (_obj *Log) Logger(App string, Server string, File string, Format string, Buffer []string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(App, 1)
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["file"] = File
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(File, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("file", _os.ToBytes())
+	} else {
+		err = _os.Write_string(File, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["format"] = Format
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Format, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("format", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Format, 4)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["buffer"] = Buffer
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("buffer", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 5)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "logger", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return nil
+}
+
+// LoggerWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Log) LoggerWithContext(ctx context.Context, App string, Server string, File string, Format string, Buffer []string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["file"] = File
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(File, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("file", _os.ToBytes())
+	} else {
+		err = _os.Write_string(File, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["format"] = Format
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Format, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("format", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Format, 4)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["buffer"] = Buffer
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("buffer", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 5)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "logger", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return nil
+}
+
+// LoggerOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Log) LoggerOneway(App string, Server string, File string, Format string, Buffer []string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["file"] = File
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(File, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("file", _os.ToBytes())
+	} else {
+		err = _os.Write_string(File, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["format"] = Format
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Format, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("format", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Format, 4)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["buffer"] = Buffer
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("buffer", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 5)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "logger", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
 		return err
 	}
 
-	err = _os.Write_string(Server, 2)
-	if err != nil {
-		return err
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// LoggerOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Log) LoggerOnewayWithContext(ctx context.Context, App string, Server string, File string, Format string, Buffer []string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["server"] = Server
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Server, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("server", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Server, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["file"] = File
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(File, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("file", _os.ToBytes())
+	} else {
+		err = _os.Write_string(File, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["format"] = Format
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Format, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("format", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Format, 4)
+		if err != nil {
+			return err
+		}
 	}
+	if _reqJson_ != nil {
+		_reqJson_["buffer"] = Buffer
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
 
-	err = _os.Write_string(File, 3)
-	if err != nil {
-		return err
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("buffer", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 5)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = _os.Write_string(Format, 4)
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "logger", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
 		return err
 	}
 
-	err = _os.WriteHead(codec.LIST, 5)
-	if err != nil {
-		return err
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// LoggerbyInfo is the proxy function for the method defined in the tars file, with the context
+func (_obj *Log) LoggerbyInfo(Info *LogInfo, Buffer []string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-	err = _os.Write_int32(int32(len(Buffer)), 0)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		_reqJson_["info"] = Info
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = Info.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("info", _os.ToBytes())
+	} else {
+		err = Info.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
 	}
-	for _, v := range Buffer {
+	if _reqJson_ != nil {
+		_reqJson_["buffer"] = Buffer
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("buffer", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 2)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
 
-		err = _os.Write_string(v, 0)
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
 		if err != nil {
 			return err
 		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -70,62 +740,225 @@ func (_obj *Log) Logger(App string, Server string, File string, Format string, B
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "logger", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, 0, "loggerbyInfo", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return nil
 }
 
-//LoggerWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Log) LoggerWithContext(ctx context.Context, App string, Server string, File string, Format string, Buffer []string, _opt ...map[string]string) (err error) {
+// LoggerbyInfoWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Log) LoggerbyInfoWithContext(ctx context.Context, Info *LogInfo, Buffer []string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(App, 1)
-	if err != nil {
-		return err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-
-	err = _os.Write_string(Server, 2)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		_reqJson_["info"] = Info
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = Info.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("info", _os.ToBytes())
+	} else {
+		err = Info.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
 	}
+	if _reqJson_ != nil {
+		_reqJson_["buffer"] = Buffer
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
 
-	err = _os.Write_string(File, 3)
-	if err != nil {
-		return err
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("buffer", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 2)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = _os.Write_string(Format, 4)
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "loggerbyInfo", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	err = _os.WriteHead(codec.LIST, 5)
-	if err != nil {
-		return err
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return nil
+}
+
+// LoggerbyInfoOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Log) LoggerbyInfoOneway(Info *LogInfo, Buffer []string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-	err = _os.Write_int32(int32(len(Buffer)), 0)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		_reqJson_["info"] = Info
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = Info.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("info", _os.ToBytes())
+	} else {
+		err = Info.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
 	}
-	for _, v := range Buffer {
+	if _reqJson_ != nil {
+		_reqJson_["buffer"] = Buffer
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
+
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("buffer", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 2)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
 
-		err = _os.Write_string(v, 0)
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
 		if err != nil {
 			return err
 		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -134,92 +967,99 @@ func (_obj *Log) LoggerWithContext(ctx context.Context, App string, Server strin
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "logger", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "loggerbyInfo", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
 		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
 	return nil
 }
 
-//LoggerbyInfo is the proxy function for the method defined in the tars file, with the context
-func (_obj *Log) LoggerbyInfo(Info *LogInfo, Buffer []string, _opt ...map[string]string) (err error) {
+// LoggerbyInfoOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Log) LoggerbyInfoOnewayWithContext(ctx context.Context, Info *LogInfo, Buffer []string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = Info.WriteBlock(_os, 1)
-	if err != nil {
-		return err
-	}
-
-	err = _os.WriteHead(codec.LIST, 2)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(Buffer)), 0)
-	if err != nil {
-		return err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-	for _, v := range Buffer {
-
-		err = _os.Write_string(v, 0)
+	if _reqJson_ != nil {
+		_reqJson_["info"] = Info
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = Info.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("info", _os.ToBytes())
+	} else {
+		err = Info.WriteBlock(_os, 1)
 		if err != nil {
 			return err
 		}
 	}
+	if _reqJson_ != nil {
+		_reqJson_["buffer"] = Buffer
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
 
-	var _status map[string]string
-	var _context map[string]string
-	if len(_opt) == 1 {
-		_context = _opt[0]
-	} else if len(_opt) == 2 {
-		_context = _opt[0]
-		_status = _opt[1]
-	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "loggerbyInfo", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return err
-	}
-
-	setMap(len(_opt), _resp, _context, _status)
-	_ = length
-	_ = have
-	_ = ty
-	return nil
-}
-
-//LoggerbyInfoWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Log) LoggerbyInfoWithContext(ctx context.Context, Info *LogInfo, Buffer []string, _opt ...map[string]string) (err error) {
-
-	var length int32
-	var have bool
-	var ty byte
-	_os := codec.NewBuffer()
-	err = Info.WriteBlock(_os, 1)
-	if err != nil {
-		return err
-	}
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("buffer", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 2)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Buffer)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Buffer {
 
-	err = _os.WriteHead(codec.LIST, 2)
-	if err != nil {
-		return err
+			err = _os.Write_string(v, 0)
+			if err != nil {
+				return err
+			}
+		}
 	}
-	err = _os.Write_int32(int32(len(Buffer)), 0)
-	if err != nil {
-		return err
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
 	}
-	for _, v := range Buffer {
-
-		err = _os.Write_string(v, 0)
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
 		if err != nil {
 			return err
 		}
@@ -233,44 +1073,51 @@ func (_obj *Log) LoggerbyInfoWithContext(ctx context.Context, Info *LogInfo, Buf
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "loggerbyInfo", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "loggerbyInfo", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
 		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
 	return nil
 }
 
-//SetServant sets servant for the service.
+// SetServant sets servant for the service.
 func (_obj *Log) SetServant(s m.Servant) {
 	_obj.s = s
 }
 
-//TarsSetTimeout sets the timeout for the servant which is in ms.
+// TarsSetTimeout sets the timeout for the servant which is in ms.
 func (_obj *Log) TarsSetTimeout(t int) {
 	_obj.s.TarsSetTimeout(t)
 }
-func setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
+
+// TarsSetVersion sets the protocol version of the servant, basef.TUPVERSION for the servants speaking TUP,
+// basef.JSONVERSION for encoding the arguments as a JSON object. The servants other than m.VersionServant
+// only speak tars, it takes no effect on them.
+func (_obj *Log) TarsSetVersion(v int16) {
+	if vs, ok := _obj.s.(m.VersionServant); ok {
+		vs.TarsSetVersion(v)
+	}
+}
+func (_obj *Log) setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
 	if l == 1 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
 	} else if l == 2 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
-		for k := range sts {
+		for k, _ := range sts {
 			delete(sts, k)
 		}
 		for k, v := range res.Status {
@@ -288,59 +1135,180 @@ type _impLogWithContext interface {
 	LoggerbyInfo(ctx context.Context, Info *LogInfo, Buffer []string) (err error)
 }
 
-func logger(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func logger(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var App string
-	err = _is.Read_string(&App, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["app"]; ok {
+			err = json.Unmarshal(_raw_, &App)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("app", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&App, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&App, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Server string
-	err = _is.Read_string(&Server, 2, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["server"]; ok {
+			err = json.Unmarshal(_raw_, &Server)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("server", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Server, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Server, 2, true)
+		if err != nil {
+			return err
+		}
 	}
 	var File string
-	err = _is.Read_string(&File, 3, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["file"]; ok {
+			err = json.Unmarshal(_raw_, &File)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("file", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&File, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&File, 3, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Format string
-	err = _is.Read_string(&Format, 4, true)
-	if err != nil {
-		return err
-	}
-	var Buffer []string
-	err, _, ty = _is.SkipToNoCheck(5, true)
-	if err != nil {
-		return err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["format"]; ok {
+			err = json.Unmarshal(_raw_, &Format)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("format", &_tupBuffer_)
 		if err != nil {
 			return err
 		}
-		Buffer = make([]string, length, length)
-		for i0, e0 := int32(0), length; i0 < e0; i0++ {
-
-			err = _is.Read_string(&Buffer[i0], 0, false)
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Format, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Format, 4, true)
+		if err != nil {
+			return err
+		}
+	}
+	var Buffer []string
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["buffer"]; ok {
+			err = json.Unmarshal(_raw_, &Buffer)
 			if err != nil {
 				return err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("buffer", &_tupBuffer_)
 		if err != nil {
 			return err
 		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return err
+			}
+			Buffer = make([]string, length, length)
+			for i0, e0 := int32(0), length; i0 < e0; i0++ {
+
+				err = _is.Read_string(&Buffer[i0], 0, false)
+				if err != nil {
+					return err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return err
+			}
+		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err, _, ty = _is.SkipToNoCheck(5, true)
 		if err != nil {
 			return err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return err
+			}
+			Buffer = make([]string, length, length)
+			for i1, e1 := int32(0), length; i1 < e1; i1++ {
+
+				err = _is.Read_string(&Buffer[i1], 0, false)
+				if err != nil {
+					return err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return err
+			}
+		}
 	}
 	if withContext == false {
 		_imp := _val.(_impLog)
@@ -359,46 +1327,111 @@ func logger(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func loggerbyInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func loggerbyInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var Info LogInfo
-	err = Info.ReadBlock(_is, 1, true)
-	if err != nil {
-		return err
-	}
-	var Buffer []string
-	err, _, ty = _is.SkipToNoCheck(2, true)
-	if err != nil {
-		return err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["info"]; ok {
+			err = json.Unmarshal(_raw_, &Info)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("info", &_tupBuffer_)
 		if err != nil {
 			return err
 		}
-		Buffer = make([]string, length, length)
-		for i1, e1 := int32(0), length; i1 < e1; i1++ {
-
-			err = _is.Read_string(&Buffer[i1], 0, false)
+		_is.Reset(_tupBuffer_)
+		err = Info.ReadBlock(_is, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = Info.ReadBlock(_is, 1, true)
+		if err != nil {
+			return err
+		}
+	}
+	var Buffer []string
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["buffer"]; ok {
+			err = json.Unmarshal(_raw_, &Buffer)
 			if err != nil {
 				return err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("buffer", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return err
+			}
+			Buffer = make([]string, length, length)
+			for i2, e2 := int32(0), length; i2 < e2; i2++ {
+
+				err = _is.Read_string(&Buffer[i2], 0, false)
+				if err != nil {
+					return err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return err
+			}
+		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err, _, ty = _is.SkipToNoCheck(2, true)
 		if err != nil {
 			return err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return err
+			}
+			Buffer = make([]string, length, length)
+			for i3, e3 := int32(0), length; i3 < e3; i3++ {
+
+				err = _is.Read_string(&Buffer[i3], 0, false)
+				if err != nil {
+					return err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return err
+			}
+		}
 	}
 	if withContext == false {
 		_imp := _val.(_impLog)
@@ -417,21 +1450,49 @@ func loggerbyInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
 
-//Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
+// Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
 func (_obj *Log) Dispatch(ctx context.Context, _val interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool) (err error) {
-	_is := codec.NewReader(tools.Int8ToByte(req.SBuffer))
+	_is := codec.NewReader(req.SBuffer)
 	_os := codec.NewBuffer()
+	var _reqTup_, _rspTup_ *tup.UniAttribute
+	var _reqJson_ map[string]json.RawMessage
+	var _rspJson_ map[string]interface{}
+	switch req.IVersion {
+	case basef.TUPVERSION:
+		// TUP requests carry the arguments by name
+		_reqTup_ = tup.NewUniAttribute()
+		err = _reqTup_.Decode(_is)
+		if err != nil {
+			return err
+		}
+		_rspTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		// JSON requests carry the arguments as an object by name
+		_reqJson_ = make(map[string]json.RawMessage)
+		if len(req.SBuffer) > 0 {
+			err = json.Unmarshal(req.SBuffer, &_reqJson_)
+			if err != nil {
+				return err
+			}
+			if _reqJson_ == nil {
+				// a null object has no vars
+				_reqJson_ = make(map[string]json.RawMessage)
+			}
+		}
+		_rspJson_ = make(map[string]interface{})
+	}
 	switch req.SFuncName {
 	case "logger":
-		err := logger(ctx, _val, _os, _is, withContext)
+		err := logger(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "loggerbyInfo":
-		err := loggerbyInfo(ctx, _val, _os, _is, withContext)
+		err := loggerbyInfo(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
@@ -439,6 +1500,25 @@ func (_obj *Log) Dispatch(ctx context.Context, _val interface{}, req *requestf.R
 	default:
 		return fmt.Errorf("func mismatch")
 	}
+	if _rspTup_ != nil {
+		_os.Reset()
+		err = _rspTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _rspJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_rspJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
 	var _status map[string]string
 	s, ok := current.GetResponseStatus(ctx)
 	if ok && s != nil {
@@ -450,12 +1530,12 @@ func (_obj *Log) Dispatch(ctx context.Context, _val interface{}, req *requestf.R
 		_context = c
 	}
 	*resp = requestf.ResponsePacket{
-		IVersion:     1,
+		IVersion:     req.IVersion,
 		CPacketType:  0,
 		IRequestId:   req.IRequestId,
 		IMessageType: 0,
 		IRet:         0,
-		SBuffer:      tools.ByteToInt8(_os.ToBytes()),
+		SBuffer:      _os.ToBytes(),
 		Status:       _status,
 		SResultDesc:  "",
 		Context:      _context,
@@ -1,35 +1,77 @@
-//Package nodef comment
+// Package nodef comment
 // This file war generated by tars2go 1.1
 // Generated from NodeF.tars
 package nodef
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	m "github.com/TarsCloud/TarsGo/tars/model"
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/tup"
 	"github.com/TarsCloud/TarsGo/tars/util/current"
-	"github.com/TarsCloud/TarsGo/tars/util/tools"
 )
 
-//ServerF struct
+// ServerF struct
 type ServerF struct {
 	s m.Servant
 }
 
-//KeepAlive is the proxy function for the method defined in the tars file, with the context
+// KeepAlive is the proxy function for the method defined in the tars file, with the context
 func (_obj *ServerF) KeepAlive(ServerInfo *ServerInfo, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = ServerInfo.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["serverInfo"] = ServerInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ServerInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("serverInfo", _os.ToBytes())
+	} else {
+		err = ServerInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -39,34 +81,116 @@ func (_obj *ServerF) KeepAlive(ServerInfo *ServerInfo, _opt ...map[string]string
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
 	err = _obj.s.Tars_invoke(ctx, 0, "keepAlive", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//KeepAliveWithContext is the proxy function for the method defined in the tars file, with the context
+// KeepAliveWithContext is the proxy function for the method defined in the tars file, with the context
 func (_obj *ServerF) KeepAliveWithContext(ctx context.Context, ServerInfo *ServerInfo, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = ServerInfo.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["serverInfo"] = ServerInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ServerInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("serverInfo", _os.ToBytes())
+	} else {
+		err = ServerInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
 	var _status map[string]string
@@ -82,41 +206,284 @@ func (_obj *ServerF) KeepAliveWithContext(ctx context.Context, ServerInfo *Serve
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//ReportVersion is the proxy function for the method defined in the tars file, with the context
-func (_obj *ServerF) ReportVersion(App string, ServerName string, Version string, _opt ...map[string]string) (ret int32, err error) {
+// KeepAliveOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *ServerF) KeepAliveOneway(ServerInfo *ServerInfo, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(App, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["serverInfo"] = ServerInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ServerInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("serverInfo", _os.ToBytes())
+	} else {
+		err = ServerInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = _os.Write_string(ServerName, 2)
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "keepAlive", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	err = _os.Write_string(Version, 3)
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// KeepAliveOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *ServerF) KeepAliveOnewayWithContext(ctx context.Context, ServerInfo *ServerInfo, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["serverInfo"] = ServerInfo
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = ServerInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("serverInfo", _os.ToBytes())
+	} else {
+		err = ServerInfo.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "keepAlive", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// ReportVersion is the proxy function for the method defined in the tars file, with the context
+func (_obj *ServerF) ReportVersion(App string, ServerName string, Version string, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["serverName"] = ServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(ServerName, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("serverName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(ServerName, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["version"] = Version
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Version, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("version", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Version, 3)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -126,46 +493,299 @@ func (_obj *ServerF) ReportVersion(App string, ServerName string, Version string
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
 	err = _obj.s.Tars_invoke(ctx, 0, "reportVersion", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//ReportVersionWithContext is the proxy function for the method defined in the tars file, with the context
+// ReportVersionWithContext is the proxy function for the method defined in the tars file, with the context
 func (_obj *ServerF) ReportVersionWithContext(ctx context.Context, App string, ServerName string, Version string, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(App, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["serverName"] = ServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(ServerName, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("serverName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(ServerName, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["version"] = Version
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Version, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("version", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Version, 3)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
-	err = _os.Write_string(ServerName, 2)
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "reportVersion", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	err = _os.Write_string(Version, 3)
-	if err != nil {
-		return ret, err
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// ReportVersionOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *ServerF) ReportVersionOneway(App string, ServerName string, Version string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["serverName"] = ServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(ServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("serverName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(ServerName, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["version"] = Version
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Version, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("version", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Version, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -174,49 +794,150 @@ func (_obj *ServerF) ReportVersionWithContext(ctx context.Context, App string, S
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "reportVersion", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportVersion", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// ReportVersionOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *ServerF) ReportVersionOnewayWithContext(ctx context.Context, App string, ServerName string, Version string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["app"] = App
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(App, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("app", _os.ToBytes())
+	} else {
+		err = _os.Write_string(App, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["serverName"] = ServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(ServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("serverName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(ServerName, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["version"] = Version
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Version, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("version", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Version, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportVersion", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//SetServant sets servant for the service.
+// SetServant sets servant for the service.
 func (_obj *ServerF) SetServant(s m.Servant) {
 	_obj.s = s
 }
 
-//TarsSetTimeout sets the timeout for the servant which is in ms.
+// TarsSetTimeout sets the timeout for the servant which is in ms.
 func (_obj *ServerF) TarsSetTimeout(t int) {
 	_obj.s.TarsSetTimeout(t)
 }
-func setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
+
+// TarsSetVersion sets the protocol version of the servant, basef.TUPVERSION for the servants speaking TUP,
+// basef.JSONVERSION for encoding the arguments as a JSON object. The servants other than m.VersionServant
+// only speak tars, it takes no effect on them.
+func (_obj *ServerF) TarsSetVersion(v int16) {
+	if vs, ok := _obj.s.(m.VersionServant); ok {
+		vs.TarsSetVersion(v)
+	}
+}
+func (_obj *ServerF) setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
 	if l == 1 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
 	} else if l == 2 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
-		for k := range sts {
+		for k, _ := range sts {
 			delete(sts, k)
 		}
 		for k, v := range res.Status {
@@ -234,14 +955,34 @@ type _impServerFWithContext interface {
 	ReportVersion(ctx context.Context, App string, ServerName string, Version string) (ret int32, err error)
 }
 
-func keepAlive(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func keepAlive(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var ServerInfo ServerInfo
-	err = ServerInfo.ReadBlock(_is, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["serverInfo"]; ok {
+			err = json.Unmarshal(_raw_, &ServerInfo)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("serverInfo", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = ServerInfo.ReadBlock(_is, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = ServerInfo.ReadBlock(_is, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	if withContext == false {
 		_imp := _val.(_impServerF)
@@ -249,10 +990,21 @@ func keepAlive(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *co
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impServerFWithContext)
@@ -260,36 +1012,106 @@ func keepAlive(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *co
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func reportVersion(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func reportVersion(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var App string
-	err = _is.Read_string(&App, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["app"]; ok {
+			err = json.Unmarshal(_raw_, &App)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("app", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&App, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&App, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var ServerName string
-	err = _is.Read_string(&ServerName, 2, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["serverName"]; ok {
+			err = json.Unmarshal(_raw_, &ServerName)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("serverName", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&ServerName, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&ServerName, 2, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Version string
-	err = _is.Read_string(&Version, 3, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["version"]; ok {
+			err = json.Unmarshal(_raw_, &Version)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("version", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Version, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Version, 3, true)
+		if err != nil {
+			return err
+		}
 	}
 	if withContext == false {
 		_imp := _val.(_impServerF)
@@ -297,10 +1119,21 @@ func reportVersion(ctx context.Context, _val interface{}, _os *codec.Buffer, _is
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impServerFWithContext)
@@ -308,31 +1141,70 @@ func reportVersion(ctx context.Context, _val interface{}, _os *codec.Buffer, _is
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
 
-//Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
+// Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
 func (_obj *ServerF) Dispatch(ctx context.Context, _val interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool) (err error) {
-	_is := codec.NewReader(tools.Int8ToByte(req.SBuffer))
+	_is := codec.NewReader(req.SBuffer)
 	_os := codec.NewBuffer()
+	var _reqTup_, _rspTup_ *tup.UniAttribute
+	var _reqJson_ map[string]json.RawMessage
+	var _rspJson_ map[string]interface{}
+	switch req.IVersion {
+	case basef.TUPVERSION:
+		// TUP requests carry the arguments by name
+		_reqTup_ = tup.NewUniAttribute()
+		err = _reqTup_.Decode(_is)
+		if err != nil {
+			return err
+		}
+		_rspTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		// JSON requests carry the arguments as an object by name
+		_reqJson_ = make(map[string]json.RawMessage)
+		if len(req.SBuffer) > 0 {
+			err = json.Unmarshal(req.SBuffer, &_reqJson_)
+			if err != nil {
+				return err
+			}
+			if _reqJson_ == nil {
+				// a null object has no vars
+				_reqJson_ = make(map[string]json.RawMessage)
+			}
+		}
+		_rspJson_ = make(map[string]interface{})
+	}
 	switch req.SFuncName {
 	case "keepAlive":
-		err := keepAlive(ctx, _val, _os, _is, withContext)
+		err := keepAlive(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "reportVersion":
-		err := reportVersion(ctx, _val, _os, _is, withContext)
+		err := reportVersion(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
@@ -340,6 +1212,25 @@ func (_obj *ServerF) Dispatch(ctx context.Context, _val interface{}, req *reques
 	default:
 		return fmt.Errorf("func mismatch")
 	}
+	if _rspTup_ != nil {
+		_os.Reset()
+		err = _rspTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _rspJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_rspJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
 	var _status map[string]string
 	s, ok := current.GetResponseStatus(ctx)
 	if ok && s != nil {
@@ -351,12 +1242,12 @@ func (_obj *ServerF) Dispatch(ctx context.Context, _val interface{}, req *reques
 		_context = c
 	}
 	*resp = requestf.ResponsePacket{
-		IVersion:     1,
+		IVersion:     req.IVersion,
 		CPacketType:  0,
 		IRequestId:   req.IRequestId,
 		IMessageType: 0,
 		IRet:         0,
-		SBuffer:      tools.ByteToInt8(_os.ToBytes()),
+		SBuffer:      _os.ToBytes(),
 		Status:       _status,
 		SResultDesc:  "",
 		Context:      _context,
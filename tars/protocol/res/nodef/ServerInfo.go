@@ -1,4 +1,4 @@
-//Package nodef comment
+// Package nodef comment
 // This file war generated by tars2go 1.1
 // Generated from NodeF.tars
 package nodef
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//ServerInfo strcut implement
+// ServerInfo strcut implement
 type ServerInfo struct {
 	Application string `json:"application"`
 	ServerName  string `json:"serverName"`
@@ -19,7 +19,7 @@ type ServerInfo struct {
 func (st *ServerInfo) resetDefault() {
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *ServerInfo) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -53,7 +53,7 @@ func (st *ServerInfo) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *ServerInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -81,7 +81,7 @@ func (st *ServerInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *ServerInfo) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -108,7 +108,7 @@ func (st *ServerInfo) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *ServerInfo) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
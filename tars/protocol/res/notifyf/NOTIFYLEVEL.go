@@ -1,4 +1,4 @@
-//Package notifyf comment
+// Package notifyf comment
 // This file war generated by tars2go 1.1
 // Generated from NotifyF.tars
 package notifyf
@@ -6,7 +6,10 @@ package notifyf
 type NOTIFYLEVEL int32
 
 const (
+	//NOTIFYLEVEL_NOTIFYNORMAL enum
 	NOTIFYLEVEL_NOTIFYNORMAL = 0
-	NOTIFYLEVEL_NOTIFYWARN   = 1
-	NOTIFYLEVEL_NOTIFYERROR  = 2
+	//NOTIFYLEVEL_NOTIFYWARN enum
+	NOTIFYLEVEL_NOTIFYWARN = 1
+	//NOTIFYLEVEL_NOTIFYERROR enum
+	NOTIFYLEVEL_NOTIFYERROR = 2
 )
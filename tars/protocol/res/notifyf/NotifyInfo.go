@@ -1,4 +1,4 @@
-//Package notifyf comment
+// Package notifyf comment
 // This file war generated by tars2go 1.1
 // Generated from NotifyF.tars
 package notifyf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//NotifyInfo strcut implement
+// NotifyInfo strcut implement
 type NotifyInfo struct {
 	Nextpage    int32        `json:"nextpage"`
 	NotifyItems []NotifyItem `json:"notifyItems"`
@@ -17,7 +17,7 @@ type NotifyInfo struct {
 func (st *NotifyInfo) resetDefault() {
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *NotifyInfo) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -66,7 +66,7 @@ func (st *NotifyInfo) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *NotifyInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -94,7 +94,7 @@ func (st *NotifyInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *NotifyInfo) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -122,7 +122,7 @@ func (st *NotifyInfo) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *NotifyInfo) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
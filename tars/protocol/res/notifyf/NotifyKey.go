@@ -1,4 +1,4 @@
-//Package notifyf comment
+// Package notifyf comment
 // This file war generated by tars2go 1.1
 // Generated from NotifyF.tars
 package notifyf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//NotifyKey strcut implement
+// NotifyKey strcut implement
 type NotifyKey struct {
 	Name string `json:"name"`
 	Ip   string `json:"ip"`
@@ -18,7 +18,7 @@ type NotifyKey struct {
 func (st *NotifyKey) resetDefault() {
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *NotifyKey) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -47,7 +47,7 @@ func (st *NotifyKey) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *NotifyKey) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -75,7 +75,7 @@ func (st *NotifyKey) ReadBlock(_is *codec.Reader, tag byte, require bool) error
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *NotifyKey) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -97,7 +97,7 @@ func (st *NotifyKey) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *NotifyKey) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
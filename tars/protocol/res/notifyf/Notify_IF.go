@@ -1,45 +1,107 @@
-//Package notifyf comment
+// Package notifyf comment
 // This file war generated by tars2go 1.1
 // Generated from NotifyF.tars
 package notifyf
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	m "github.com/TarsCloud/TarsGo/tars/model"
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/tup"
 	"github.com/TarsCloud/TarsGo/tars/util/current"
-	"github.com/TarsCloud/TarsGo/tars/util/tools"
 )
 
-//Notify struct
+// Notify struct
 type Notify struct {
 	s m.Servant
 }
 
-//ReportServer is the proxy function for the method defined in the tars file, with the context
+// ReportServer is the proxy function for the method defined in the tars file, with the context
 func (_obj *Notify) ReportServer(SServerName string, SThreadId string, SMessage string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(SServerName, 1)
-	if err != nil {
-		return err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-
-	err = _os.Write_string(SThreadId, 2)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		_reqJson_["sServerName"] = SServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SServerName, 1)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _os.Write_string(SMessage, 3)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		_reqJson_["sThreadId"] = SThreadId
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SThreadId, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sThreadId", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SThreadId, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sMessage"] = SMessage
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SMessage, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sMessage", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SMessage, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -49,39 +111,102 @@ func (_obj *Notify) ReportServer(SServerName string, SThreadId string, SMessage
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
 	err = _obj.s.Tars_invoke(ctx, 0, "reportServer", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return nil
 }
 
-//ReportServerWithContext is the proxy function for the method defined in the tars file, with the context
+// ReportServerWithContext is the proxy function for the method defined in the tars file, with the context
 func (_obj *Notify) ReportServerWithContext(ctx context.Context, SServerName string, SThreadId string, SMessage string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(SServerName, 1)
-	if err != nil {
-		return err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-
-	err = _os.Write_string(SThreadId, 2)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		_reqJson_["sServerName"] = SServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SServerName, 1)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _os.Write_string(SMessage, 3)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		_reqJson_["sThreadId"] = SThreadId
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SThreadId, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sThreadId", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SThreadId, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sMessage"] = SMessage
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SMessage, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sMessage", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SMessage, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
 	var _status map[string]string
@@ -97,36 +222,100 @@ func (_obj *Notify) ReportServerWithContext(ctx context.Context, SServerName str
 	if err != nil {
 		return err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return nil
 }
 
-//NotifyServer is the proxy function for the method defined in the tars file, with the context
-func (_obj *Notify) NotifyServer(SServerName string, Level NOTIFYLEVEL, SMessage string, _opt ...map[string]string) (err error) {
+// ReportServerOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Notify) ReportServerOneway(SServerName string, SThreadId string, SMessage string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(SServerName, 1)
-	if err != nil {
-		return err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-
-	err = _os.Write_int32(int32(Level), 2)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		_reqJson_["sServerName"] = SServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SServerName, 1)
+		if err != nil {
+			return err
+		}
 	}
-
-	err = _os.Write_string(SMessage, 3)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		_reqJson_["sThreadId"] = SThreadId
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SThreadId, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sThreadId", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SThreadId, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sMessage"] = SMessage
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SMessage, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sMessage", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SMessage, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -135,42 +324,198 @@ func (_obj *Notify) NotifyServer(SServerName string, Level NOTIFYLEVEL, SMessage
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "notifyServer", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportServer", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
 		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
 	return nil
 }
 
-//NotifyServerWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Notify) NotifyServerWithContext(ctx context.Context, SServerName string, Level NOTIFYLEVEL, SMessage string, _opt ...map[string]string) (err error) {
+// ReportServerOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Notify) ReportServerOnewayWithContext(ctx context.Context, SServerName string, SThreadId string, SMessage string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(SServerName, 1)
-	if err != nil {
-		return err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sServerName"] = SServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SServerName, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sThreadId"] = SThreadId
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SThreadId, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sThreadId", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SThreadId, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sMessage"] = SMessage
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SMessage, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sMessage", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SMessage, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = _os.Write_int32(int32(Level), 2)
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportServer", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
 		return err
 	}
 
-	err = _os.Write_string(SMessage, 3)
-	if err != nil {
-		return err
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// NotifyServer is the proxy function for the method defined in the tars file, with the context
+func (_obj *Notify) NotifyServer(SServerName string, Level NOTIFYLEVEL, SMessage string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sServerName"] = SServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SServerName, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["level"] = Level
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_int32(int32(Level), 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("level", _os.ToBytes())
+	} else {
+		err = _os.Write_int32(int32(Level), 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sMessage"] = SMessage
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SMessage, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sMessage", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SMessage, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -184,26 +529,805 @@ func (_obj *Notify) NotifyServerWithContext(ctx context.Context, SServerName str
 	if err != nil {
 		return err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return nil
 }
 
-//GetNotifyInfo is the proxy function for the method defined in the tars file, with the context
-func (_obj *Notify) GetNotifyInfo(StKey *NotifyKey, StInfo *NotifyInfo, _opt ...map[string]string) (ret int32, err error) {
+// NotifyServerWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Notify) NotifyServerWithContext(ctx context.Context, SServerName string, Level NOTIFYLEVEL, SMessage string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = StKey.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sServerName"] = SServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SServerName, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["level"] = Level
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_int32(int32(Level), 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("level", _os.ToBytes())
+	} else {
+		err = _os.Write_int32(int32(Level), 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sMessage"] = SMessage
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SMessage, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sMessage", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SMessage, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "notifyServer", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return nil
+}
+
+// NotifyServerOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Notify) NotifyServerOneway(SServerName string, Level NOTIFYLEVEL, SMessage string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sServerName"] = SServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SServerName, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["level"] = Level
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_int32(int32(Level), 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("level", _os.ToBytes())
+	} else {
+		err = _os.Write_int32(int32(Level), 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sMessage"] = SMessage
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SMessage, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sMessage", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SMessage, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "notifyServer", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// NotifyServerOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Notify) NotifyServerOnewayWithContext(ctx context.Context, SServerName string, Level NOTIFYLEVEL, SMessage string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sServerName"] = SServerName
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SServerName, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sServerName", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SServerName, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["level"] = Level
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_int32(int32(Level), 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("level", _os.ToBytes())
+	} else {
+		err = _os.Write_int32(int32(Level), 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sMessage"] = SMessage
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SMessage, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sMessage", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SMessage, 3)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "notifyServer", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// GetNotifyInfo is the proxy function for the method defined in the tars file, with the context
+func (_obj *Notify) GetNotifyInfo(StKey *NotifyKey, StInfo *NotifyInfo, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["stKey"] = StKey
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = StKey.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("stKey", _os.ToBytes())
+	} else {
+		err = StKey.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "getNotifyInfo", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["stInfo"]; ok {
+			err = json.Unmarshal(_raw_, &(*StInfo))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("stInfo", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = (*StInfo).ReadBlock(_is, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = (*StInfo).ReadBlock(_is, 2, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// GetNotifyInfoWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Notify) GetNotifyInfoWithContext(ctx context.Context, StKey *NotifyKey, StInfo *NotifyInfo, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["stKey"] = StKey
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = StKey.WriteBlock(_os, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("stKey", _os.ToBytes())
+	} else {
+		err = StKey.WriteBlock(_os, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "getNotifyInfo", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["stInfo"]; ok {
+			err = json.Unmarshal(_raw_, &(*StInfo))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("stInfo", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = (*StInfo).ReadBlock(_is, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = (*StInfo).ReadBlock(_is, 2, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// GetNotifyInfoOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Notify) GetNotifyInfoOneway(StKey *NotifyKey, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["stKey"] = StKey
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = StKey.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("stKey", _os.ToBytes())
+	} else {
+		err = StKey.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "getNotifyInfo", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// GetNotifyInfoOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Notify) GetNotifyInfoOnewayWithContext(ctx context.Context, StKey *NotifyKey, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["stKey"] = StKey
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = StKey.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("stKey", _os.ToBytes())
+	} else {
+		err = StKey.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "getNotifyInfo", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// ReportNotifyInfo is the proxy function for the method defined in the tars file, with the context
+func (_obj *Notify) ReportNotifyInfo(Info *ReportInfo, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["info"] = Info
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = Info.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("info", _os.ToBytes())
+	} else {
+		err = Info.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -213,39 +1337,72 @@ func (_obj *Notify) GetNotifyInfo(StKey *NotifyKey, StInfo *NotifyInfo, _opt ...
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "getNotifyInfo", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err = (*StInfo).ReadBlock(_is, 2, true)
+	err = _obj.s.Tars_invoke(ctx, 0, "reportNotifyInfo", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
-		return ret, err
+		return err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return nil
 }
 
-//GetNotifyInfoWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Notify) GetNotifyInfoWithContext(ctx context.Context, StKey *NotifyKey, StInfo *NotifyInfo, _opt ...map[string]string) (ret int32, err error) {
+// ReportNotifyInfoWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *Notify) ReportNotifyInfoWithContext(ctx context.Context, Info *ReportInfo, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = StKey.WriteBlock(_os, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["info"] = Info
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = Info.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("info", _os.ToBytes())
+	} else {
+		err = Info.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
 	var _status map[string]string
@@ -257,40 +1414,74 @@ func (_obj *Notify) GetNotifyInfoWithContext(ctx context.Context, StKey *NotifyK
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "getNotifyInfo", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err = (*StInfo).ReadBlock(_is, 2, true)
+	err = _obj.s.Tars_invoke(ctx, 0, "reportNotifyInfo", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
-		return ret, err
+		return err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return nil
 }
 
-//ReportNotifyInfo is the proxy function for the method defined in the tars file, with the context
-func (_obj *Notify) ReportNotifyInfo(Info *ReportInfo, _opt ...map[string]string) (err error) {
+// ReportNotifyInfoOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *Notify) ReportNotifyInfoOneway(Info *ReportInfo, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = Info.WriteBlock(_os, 1)
-	if err != nil {
-		return err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["info"] = Info
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = Info.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("info", _os.ToBytes())
+	} else {
+		err = Info.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -299,30 +1490,65 @@ func (_obj *Notify) ReportNotifyInfo(Info *ReportInfo, _opt ...map[string]string
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "reportNotifyInfo", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportNotifyInfo", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
 		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
 	return nil
 }
 
-//ReportNotifyInfoWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *Notify) ReportNotifyInfoWithContext(ctx context.Context, Info *ReportInfo, _opt ...map[string]string) (err error) {
+// ReportNotifyInfoOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *Notify) ReportNotifyInfoOnewayWithContext(ctx context.Context, Info *ReportInfo, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = Info.WriteBlock(_os, 1)
-	if err != nil {
-		return err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["info"] = Info
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = Info.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("info", _os.ToBytes())
+	} else {
+		err = Info.WriteBlock(_os, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
 	var _status map[string]string
@@ -333,44 +1559,51 @@ func (_obj *Notify) ReportNotifyInfoWithContext(ctx context.Context, Info *Repor
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "reportNotifyInfo", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportNotifyInfo", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
 		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
 	return nil
 }
 
-//SetServant sets servant for the service.
+// SetServant sets servant for the service.
 func (_obj *Notify) SetServant(s m.Servant) {
 	_obj.s = s
 }
 
-//TarsSetTimeout sets the timeout for the servant which is in ms.
+// TarsSetTimeout sets the timeout for the servant which is in ms.
 func (_obj *Notify) TarsSetTimeout(t int) {
 	_obj.s.TarsSetTimeout(t)
 }
-func setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
+
+// TarsSetVersion sets the protocol version of the servant, basef.TUPVERSION for the servants speaking TUP,
+// basef.JSONVERSION for encoding the arguments as a JSON object. The servants other than m.VersionServant
+// only speak tars, it takes no effect on them.
+func (_obj *Notify) TarsSetVersion(v int16) {
+	if vs, ok := _obj.s.(m.VersionServant); ok {
+		vs.TarsSetVersion(v)
+	}
+}
+func (_obj *Notify) setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
 	if l == 1 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
 	} else if l == 2 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
-		for k := range sts {
+		for k, _ := range sts {
 			delete(sts, k)
 		}
 		for k, v := range res.Status {
@@ -392,24 +1625,82 @@ type _impNotifyWithContext interface {
 	ReportNotifyInfo(ctx context.Context, Info *ReportInfo) (err error)
 }
 
-func reportServer(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func reportServer(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var SServerName string
-	err = _is.Read_string(&SServerName, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["sServerName"]; ok {
+			err = json.Unmarshal(_raw_, &SServerName)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("sServerName", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&SServerName, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&SServerName, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var SThreadId string
-	err = _is.Read_string(&SThreadId, 2, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["sThreadId"]; ok {
+			err = json.Unmarshal(_raw_, &SThreadId)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("sThreadId", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&SThreadId, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&SThreadId, 2, true)
+		if err != nil {
+			return err
+		}
 	}
 	var SMessage string
-	err = _is.Read_string(&SMessage, 3, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["sMessage"]; ok {
+			err = json.Unmarshal(_raw_, &SMessage)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("sMessage", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&SMessage, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&SMessage, 3, true)
+		if err != nil {
+			return err
+		}
 	}
 	if withContext == false {
 		_imp := _val.(_impNotify)
@@ -428,26 +1719,85 @@ func reportServer(ctx context.Context, _val interface{}, _os *codec.Buffer, _is
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func notifyServer(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func notifyServer(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var SServerName string
-	err = _is.Read_string(&SServerName, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["sServerName"]; ok {
+			err = json.Unmarshal(_raw_, &SServerName)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("sServerName", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&SServerName, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&SServerName, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var Level NOTIFYLEVEL
-	err = _is.Read_int32((*int32)(&Level), 2, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["level"]; ok {
+			err = json.Unmarshal(_raw_, &Level)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("level", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32((*int32)(&Level), 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_int32((*int32)(&Level), 2, true)
+		if err != nil {
+			return err
+		}
 	}
 	var SMessage string
-	err = _is.Read_string(&SMessage, 3, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["sMessage"]; ok {
+			err = json.Unmarshal(_raw_, &SMessage)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("sMessage", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&SMessage, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&SMessage, 3, true)
+		if err != nil {
+			return err
+		}
 	}
 	if withContext == false {
 		_imp := _val.(_impNotify)
@@ -466,28 +1816,61 @@ func notifyServer(ctx context.Context, _val interface{}, _os *codec.Buffer, _is
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func getNotifyInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func getNotifyInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var StKey NotifyKey
-	err = StKey.ReadBlock(_is, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["stKey"]; ok {
+			err = json.Unmarshal(_raw_, &StKey)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("stKey", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = StKey.ReadBlock(_is, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = StKey.ReadBlock(_is, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	var StInfo NotifyInfo
+
 	if withContext == false {
 		_imp := _val.(_impNotify)
 		ret, err := _imp.GetNotifyInfo(&StKey, &StInfo)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impNotifyWithContext)
@@ -495,31 +1878,73 @@ func getNotifyInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["stInfo"] = StInfo
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = StInfo.WriteBlock(_os, 0)
+		if err != nil {
+			return err
+		}
+		_rspTup_.PutBuffer("stInfo", _os.ToBytes())
+	} else {
+		err = StInfo.WriteBlock(_os, 2)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = StInfo.WriteBlock(_os, 2)
-	if err != nil {
-		return err
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func reportNotifyInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func reportNotifyInfo(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var Info ReportInfo
-	err = Info.ReadBlock(_is, 1, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["info"]; ok {
+			err = json.Unmarshal(_raw_, &Info)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("info", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = Info.ReadBlock(_is, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = Info.ReadBlock(_is, 1, true)
+		if err != nil {
+			return err
+		}
 	}
 	if withContext == false {
 		_imp := _val.(_impNotify)
@@ -538,31 +1963,59 @@ func reportNotifyInfo(ctx context.Context, _val interface{}, _os *codec.Buffer,
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
 
-//Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
+// Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
 func (_obj *Notify) Dispatch(ctx context.Context, _val interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool) (err error) {
-	_is := codec.NewReader(tools.Int8ToByte(req.SBuffer))
+	_is := codec.NewReader(req.SBuffer)
 	_os := codec.NewBuffer()
+	var _reqTup_, _rspTup_ *tup.UniAttribute
+	var _reqJson_ map[string]json.RawMessage
+	var _rspJson_ map[string]interface{}
+	switch req.IVersion {
+	case basef.TUPVERSION:
+		// TUP requests carry the arguments by name
+		_reqTup_ = tup.NewUniAttribute()
+		err = _reqTup_.Decode(_is)
+		if err != nil {
+			return err
+		}
+		_rspTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		// JSON requests carry the arguments as an object by name
+		_reqJson_ = make(map[string]json.RawMessage)
+		if len(req.SBuffer) > 0 {
+			err = json.Unmarshal(req.SBuffer, &_reqJson_)
+			if err != nil {
+				return err
+			}
+			if _reqJson_ == nil {
+				// a null object has no vars
+				_reqJson_ = make(map[string]json.RawMessage)
+			}
+		}
+		_rspJson_ = make(map[string]interface{})
+	}
 	switch req.SFuncName {
 	case "reportServer":
-		err := reportServer(ctx, _val, _os, _is, withContext)
+		err := reportServer(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "notifyServer":
-		err := notifyServer(ctx, _val, _os, _is, withContext)
+		err := notifyServer(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "getNotifyInfo":
-		err := getNotifyInfo(ctx, _val, _os, _is, withContext)
+		err := getNotifyInfo(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "reportNotifyInfo":
-		err := reportNotifyInfo(ctx, _val, _os, _is, withContext)
+		err := reportNotifyInfo(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
@@ -570,6 +2023,25 @@ func (_obj *Notify) Dispatch(ctx context.Context, _val interface{}, req *request
 	default:
 		return fmt.Errorf("func mismatch")
 	}
+	if _rspTup_ != nil {
+		_os.Reset()
+		err = _rspTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _rspJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_rspJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
 	var _status map[string]string
 	s, ok := current.GetResponseStatus(ctx)
 	if ok && s != nil {
@@ -581,12 +2053,12 @@ func (_obj *Notify) Dispatch(ctx context.Context, _val interface{}, req *request
 		_context = c
 	}
 	*resp = requestf.ResponsePacket{
-		IVersion:     1,
+		IVersion:     req.IVersion,
 		CPacketType:  0,
 		IRequestId:   req.IRequestId,
 		IMessageType: 0,
 		IRet:         0,
-		SBuffer:      tools.ByteToInt8(_os.ToBytes()),
+		SBuffer:      _os.ToBytes(),
 		Status:       _status,
 		SResultDesc:  "",
 		Context:      _context,
@@ -1,4 +1,4 @@
-//Package notifyf comment
+// Package notifyf comment
 // This file war generated by tars2go 1.1
 // Generated from NotifyF.tars
 package notifyf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//ReportInfo strcut implement
+// ReportInfo strcut implement
 type ReportInfo struct {
 	EType      ReportType  `json:"eType"`
 	SApp       string      `json:"sApp"`
@@ -23,7 +23,7 @@ type ReportInfo struct {
 func (st *ReportInfo) resetDefault() {
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *ReportInfo) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -77,7 +77,7 @@ func (st *ReportInfo) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *ReportInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -105,7 +105,7 @@ func (st *ReportInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *ReportInfo) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -152,7 +152,7 @@ func (st *ReportInfo) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *ReportInfo) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
@@ -1,4 +1,4 @@
-//Package notifyf comment
+// Package notifyf comment
 // This file war generated by tars2go 1.1
 // Generated from NotifyF.tars
 package notifyf
@@ -6,6 +6,8 @@ package notifyf
 type ReportType int32
 
 const (
+	//ReportType_REPORT enum
 	ReportType_REPORT = 0
+	//ReportType_NOTIFY enum
 	ReportType_NOTIFY = 1
 )
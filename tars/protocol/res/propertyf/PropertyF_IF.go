@@ -1,51 +1,109 @@
-//Package propertyf comment
+// Package propertyf comment
 // This file war generated by tars2go 1.1
 // Generated from PropertyF.tars
 package propertyf
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	m "github.com/TarsCloud/TarsGo/tars/model"
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/tup"
 	"github.com/TarsCloud/TarsGo/tars/util/current"
-	"github.com/TarsCloud/TarsGo/tars/util/tools"
 )
 
-//PropertyF struct
+// PropertyF struct
 type PropertyF struct {
 	s m.Servant
 }
 
-//ReportPropMsg is the proxy function for the method defined in the tars file, with the context
+// ReportPropMsg is the proxy function for the method defined in the tars file, with the context
 func (_obj *PropertyF) ReportPropMsg(Statmsg map[StatPropMsgHead]StatPropMsgBody, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.WriteHead(codec.MAP, 1)
-	if err != nil {
-		return ret, err
-	}
-	err = _os.Write_int32(int32(len(Statmsg)), 0)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-	for k1, v1 := range Statmsg {
+	if _reqJson_ != nil {
+		_reqJson_["statmsg"] = Statmsg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.MAP, 0)
+		if err != nil {
+			return ret, err
+		}
+		err = _os.Write_int32(int32(len(Statmsg)), 0)
+		if err != nil {
+			return ret, err
+		}
+		for k1, v1 := range Statmsg {
+
+			err = k1.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
 
-		err = k1.WriteBlock(_os, 0)
+			err = v1.WriteBlock(_os, 1)
+			if err != nil {
+				return ret, err
+			}
+		}
+		_reqTup_.PutBuffer("statmsg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.MAP, 1)
+		if err != nil {
+			return ret, err
+		}
+		err = _os.Write_int32(int32(len(Statmsg)), 0)
 		if err != nil {
 			return ret, err
 		}
+		for k2, v2 := range Statmsg {
+
+			err = k2.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
 
-		err = v1.WriteBlock(_os, 1)
+			err = v2.WriteBlock(_os, 1)
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
 		if err != nil {
 			return ret, err
 		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -55,47 +113,145 @@ func (_obj *PropertyF) ReportPropMsg(Statmsg map[StatPropMsgHead]StatPropMsgBody
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
 	err = _obj.s.Tars_invoke(ctx, 0, "reportPropMsg", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//ReportPropMsgWithContext is the proxy function for the method defined in the tars file, with the context
+// ReportPropMsgWithContext is the proxy function for the method defined in the tars file, with the context
 func (_obj *PropertyF) ReportPropMsgWithContext(ctx context.Context, Statmsg map[StatPropMsgHead]StatPropMsgBody, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.WriteHead(codec.MAP, 1)
-	if err != nil {
-		return ret, err
-	}
-	err = _os.Write_int32(int32(len(Statmsg)), 0)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-	for k2, v2 := range Statmsg {
+	if _reqJson_ != nil {
+		_reqJson_["statmsg"] = Statmsg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.MAP, 0)
+		if err != nil {
+			return ret, err
+		}
+		err = _os.Write_int32(int32(len(Statmsg)), 0)
+		if err != nil {
+			return ret, err
+		}
+		for k3, v3 := range Statmsg {
+
+			err = k3.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
 
-		err = k2.WriteBlock(_os, 0)
+			err = v3.WriteBlock(_os, 1)
+			if err != nil {
+				return ret, err
+			}
+		}
+		_reqTup_.PutBuffer("statmsg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.MAP, 1)
+		if err != nil {
+			return ret, err
+		}
+		err = _os.Write_int32(int32(len(Statmsg)), 0)
 		if err != nil {
 			return ret, err
 		}
+		for k4, v4 := range Statmsg {
+
+			err = k4.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
 
-		err = v2.WriteBlock(_os, 1)
+			err = v4.WriteBlock(_os, 1)
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
 		if err != nil {
 			return ret, err
 		}
@@ -114,44 +270,300 @@ func (_obj *PropertyF) ReportPropMsgWithContext(ctx context.Context, Statmsg map
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
 	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//SetServant sets servant for the service.
+// ReportPropMsgOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *PropertyF) ReportPropMsgOneway(Statmsg map[StatPropMsgHead]StatPropMsgBody, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["statmsg"] = Statmsg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.MAP, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Statmsg)), 0)
+		if err != nil {
+			return err
+		}
+		for k5, v5 := range Statmsg {
+
+			err = k5.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+
+			err = v5.WriteBlock(_os, 1)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("statmsg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.MAP, 1)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Statmsg)), 0)
+		if err != nil {
+			return err
+		}
+		for k6, v6 := range Statmsg {
+
+			err = k6.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+
+			err = v6.WriteBlock(_os, 1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportPropMsg", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// ReportPropMsgOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *PropertyF) ReportPropMsgOnewayWithContext(ctx context.Context, Statmsg map[StatPropMsgHead]StatPropMsgBody, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["statmsg"] = Statmsg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.MAP, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Statmsg)), 0)
+		if err != nil {
+			return err
+		}
+		for k7, v7 := range Statmsg {
+
+			err = k7.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+
+			err = v7.WriteBlock(_os, 1)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("statmsg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.MAP, 1)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Statmsg)), 0)
+		if err != nil {
+			return err
+		}
+		for k8, v8 := range Statmsg {
+
+			err = k8.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+
+			err = v8.WriteBlock(_os, 1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportPropMsg", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// SetServant sets servant for the service.
 func (_obj *PropertyF) SetServant(s m.Servant) {
 	_obj.s = s
 }
 
-//TarsSetTimeout sets the timeout for the servant which is in ms.
+// TarsSetTimeout sets the timeout for the servant which is in ms.
 func (_obj *PropertyF) TarsSetTimeout(t int) {
 	_obj.s.TarsSetTimeout(t)
 }
-func setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
+
+// TarsSetVersion sets the protocol version of the servant, basef.TUPVERSION for the servants speaking TUP,
+// basef.JSONVERSION for encoding the arguments as a JSON object. The servants other than m.VersionServant
+// only speak tars, it takes no effect on them.
+func (_obj *PropertyF) TarsSetVersion(v int16) {
+	if vs, ok := _obj.s.(m.VersionServant); ok {
+		vs.TarsSetVersion(v)
+	}
+}
+func (_obj *PropertyF) setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
 	if l == 1 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
 	} else if l == 2 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
-		for k := range sts {
+		for k, _ := range sts {
 			delete(sts, k)
 		}
 		for k, v := range res.Status {
@@ -167,36 +579,78 @@ type _impPropertyFWithContext interface {
 	ReportPropMsg(ctx context.Context, Statmsg map[StatPropMsgHead]StatPropMsgBody) (ret int32, err error)
 }
 
-func reportPropMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func reportPropMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var Statmsg map[StatPropMsgHead]StatPropMsgBody
-	err, have = _is.SkipTo(codec.MAP, 1, true)
-	if err != nil {
-		return err
-	}
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["statmsg"]; ok {
+			err = json.Unmarshal(_raw_, &Statmsg)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("statmsg", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err, have = _is.SkipTo(codec.MAP, 0, true)
+		if err != nil {
+			return err
+		}
 
-	err = _is.Read_int32(&length, 0, true)
-	if err != nil {
-		return err
-	}
-	Statmsg = make(map[StatPropMsgHead]StatPropMsgBody)
-	for i3, e3 := int32(0), length; i3 < e3; i3++ {
-		var k3 StatPropMsgHead
-		var v3 StatPropMsgBody
+		err = _is.Read_int32(&length, 0, true)
+		if err != nil {
+			return err
+		}
+		Statmsg = make(map[StatPropMsgHead]StatPropMsgBody)
+		for i9, e9 := int32(0), length; i9 < e9; i9++ {
+			var k9 StatPropMsgHead
+			var v9 StatPropMsgBody
+
+			err = k9.ReadBlock(_is, 0, false)
+			if err != nil {
+				return err
+			}
+
+			err = v9.ReadBlock(_is, 1, false)
+			if err != nil {
+				return err
+			}
 
-		err = k3.ReadBlock(_is, 0, false)
+			Statmsg[k9] = v9
+		}
+	} else {
+		err, have = _is.SkipTo(codec.MAP, 1, true)
 		if err != nil {
 			return err
 		}
 
-		err = v3.ReadBlock(_is, 1, false)
+		err = _is.Read_int32(&length, 0, true)
 		if err != nil {
 			return err
 		}
+		Statmsg = make(map[StatPropMsgHead]StatPropMsgBody)
+		for i10, e10 := int32(0), length; i10 < e10; i10++ {
+			var k10 StatPropMsgHead
+			var v10 StatPropMsgBody
+
+			err = k10.ReadBlock(_is, 0, false)
+			if err != nil {
+				return err
+			}
+
+			err = v10.ReadBlock(_is, 1, false)
+			if err != nil {
+				return err
+			}
 
-		Statmsg[k3] = v3
+			Statmsg[k10] = v10
+		}
 	}
 	if withContext == false {
 		_imp := _val.(_impPropertyF)
@@ -204,10 +658,21 @@ func reportPropMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _is
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impPropertyFWithContext)
@@ -215,26 +680,65 @@ func reportPropMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _is
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
 
-//Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
+// Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
 func (_obj *PropertyF) Dispatch(ctx context.Context, _val interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool) (err error) {
-	_is := codec.NewReader(tools.Int8ToByte(req.SBuffer))
+	_is := codec.NewReader(req.SBuffer)
 	_os := codec.NewBuffer()
+	var _reqTup_, _rspTup_ *tup.UniAttribute
+	var _reqJson_ map[string]json.RawMessage
+	var _rspJson_ map[string]interface{}
+	switch req.IVersion {
+	case basef.TUPVERSION:
+		// TUP requests carry the arguments by name
+		_reqTup_ = tup.NewUniAttribute()
+		err = _reqTup_.Decode(_is)
+		if err != nil {
+			return err
+		}
+		_rspTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		// JSON requests carry the arguments as an object by name
+		_reqJson_ = make(map[string]json.RawMessage)
+		if len(req.SBuffer) > 0 {
+			err = json.Unmarshal(req.SBuffer, &_reqJson_)
+			if err != nil {
+				return err
+			}
+			if _reqJson_ == nil {
+				// a null object has no vars
+				_reqJson_ = make(map[string]json.RawMessage)
+			}
+		}
+		_rspJson_ = make(map[string]interface{})
+	}
 	switch req.SFuncName {
 	case "reportPropMsg":
-		err := reportPropMsg(ctx, _val, _os, _is, withContext)
+		err := reportPropMsg(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
@@ -242,6 +746,25 @@ func (_obj *PropertyF) Dispatch(ctx context.Context, _val interface{}, req *requ
 	default:
 		return fmt.Errorf("func mismatch")
 	}
+	if _rspTup_ != nil {
+		_os.Reset()
+		err = _rspTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _rspJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_rspJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
 	var _status map[string]string
 	s, ok := current.GetResponseStatus(ctx)
 	if ok && s != nil {
@@ -253,12 +776,12 @@ func (_obj *PropertyF) Dispatch(ctx context.Context, _val interface{}, req *requ
 		_context = c
 	}
 	*resp = requestf.ResponsePacket{
-		IVersion:     1,
+		IVersion:     req.IVersion,
 		CPacketType:  0,
 		IRequestId:   req.IRequestId,
 		IMessageType: 0,
 		IRet:         0,
-		SBuffer:      tools.ByteToInt8(_os.ToBytes()),
+		SBuffer:      _os.ToBytes(),
 		Status:       _status,
 		SResultDesc:  "",
 		Context:      _context,
@@ -1,4 +1,4 @@
-//Package propertyf comment
+// Package propertyf comment
 // This file war generated by tars2go 1.1
 // Generated from PropertyF.tars
 package propertyf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//StatPropInfo strcut implement
+// StatPropInfo strcut implement
 type StatPropInfo struct {
 	Policy string `json:"policy"`
 	Value  string `json:"value"`
@@ -17,7 +17,7 @@ type StatPropInfo struct {
 func (st *StatPropInfo) resetDefault() {
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *StatPropInfo) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -41,7 +41,7 @@ func (st *StatPropInfo) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *StatPropInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -69,7 +69,7 @@ func (st *StatPropInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) err
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *StatPropInfo) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -86,7 +86,7 @@ func (st *StatPropInfo) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *StatPropInfo) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
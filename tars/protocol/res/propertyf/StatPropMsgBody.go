@@ -1,4 +1,4 @@
-//Package propertyf comment
+// Package propertyf comment
 // This file war generated by tars2go 1.1
 // Generated from PropertyF.tars
 package propertyf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//StatPropMsgBody strcut implement
+// StatPropMsgBody strcut implement
 type StatPropMsgBody struct {
 	VInfo []StatPropInfo `json:"vInfo"`
 }
@@ -16,7 +16,7 @@ type StatPropMsgBody struct {
 func (st *StatPropMsgBody) resetDefault() {
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *StatPropMsgBody) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -60,7 +60,7 @@ func (st *StatPropMsgBody) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *StatPropMsgBody) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -88,7 +88,7 @@ func (st *StatPropMsgBody) ReadBlock(_is *codec.Reader, tag byte, require bool)
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *StatPropMsgBody) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -111,7 +111,7 @@ func (st *StatPropMsgBody) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *StatPropMsgBody) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
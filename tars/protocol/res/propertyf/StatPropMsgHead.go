@@ -1,4 +1,4 @@
-//Package propertyf comment
+// Package propertyf comment
 // This file war generated by tars2go 1.1
 // Generated from PropertyF.tars
 package propertyf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//StatPropMsgHead strcut implement
+// StatPropMsgHead strcut implement
 type StatPropMsgHead struct {
 	ModuleName   string `json:"moduleName"`
 	Ip           string `json:"ip"`
@@ -24,7 +24,7 @@ func (st *StatPropMsgHead) resetDefault() {
 	st.IPropertyVer = 1
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *StatPropMsgHead) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -78,7 +78,7 @@ func (st *StatPropMsgHead) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *StatPropMsgHead) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -106,7 +106,7 @@ func (st *StatPropMsgHead) ReadBlock(_is *codec.Reader, tag byte, require bool)
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *StatPropMsgHead) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -153,7 +153,7 @@ func (st *StatPropMsgHead) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *StatPropMsgHead) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
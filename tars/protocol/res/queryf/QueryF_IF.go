@@ -1,36 +1,78 @@
-//Package queryf comment
+// Package queryf comment
 // This file war generated by tars2go 1.1
 // Generated from QueryF.tars
 package queryf
 
 import (
 	"context"
+	"encoding/json"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/endpointf"
 	"fmt"
 	m "github.com/TarsCloud/TarsGo/tars/model"
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
-	"github.com/TarsCloud/TarsGo/tars/protocol/res/endpointf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/tup"
 	"github.com/TarsCloud/TarsGo/tars/util/current"
-	"github.com/TarsCloud/TarsGo/tars/util/tools"
 )
 
-//QueryF struct
+// QueryF struct
 type QueryF struct {
 	s m.Servant
 }
 
-//FindObjectById is the proxy function for the method defined in the tars file, with the context
+// FindObjectById is the proxy function for the method defined in the tars file, with the context
 func (_obj *QueryF) FindObjectById(Id string, _opt ...map[string]string) (ret []endpointf.EndpointF, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -40,59 +82,166 @@ func (_obj *QueryF) FindObjectById(Id string, _opt ...map[string]string) (ret []
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
 	err = _obj.s.Tars_invoke(ctx, 0, "findObjectById", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err, _, ty = _is.SkipToNoCheck(0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
 		if err != nil {
 			return ret, err
 		}
-		ret = make([]endpointf.EndpointF, length, length)
-		for i0, e0 := int32(0), length; i0 < e0; i0++ {
-
-			err = ret[i0].ReadBlock(_is, 0, false)
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			ret = make([]endpointf.EndpointF, length, length)
+			for i0, e0 := int32(0), length; i0 < e0; i0++ {
+
+				err = ret[i0].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			ret = make([]endpointf.EndpointF, length, length)
+			for i1, e1 := int32(0), length; i1 < e1; i1++ {
+
+				err = ret[i1].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//FindObjectByIdWithContext is the proxy function for the method defined in the tars file, with the context
+// FindObjectByIdWithContext is the proxy function for the method defined in the tars file, with the context
 func (_obj *QueryF) FindObjectByIdWithContext(ctx context.Context, Id string, _opt ...map[string]string) (ret []endpointf.EndpointF, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
 	var _status map[string]string
@@ -108,56 +257,164 @@ func (_obj *QueryF) FindObjectByIdWithContext(ctx context.Context, Id string, _o
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err, _, ty = _is.SkipToNoCheck(0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
 		if err != nil {
 			return ret, err
 		}
-		ret = make([]endpointf.EndpointF, length, length)
-		for i1, e1 := int32(0), length; i1 < e1; i1++ {
-
-			err = ret[i1].ReadBlock(_is, 0, false)
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			ret = make([]endpointf.EndpointF, length, length)
+			for i2, e2 := int32(0), length; i2 < e2; i2++ {
+
+				err = ret[i2].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			ret = make([]endpointf.EndpointF, length, length)
+			for i3, e3 := int32(0), length; i3 < e3; i3++ {
+
+				err = ret[i3].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//FindObjectById4Any is the proxy function for the method defined in the tars file, with the context
-func (_obj *QueryF) FindObjectById4Any(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+// FindObjectByIdOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *QueryF) FindObjectByIdOneway(Id string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -166,97 +423,138 @@ func (_obj *QueryF) FindObjectById4Any(Id string, ActiveEp *[]endpointf.Endpoint
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "findObjectById4Any", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectById", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	err, _, ty = _is.SkipToNoCheck(2, true)
-	if err != nil {
-		return ret, err
-	}
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
 
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
-		if err != nil {
-			return ret, err
-		}
-		(*ActiveEp) = make([]endpointf.EndpointF, length, length)
-		for i2, e2 := int32(0), length; i2 < e2; i2++ {
+// FindObjectByIdOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *QueryF) FindObjectByIdOnewayWithContext(ctx context.Context, Id string, _opt ...map[string]string) (err error) {
 
-			err = (*ActiveEp)[i2].ReadBlock(_is, 0, false)
-			if err != nil {
-				return ret, err
-			}
-		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
 		if err != nil {
-			return ret, err
+			return err
 		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err = _os.Write_string(Id, 1)
 		if err != nil {
-			return ret, err
+			return err
 		}
 	}
-
-	err, _, ty = _is.SkipToNoCheck(3, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
 		if err != nil {
-			return ret, err
-		}
-		(*InactiveEp) = make([]endpointf.EndpointF, length, length)
-		for i3, e3 := int32(0), length; i3 < e3; i3++ {
-
-			err = (*InactiveEp)[i3].ReadBlock(_is, 0, false)
-			if err != nil {
-				return ret, err
-			}
+			return err
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
 		if err != nil {
-			return ret, err
+			return err
 		}
-	} else {
-		err = fmt.Errorf("require vector, but not")
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
 		if err != nil {
-			return ret, err
+			return err
 		}
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectById", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//FindObjectById4AnyWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *QueryF) FindObjectById4AnyWithContext(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+// FindObjectById4Any is the proxy function for the method defined in the tars file, with the context
+func (_obj *QueryF) FindObjectById4Any(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -270,188 +568,258 @@ func (_obj *QueryF) FindObjectById4AnyWithContext(ctx context.Context, Id string
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err, _, ty = _is.SkipToNoCheck(2, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
 		if err != nil {
 			return ret, err
 		}
-		(*ActiveEp) = make([]endpointf.EndpointF, length, length)
-		for i4, e4 := int32(0), length; i4 < e4; i4++ {
-
-			err = (*ActiveEp)[i4].ReadBlock(_is, 0, false)
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
 		if err != nil {
 			return ret, err
 		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err = _is.Read_int32(&ret, 0, true)
 		if err != nil {
 			return ret, err
 		}
 	}
-
-	err, _, ty = _is.SkipToNoCheck(3, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
-		if err != nil {
-			return ret, err
-		}
-		(*InactiveEp) = make([]endpointf.EndpointF, length, length)
-		for i5, e5 := int32(0), length; i5 < e5; i5++ {
-
-			err = (*InactiveEp)[i5].ReadBlock(_is, 0, false)
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["activeEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*ActiveEp))
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("activeEp", &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-	} else {
-		err = fmt.Errorf("require vector, but not")
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return ret, err
 		}
-	}
-
-	setMap(len(_opt), _resp, _context, _status)
-	_ = length
-	_ = have
-	_ = ty
-	return ret, nil
-}
-
-//FindObjectById4All is the proxy function for the method defined in the tars file, with the context
-func (_obj *QueryF) FindObjectById4All(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
-
-	var length int32
-	var have bool
-	var ty byte
-	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
-	}
-
-	var _status map[string]string
-	var _context map[string]string
-	if len(_opt) == 1 {
-		_context = _opt[0]
-	} else if len(_opt) == 2 {
-		_context = _opt[0]
-		_status = _opt[1]
-	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "findObjectById4All", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
 
-	err, _, ty = _is.SkipToNoCheck(2, true)
-	if err != nil {
-		return ret, err
-	}
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i4, e4 := int32(0), length; i4 < e4; i4++ {
 
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+				err = (*ActiveEp)[i4].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(2, true)
 		if err != nil {
 			return ret, err
 		}
-		(*ActiveEp) = make([]endpointf.EndpointF, length, length)
-		for i6, e6 := int32(0), length; i6 < e6; i6++ {
 
-			err = (*ActiveEp)[i6].ReadBlock(_is, 0, false)
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i5, e5 := int32(0), length; i5 < e5; i5++ {
+
+				err = (*ActiveEp)[i5].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
-		if err != nil {
-			return ret, err
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["inactiveEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*InactiveEp))
+			if err != nil {
+				return ret, err
+			}
 		}
-	} else {
-		err = fmt.Errorf("require vector, but not")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("inactiveEp", &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-	}
-
-	err, _, ty = _is.SkipToNoCheck(3, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return ret, err
 		}
-		(*InactiveEp) = make([]endpointf.EndpointF, length, length)
-		for i7, e7 := int32(0), length; i7 < e7; i7++ {
 
-			err = (*InactiveEp)[i7].ReadBlock(_is, 0, false)
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i6, e6 := int32(0), length; i6 < e6; i6++ {
+
+				err = (*InactiveEp)[i6].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
 			if err != nil {
 				return ret, err
 			}
-		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
-		if err != nil {
-			return ret, err
 		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err, _, ty = _is.SkipToNoCheck(3, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i7, e7 := int32(0), length; i7 < e7; i7++ {
+
+				err = (*InactiveEp)[i7].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//FindObjectById4AllWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *QueryF) FindObjectById4AllWithContext(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+// FindObjectById4AnyWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *QueryF) FindObjectById4AnyWithContext(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
 	var _status map[string]string
@@ -463,297 +831,404 @@ func (_obj *QueryF) FindObjectById4AllWithContext(ctx context.Context, Id string
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "findObjectById4All", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err, _, ty = _is.SkipToNoCheck(2, true)
+	err = _obj.s.Tars_invoke(ctx, 0, "findObjectById4Any", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
 		if err != nil {
 			return ret, err
 		}
-		(*ActiveEp) = make([]endpointf.EndpointF, length, length)
-		for i8, e8 := int32(0), length; i8 < e8; i8++ {
-
-			err = (*ActiveEp)[i8].ReadBlock(_is, 0, false)
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-	} else {
-		err = fmt.Errorf("require vector, but not")
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
 		if err != nil {
 			return ret, err
 		}
-	}
-
-	err, _, ty = _is.SkipToNoCheck(3, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
 		if err != nil {
 			return ret, err
 		}
-		(*InactiveEp) = make([]endpointf.EndpointF, length, length)
-		for i9, e9 := int32(0), length; i9 < e9; i9++ {
-
-			err = (*InactiveEp)[i9].ReadBlock(_is, 0, false)
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["activeEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*ActiveEp))
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("activeEp", &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-	} else {
-		err = fmt.Errorf("require vector, but not")
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return ret, err
 		}
-	}
-
-	setMap(len(_opt), _resp, _context, _status)
-	_ = length
-	_ = have
-	_ = ty
-	return ret, nil
-}
 
-//FindObjectByIdInSameGroup is the proxy function for the method defined in the tars file, with the context
-func (_obj *QueryF) FindObjectByIdInSameGroup(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
-
-	var length int32
-	var have bool
-	var ty byte
-	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
-	}
-
-	var _status map[string]string
-	var _context map[string]string
-	if len(_opt) == 1 {
-		_context = _opt[0]
-	} else if len(_opt) == 2 {
-		_context = _opt[0]
-		_status = _opt[1]
-	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameGroup", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err, _, ty = _is.SkipToNoCheck(2, true)
-	if err != nil {
-		return ret, err
-	}
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i8, e8 := int32(0), length; i8 < e8; i8++ {
 
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+				err = (*ActiveEp)[i8].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(2, true)
 		if err != nil {
 			return ret, err
 		}
-		(*ActiveEp) = make([]endpointf.EndpointF, length, length)
-		for i10, e10 := int32(0), length; i10 < e10; i10++ {
 
-			err = (*ActiveEp)[i10].ReadBlock(_is, 0, false)
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i9, e9 := int32(0), length; i9 < e9; i9++ {
+
+				err = (*ActiveEp)[i9].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
-		if err != nil {
-			return ret, err
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["inactiveEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*InactiveEp))
+			if err != nil {
+				return ret, err
+			}
 		}
-	} else {
-		err = fmt.Errorf("require vector, but not")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("inactiveEp", &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-	}
-
-	err, _, ty = _is.SkipToNoCheck(3, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return ret, err
 		}
-		(*InactiveEp) = make([]endpointf.EndpointF, length, length)
-		for i11, e11 := int32(0), length; i11 < e11; i11++ {
 
-			err = (*InactiveEp)[i11].ReadBlock(_is, 0, false)
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i10, e10 := int32(0), length; i10 < e10; i10++ {
+
+				err = (*InactiveEp)[i10].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
 			if err != nil {
 				return ret, err
 			}
-		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
-		if err != nil {
-			return ret, err
 		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err, _, ty = _is.SkipToNoCheck(3, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i11, e11 := int32(0), length; i11 < e11; i11++ {
+
+				err = (*InactiveEp)[i11].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//FindObjectByIdInSameGroupWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *QueryF) FindObjectByIdInSameGroupWithContext(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+// FindObjectById4AnyOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *QueryF) FindObjectById4AnyOneway(Id string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
-	}
-
-	var _status map[string]string
-	var _context map[string]string
-	if len(_opt) == 1 {
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
 		_context = _opt[0]
 	} else if len(_opt) == 2 {
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameGroup", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectById4Any", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	err, _, ty = _is.SkipToNoCheck(2, true)
-	if err != nil {
-		return ret, err
-	}
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
 
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
-		if err != nil {
-			return ret, err
-		}
-		(*ActiveEp) = make([]endpointf.EndpointF, length, length)
-		for i12, e12 := int32(0), length; i12 < e12; i12++ {
+// FindObjectById4AnyOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *QueryF) FindObjectById4AnyOnewayWithContext(ctx context.Context, Id string, _opt ...map[string]string) (err error) {
 
-			err = (*ActiveEp)[i12].ReadBlock(_is, 0, false)
-			if err != nil {
-				return ret, err
-			}
-		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
 		if err != nil {
-			return ret, err
+			return err
 		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err = _os.Write_string(Id, 1)
 		if err != nil {
-			return ret, err
+			return err
 		}
 	}
-
-	err, _, ty = _is.SkipToNoCheck(3, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
 		if err != nil {
-			return ret, err
-		}
-		(*InactiveEp) = make([]endpointf.EndpointF, length, length)
-		for i13, e13 := int32(0), length; i13 < e13; i13++ {
-
-			err = (*InactiveEp)[i13].ReadBlock(_is, 0, false)
-			if err != nil {
-				return ret, err
-			}
+			return err
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
 		if err != nil {
-			return ret, err
+			return err
 		}
-	} else {
-		err = fmt.Errorf("require vector, but not")
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
 		if err != nil {
-			return ret, err
+			return err
 		}
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectById4Any", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//FindObjectByIdInSameStation is the proxy function for the method defined in the tars file, with the context
-func (_obj *QueryF) FindObjectByIdInSameStation(Id string, SStation string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+// FindObjectById4All is the proxy function for the method defined in the tars file, with the context
+func (_obj *QueryF) FindObjectById4All(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err = _os.Write_string(SStation, 2)
-	if err != nil {
-		return ret, err
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -763,99 +1238,262 @@ func (_obj *QueryF) FindObjectByIdInSameStation(Id string, SStation string, Acti
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameStation", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err, _, ty = _is.SkipToNoCheck(3, true)
+	err = _obj.s.Tars_invoke(ctx, 0, "findObjectById4All", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
 		if err != nil {
 			return ret, err
 		}
-		(*ActiveEp) = make([]endpointf.EndpointF, length, length)
-		for i14, e14 := int32(0), length; i14 < e14; i14++ {
-
-			err = (*ActiveEp)[i14].ReadBlock(_is, 0, false)
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
 		if err != nil {
 			return ret, err
 		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err = _is.Read_int32(&ret, 0, true)
 		if err != nil {
 			return ret, err
 		}
 	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["activeEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*ActiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("activeEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
 
-	err, _, ty = _is.SkipToNoCheck(4, true)
-	if err != nil {
-		return ret, err
-	}
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i12, e12 := int32(0), length; i12 < e12; i12++ {
 
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+				err = (*ActiveEp)[i12].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(2, true)
 		if err != nil {
 			return ret, err
 		}
-		(*InactiveEp) = make([]endpointf.EndpointF, length, length)
-		for i15, e15 := int32(0), length; i15 < e15; i15++ {
 
-			err = (*InactiveEp)[i15].ReadBlock(_is, 0, false)
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i13, e13 := int32(0), length; i13 < e13; i13++ {
+
+				err = (*ActiveEp)[i13].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["inactiveEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*InactiveEp))
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("inactiveEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i14, e14 := int32(0), length; i14 < e14; i14++ {
+
+				err = (*InactiveEp)[i14].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err, _, ty = _is.SkipToNoCheck(3, true)
 		if err != nil {
 			return ret, err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i15, e15 := int32(0), length; i15 < e15; i15++ {
+
+				err = (*InactiveEp)[i15].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//FindObjectByIdInSameStationWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *QueryF) FindObjectByIdInSameStationWithContext(ctx context.Context, Id string, SStation string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+// FindObjectById4AllWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *QueryF) FindObjectById4AllWithContext(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
 	}
-
-	err = _os.Write_string(SStation, 2)
-	if err != nil {
-		return ret, err
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
 	var _status map[string]string
@@ -867,98 +1505,331 @@ func (_obj *QueryF) FindObjectByIdInSameStationWithContext(ctx context.Context,
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameStation", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err, _, ty = _is.SkipToNoCheck(3, true)
+	err = _obj.s.Tars_invoke(ctx, 0, "findObjectById4All", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
 		if err != nil {
 			return ret, err
 		}
-		(*ActiveEp) = make([]endpointf.EndpointF, length, length)
-		for i16, e16 := int32(0), length; i16 < e16; i16++ {
-
-			err = (*ActiveEp)[i16].ReadBlock(_is, 0, false)
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-	} else {
-		err = fmt.Errorf("require vector, but not")
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
 		if err != nil {
 			return ret, err
 		}
-	}
-
-	err, _, ty = _is.SkipToNoCheck(4, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
 		if err != nil {
 			return ret, err
 		}
-		(*InactiveEp) = make([]endpointf.EndpointF, length, length)
-		for i17, e17 := int32(0), length; i17 < e17; i17++ {
-
-			err = (*InactiveEp)[i17].ReadBlock(_is, 0, false)
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["activeEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*ActiveEp))
 			if err != nil {
 				return ret, err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("activeEp", &_tupBuffer_)
 		if err != nil {
 			return ret, err
 		}
-	} else {
-		err = fmt.Errorf("require vector, but not")
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return ret, err
 		}
-	}
 
-	setMap(len(_opt), _resp, _context, _status)
-	_ = length
-	_ = have
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i16, e16 := int32(0), length; i16 < e16; i16++ {
+
+				err = (*ActiveEp)[i16].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(2, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i17, e17 := int32(0), length; i17 < e17; i17++ {
+
+				err = (*ActiveEp)[i17].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["inactiveEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*InactiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("inactiveEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i18, e18 := int32(0), length; i18 < e18; i18++ {
+
+				err = (*InactiveEp)[i18].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(3, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i19, e19 := int32(0), length; i19 < e19; i19++ {
+
+				err = (*InactiveEp)[i19].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//FindObjectByIdInSameSet is the proxy function for the method defined in the tars file, with the context
-func (_obj *QueryF) FindObjectByIdInSameSet(Id string, SetId string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+// FindObjectById4AllOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *QueryF) FindObjectById4AllOneway(Id string, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = _os.Write_string(SetId, 2)
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectById4All", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// FindObjectById4AllOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *QueryF) FindObjectById4AllOnewayWithContext(ctx context.Context, Id string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
 	}
 
 	var _status map[string]string
@@ -969,285 +1840,2806 @@ func (_obj *QueryF) FindObjectByIdInSameSet(Id string, SetId string, ActiveEp *[
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameSet", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectById4All", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// FindObjectByIdInSameGroup is the proxy function for the method defined in the tars file, with the context
+func (_obj *QueryF) FindObjectByIdInSameGroup(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
 	}
 
-	err, _, ty = _is.SkipToNoCheck(3, true)
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameGroup", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["activeEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*ActiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("activeEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i20, e20 := int32(0), length; i20 < e20; i20++ {
+
+				err = (*ActiveEp)[i20].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(2, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i21, e21 := int32(0), length; i21 < e21; i21++ {
 
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+				err = (*ActiveEp)[i21].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["inactiveEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*InactiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("inactiveEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i22, e22 := int32(0), length; i22 < e22; i22++ {
+
+				err = (*InactiveEp)[i22].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(3, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i23, e23 := int32(0), length; i23 < e23; i23++ {
+
+				err = (*InactiveEp)[i23].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// FindObjectByIdInSameGroupWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *QueryF) FindObjectByIdInSameGroupWithContext(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameGroup", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["activeEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*ActiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("activeEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i24, e24 := int32(0), length; i24 < e24; i24++ {
+
+				err = (*ActiveEp)[i24].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(2, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i25, e25 := int32(0), length; i25 < e25; i25++ {
+
+				err = (*ActiveEp)[i25].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["inactiveEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*InactiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("inactiveEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i26, e26 := int32(0), length; i26 < e26; i26++ {
+
+				err = (*InactiveEp)[i26].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(3, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i27, e27 := int32(0), length; i27 < e27; i27++ {
+
+				err = (*InactiveEp)[i27].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// FindObjectByIdInSameGroupOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *QueryF) FindObjectByIdInSameGroupOneway(Id string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectByIdInSameGroup", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// FindObjectByIdInSameGroupOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *QueryF) FindObjectByIdInSameGroupOnewayWithContext(ctx context.Context, Id string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectByIdInSameGroup", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// FindObjectByIdInSameStation is the proxy function for the method defined in the tars file, with the context
+func (_obj *QueryF) FindObjectByIdInSameStation(Id string, SStation string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sStation"] = SStation
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SStation, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("sStation", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SStation, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameStation", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["activeEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*ActiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("activeEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i28, e28 := int32(0), length; i28 < e28; i28++ {
+
+				err = (*ActiveEp)[i28].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(3, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i29, e29 := int32(0), length; i29 < e29; i29++ {
+
+				err = (*ActiveEp)[i29].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["inactiveEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*InactiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("inactiveEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i30, e30 := int32(0), length; i30 < e30; i30++ {
+
+				err = (*InactiveEp)[i30].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(4, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i31, e31 := int32(0), length; i31 < e31; i31++ {
+
+				err = (*InactiveEp)[i31].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// FindObjectByIdInSameStationWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *QueryF) FindObjectByIdInSameStationWithContext(ctx context.Context, Id string, SStation string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sStation"] = SStation
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SStation, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("sStation", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SStation, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameStation", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["activeEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*ActiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("activeEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i32, e32 := int32(0), length; i32 < e32; i32++ {
+
+				err = (*ActiveEp)[i32].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(3, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i33, e33 := int32(0), length; i33 < e33; i33++ {
+
+				err = (*ActiveEp)[i33].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["inactiveEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*InactiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("inactiveEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i34, e34 := int32(0), length; i34 < e34; i34++ {
+
+				err = (*InactiveEp)[i34].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(4, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i35, e35 := int32(0), length; i35 < e35; i35++ {
+
+				err = (*InactiveEp)[i35].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// FindObjectByIdInSameStationOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *QueryF) FindObjectByIdInSameStationOneway(Id string, SStation string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sStation"] = SStation
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SStation, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sStation", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SStation, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectByIdInSameStation", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// FindObjectByIdInSameStationOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *QueryF) FindObjectByIdInSameStationOnewayWithContext(ctx context.Context, Id string, SStation string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["sStation"] = SStation
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SStation, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("sStation", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SStation, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectByIdInSameStation", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// FindObjectByIdInSameSet is the proxy function for the method defined in the tars file, with the context
+func (_obj *QueryF) FindObjectByIdInSameSet(Id string, SetId string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["setId"] = SetId
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SetId, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("setId", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SetId, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameSet", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["activeEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*ActiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("activeEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i36, e36 := int32(0), length; i36 < e36; i36++ {
+
+				err = (*ActiveEp)[i36].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(3, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i37, e37 := int32(0), length; i37 < e37; i37++ {
+
+				err = (*ActiveEp)[i37].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["inactiveEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*InactiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("inactiveEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i38, e38 := int32(0), length; i38 < e38; i38++ {
+
+				err = (*InactiveEp)[i38].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(4, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i39, e39 := int32(0), length; i39 < e39; i39++ {
+
+				err = (*InactiveEp)[i39].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// FindObjectByIdInSameSetWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *QueryF) FindObjectByIdInSameSetWithContext(ctx context.Context, Id string, SetId string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["setId"] = SetId
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SetId, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("setId", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SetId, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameSet", _os.ToBytes(), _status, _context, _resp)
+	if err != nil {
+		return ret, err
+	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["activeEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*ActiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("activeEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i40, e40 := int32(0), length; i40 < e40; i40++ {
+
+				err = (*ActiveEp)[i40].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(3, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*ActiveEp) = make([]endpointf.EndpointF, length, length)
+			for i41, e41 := int32(0), length; i41 < e41; i41++ {
+
+				err = (*ActiveEp)[i41].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["inactiveEp"]; ok {
+			err = json.Unmarshal(_raw_, &(*InactiveEp))
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer("inactiveEp", &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i42, e42 := int32(0), length; i42 < e42; i42++ {
+
+				err = (*InactiveEp)[i42].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else {
+		err, _, ty = _is.SkipToNoCheck(4, true)
+		if err != nil {
+			return ret, err
+		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return ret, err
+			}
+			(*InactiveEp) = make([]endpointf.EndpointF, length, length)
+			for i43, e43 := int32(0), length; i43 < e43; i43++ {
+
+				err = (*InactiveEp)[i43].ReadBlock(_is, 0, false)
+				if err != nil {
+					return ret, err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return ret, err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// FindObjectByIdInSameSetOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *QueryF) FindObjectByIdInSameSetOneway(Id string, SetId string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["setId"] = SetId
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SetId, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("setId", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SetId, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectByIdInSameSet", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// FindObjectByIdInSameSetOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *QueryF) FindObjectByIdInSameSetOnewayWithContext(ctx context.Context, Id string, SetId string, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["id"] = Id
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(Id, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("id", _os.ToBytes())
+	} else {
+		err = _os.Write_string(Id, 1)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["setId"] = SetId
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_string(SetId, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("setId", _os.ToBytes())
+	} else {
+		err = _os.Write_string(SetId, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "findObjectByIdInSameSet", _os.ToBytes(), _status, _context, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// SetServant sets servant for the service.
+func (_obj *QueryF) SetServant(s m.Servant) {
+	_obj.s = s
+}
+
+// TarsSetTimeout sets the timeout for the servant which is in ms.
+func (_obj *QueryF) TarsSetTimeout(t int) {
+	_obj.s.TarsSetTimeout(t)
+}
+
+// TarsSetVersion sets the protocol version of the servant, basef.TUPVERSION for the servants speaking TUP,
+// basef.JSONVERSION for encoding the arguments as a JSON object. The servants other than m.VersionServant
+// only speak tars, it takes no effect on them.
+func (_obj *QueryF) TarsSetVersion(v int16) {
+	if vs, ok := _obj.s.(m.VersionServant); ok {
+		vs.TarsSetVersion(v)
+	}
+}
+func (_obj *QueryF) setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
+	if l == 1 {
+		for k, _ := range ctx {
+			delete(ctx, k)
+		}
+		for k, v := range res.Context {
+			ctx[k] = v
+		}
+	} else if l == 2 {
+		for k, _ := range ctx {
+			delete(ctx, k)
+		}
+		for k, v := range res.Context {
+			ctx[k] = v
+		}
+		for k, _ := range sts {
+			delete(sts, k)
+		}
+		for k, v := range res.Status {
+			sts[k] = v
+		}
+	}
+}
+
+type _impQueryF interface {
+	FindObjectById(Id string) (ret []endpointf.EndpointF, err error)
+	FindObjectById4Any(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
+	FindObjectById4All(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
+	FindObjectByIdInSameGroup(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
+	FindObjectByIdInSameStation(Id string, SStation string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
+	FindObjectByIdInSameSet(Id string, SetId string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
+}
+type _impQueryFWithContext interface {
+	FindObjectById(ctx context.Context, Id string) (ret []endpointf.EndpointF, err error)
+	FindObjectById4Any(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
+	FindObjectById4All(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
+	FindObjectByIdInSameGroup(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
+	FindObjectByIdInSameStation(ctx context.Context, Id string, SStation string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
+	FindObjectByIdInSameSet(ctx context.Context, Id string, SetId string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
+}
+
+func findObjectById(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	var Id string
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["id"]; ok {
+			err = json.Unmarshal(_raw_, &Id)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("id", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Id, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Id, 1, true)
+		if err != nil {
+			return err
+		}
+	}
+	if withContext == false {
+		_imp := _val.(_impQueryF)
+		ret, err := _imp.FindObjectById(Id)
+		if err != nil {
+			return err
+		}
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.WriteHead(codec.LIST, 0)
+			if err != nil {
+				return err
+			}
+			err = _os.Write_int32(int32(len(ret)), 0)
+			if err != nil {
+				return err
+			}
+			for _, v := range ret {
+
+				err = v.WriteBlock(_os, 0)
+				if err != nil {
+					return err
+				}
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.WriteHead(codec.LIST, 0)
+			if err != nil {
+				return err
+			}
+			err = _os.Write_int32(int32(len(ret)), 0)
+			if err != nil {
+				return err
+			}
+			for _, v := range ret {
+
+				err = v.WriteBlock(_os, 0)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	} else {
+		_imp := _val.(_impQueryFWithContext)
+		ret, err := _imp.FindObjectById(ctx, Id)
+		if err != nil {
+			return err
+		}
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.WriteHead(codec.LIST, 0)
+			if err != nil {
+				return err
+			}
+			err = _os.Write_int32(int32(len(ret)), 0)
+			if err != nil {
+				return err
+			}
+			for _, v := range ret {
+
+				err = v.WriteBlock(_os, 0)
+				if err != nil {
+					return err
+				}
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.WriteHead(codec.LIST, 0)
+			if err != nil {
+				return err
+			}
+			err = _os.Write_int32(int32(len(ret)), 0)
+			if err != nil {
+				return err
+			}
+			for _, v := range ret {
+
+				err = v.WriteBlock(_os, 0)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	return nil
+}
+func findObjectById4Any(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	var Id string
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["id"]; ok {
+			err = json.Unmarshal(_raw_, &Id)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("id", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Id, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Id, 1, true)
+		if err != nil {
+			return err
+		}
+	}
+	var ActiveEp []endpointf.EndpointF
+
+	var InactiveEp []endpointf.EndpointF
+
+	if withContext == false {
+		_imp := _val.(_impQueryF)
+		ret, err := _imp.FindObjectById4Any(Id, &ActiveEp, &InactiveEp)
+		if err != nil {
+			return err
+		}
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		_imp := _val.(_impQueryFWithContext)
+		ret, err := _imp.FindObjectById4Any(ctx, Id, &ActiveEp, &InactiveEp)
+		if err != nil {
+			return err
+		}
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["activeEp"] = ActiveEp
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(ActiveEp)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range ActiveEp {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_rspTup_.PutBuffer("activeEp", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 2)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(ActiveEp)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range ActiveEp {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["inactiveEp"] = InactiveEp
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(InactiveEp)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range InactiveEp {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_rspTup_.PutBuffer("inactiveEp", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 3)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(InactiveEp)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range InactiveEp {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	return nil
+}
+func findObjectById4All(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	var Id string
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["id"]; ok {
+			err = json.Unmarshal(_raw_, &Id)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("id", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Id, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&Id, 1, true)
+		if err != nil {
+			return err
+		}
+	}
+	var ActiveEp []endpointf.EndpointF
+
+	var InactiveEp []endpointf.EndpointF
+
+	if withContext == false {
+		_imp := _val.(_impQueryF)
+		ret, err := _imp.FindObjectById4All(Id, &ActiveEp, &InactiveEp)
+		if err != nil {
+			return err
+		}
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		_imp := _val.(_impQueryFWithContext)
+		ret, err := _imp.FindObjectById4All(ctx, Id, &ActiveEp, &InactiveEp)
+		if err != nil {
+			return err
+		}
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["activeEp"] = ActiveEp
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(ActiveEp)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range ActiveEp {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_rspTup_.PutBuffer("activeEp", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 2)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(ActiveEp)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range ActiveEp {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["inactiveEp"] = InactiveEp
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(InactiveEp)), 0)
 		if err != nil {
-			return ret, err
+			return err
 		}
-		(*ActiveEp) = make([]endpointf.EndpointF, length, length)
-		for i18, e18 := int32(0), length; i18 < e18; i18++ {
+		for _, v := range InactiveEp {
 
-			err = (*ActiveEp)[i18].ReadBlock(_is, 0, false)
+			err = v.WriteBlock(_os, 0)
 			if err != nil {
-				return ret, err
+				return err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
-		if err != nil {
-			return ret, err
-		}
+		_rspTup_.PutBuffer("inactiveEp", _os.ToBytes())
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err = _os.WriteHead(codec.LIST, 3)
 		if err != nil {
-			return ret, err
+			return err
 		}
-	}
-
-	err, _, ty = _is.SkipToNoCheck(4, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+		err = _os.Write_int32(int32(len(InactiveEp)), 0)
 		if err != nil {
-			return ret, err
+			return err
 		}
-		(*InactiveEp) = make([]endpointf.EndpointF, length, length)
-		for i19, e19 := int32(0), length; i19 < e19; i19++ {
+		for _, v := range InactiveEp {
 
-			err = (*InactiveEp)[i19].ReadBlock(_is, 0, false)
+			err = v.WriteBlock(_os, 0)
 			if err != nil {
-				return ret, err
+				return err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
-		if err != nil {
-			return ret, err
-		}
-	} else {
-		err = fmt.Errorf("require vector, but not")
-		if err != nil {
-			return ret, err
-		}
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	_ = _tupBuffer_
+	return nil
 }
-
-//FindObjectByIdInSameSetWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *QueryF) FindObjectByIdInSameSetWithContext(ctx context.Context, Id string, SetId string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF, _opt ...map[string]string) (ret int32, err error) {
-
+func findObjectByIdInSameGroup(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
-	_os := codec.NewBuffer()
-	err = _os.Write_string(Id, 1)
-	if err != nil {
-		return ret, err
-	}
-
-	err = _os.Write_string(SetId, 2)
-	if err != nil {
-		return ret, err
-	}
-
-	var _status map[string]string
-	var _context map[string]string
-	if len(_opt) == 1 {
-		_context = _opt[0]
-	} else if len(_opt) == 2 {
-		_context = _opt[0]
-		_status = _opt[1]
-	}
-	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "findObjectByIdInSameSet", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
-	}
-
-	err, _, ty = _is.SkipToNoCheck(3, true)
-	if err != nil {
-		return ret, err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
-		if err != nil {
-			return ret, err
-		}
-		(*ActiveEp) = make([]endpointf.EndpointF, length, length)
-		for i20, e20 := int32(0), length; i20 < e20; i20++ {
-
-			err = (*ActiveEp)[i20].ReadBlock(_is, 0, false)
+	var _tupBuffer_ []byte
+	var Id string
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["id"]; ok {
+			err = json.Unmarshal(_raw_, &Id)
 			if err != nil {
-				return ret, err
+				return err
 			}
 		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("id", &_tupBuffer_)
 		if err != nil {
-			return ret, err
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Id, 0, true)
+		if err != nil {
+			return err
 		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err = _is.Read_string(&Id, 1, true)
 		if err != nil {
-			return ret, err
+			return err
 		}
 	}
+	var ActiveEp []endpointf.EndpointF
 
-	err, _, ty = _is.SkipToNoCheck(4, true)
-	if err != nil {
-		return ret, err
-	}
+	var InactiveEp []endpointf.EndpointF
 
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	if withContext == false {
+		_imp := _val.(_impQueryF)
+		ret, err := _imp.FindObjectByIdInSameGroup(Id, &ActiveEp, &InactiveEp)
 		if err != nil {
-			return ret, err
+			return err
 		}
-		(*InactiveEp) = make([]endpointf.EndpointF, length, length)
-		for i21, e21 := int32(0), length; i21 < e21; i21++ {
-
-			err = (*InactiveEp)[i21].ReadBlock(_is, 0, false)
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
 			if err != nil {
-				return ret, err
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
 			}
-		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
-		if err != nil {
-			return ret, err
 		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		_imp := _val.(_impQueryFWithContext)
+		ret, err := _imp.FindObjectByIdInSameGroup(ctx, Id, &ActiveEp, &InactiveEp)
 		if err != nil {
-			return ret, err
-		}
-	}
-
-	setMap(len(_opt), _resp, _context, _status)
-	_ = length
-	_ = have
-	_ = ty
-	return ret, nil
-}
-
-//SetServant sets servant for the service.
-func (_obj *QueryF) SetServant(s m.Servant) {
-	_obj.s = s
-}
-
-//TarsSetTimeout sets the timeout for the servant which is in ms.
-func (_obj *QueryF) TarsSetTimeout(t int) {
-	_obj.s.TarsSetTimeout(t)
-}
-func setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
-	if l == 1 {
-		for k := range ctx {
-			delete(ctx, k)
+			return err
 		}
-		for k, v := range res.Context {
-			ctx[k] = v
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
-	} else if l == 2 {
-		for k := range ctx {
-			delete(ctx, k)
+	}
+	if _rspJson_ != nil {
+		_rspJson_["activeEp"] = ActiveEp
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
 		}
-		for k, v := range res.Context {
-			ctx[k] = v
+		err = _os.Write_int32(int32(len(ActiveEp)), 0)
+		if err != nil {
+			return err
 		}
-		for k := range sts {
-			delete(sts, k)
+		for _, v := range ActiveEp {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
 		}
-		for k, v := range res.Status {
-			sts[k] = v
+		_rspTup_.PutBuffer("activeEp", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 2)
+		if err != nil {
+			return err
 		}
-	}
-}
-
-type _impQueryF interface {
-	FindObjectById(Id string) (ret []endpointf.EndpointF, err error)
-	FindObjectById4Any(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
-	FindObjectById4All(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
-	FindObjectByIdInSameGroup(Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
-	FindObjectByIdInSameStation(Id string, SStation string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
-	FindObjectByIdInSameSet(Id string, SetId string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
-}
-type _impQueryFWithContext interface {
-	FindObjectById(ctx context.Context, Id string) (ret []endpointf.EndpointF, err error)
-	FindObjectById4Any(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
-	FindObjectById4All(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
-	FindObjectByIdInSameGroup(ctx context.Context, Id string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
-	FindObjectByIdInSameStation(ctx context.Context, Id string, SStation string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
-	FindObjectByIdInSameSet(ctx context.Context, Id string, SetId string, ActiveEp *[]endpointf.EndpointF, InactiveEp *[]endpointf.EndpointF) (ret int32, err error)
-}
-
-func findObjectById(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
-	var length int32
-	var have bool
-	var ty byte
-	var Id string
-	err = _is.Read_string(&Id, 1, true)
-	if err != nil {
-		return err
-	}
-	if withContext == false {
-		_imp := _val.(_impQueryF)
-		ret, err := _imp.FindObjectById(Id)
+		err = _os.Write_int32(int32(len(ActiveEp)), 0)
 		if err != nil {
 			return err
 		}
+		for _, v := range ActiveEp {
 
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["inactiveEp"] = InactiveEp
+	} else if _rspTup_ != nil {
+		_os.Reset()
 		err = _os.WriteHead(codec.LIST, 0)
 		if err != nil {
 			return err
 		}
-		err = _os.Write_int32(int32(len(ret)), 0)
+		err = _os.Write_int32(int32(len(InactiveEp)), 0)
 		if err != nil {
 			return err
 		}
-		for _, v := range ret {
+		for _, v := range InactiveEp {
 
 			err = v.WriteBlock(_os, 0)
 			if err != nil {
 				return err
 			}
 		}
+		_rspTup_.PutBuffer("inactiveEp", _os.ToBytes())
 	} else {
-		_imp := _val.(_impQueryFWithContext)
-		ret, err := _imp.FindObjectById(ctx, Id)
-		if err != nil {
-			return err
-		}
-
-		err = _os.WriteHead(codec.LIST, 0)
+		err = _os.WriteHead(codec.LIST, 3)
 		if err != nil {
 			return err
 		}
-		err = _os.Write_int32(int32(len(ret)), 0)
+		err = _os.Write_int32(int32(len(InactiveEp)), 0)
 		if err != nil {
 			return err
 		}
-		for _, v := range ret {
+		for _, v := range InactiveEp {
 
 			err = v.WriteBlock(_os, 0)
 			if err != nil {
@@ -1259,411 +4651,435 @@ func findObjectById(ctx context.Context, _val interface{}, _os *codec.Buffer, _i
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func findObjectById4Any(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func findObjectByIdInSameStation(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var Id string
-	err = _is.Read_string(&Id, 1, true)
-	if err != nil {
-		return err
-	}
-	var ActiveEp []endpointf.EndpointF
-	var InactiveEp []endpointf.EndpointF
-	if withContext == false {
-		_imp := _val.(_impQueryF)
-		ret, err := _imp.FindObjectById4Any(Id, &ActiveEp, &InactiveEp)
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["id"]; ok {
+			err = json.Unmarshal(_raw_, &Id)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("id", &_tupBuffer_)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Id, 0, true)
 		if err != nil {
 			return err
 		}
 	} else {
-		_imp := _val.(_impQueryFWithContext)
-		ret, err := _imp.FindObjectById4Any(ctx, Id, &ActiveEp, &InactiveEp)
+		err = _is.Read_string(&Id, 1, true)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+	}
+	var SStation string
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["sStation"]; ok {
+			err = json.Unmarshal(_raw_, &SStation)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("sStation", &_tupBuffer_)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.WriteHead(codec.LIST, 2)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(ActiveEp)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range ActiveEp {
-
-		err = v.WriteBlock(_os, 0)
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&SStation, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_string(&SStation, 2, true)
 		if err != nil {
 			return err
 		}
 	}
+	var ActiveEp []endpointf.EndpointF
 
-	err = _os.WriteHead(codec.LIST, 3)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(InactiveEp)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range InactiveEp {
+	var InactiveEp []endpointf.EndpointF
 
-		err = v.WriteBlock(_os, 0)
+	if withContext == false {
+		_imp := _val.(_impQueryF)
+		ret, err := _imp.FindObjectByIdInSameStation(Id, SStation, &ActiveEp, &InactiveEp)
+		if err != nil {
+			return err
+		}
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		_imp := _val.(_impQueryFWithContext)
+		ret, err := _imp.FindObjectByIdInSameStation(ctx, Id, SStation, &ActiveEp, &InactiveEp)
 		if err != nil {
 			return err
 		}
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
 	}
-
-	_ = length
-	_ = have
-	_ = ty
-	return nil
-}
-func findObjectById4All(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
-	var length int32
-	var have bool
-	var ty byte
-	var Id string
-	err = _is.Read_string(&Id, 1, true)
-	if err != nil {
-		return err
-	}
-	var ActiveEp []endpointf.EndpointF
-	var InactiveEp []endpointf.EndpointF
-	if withContext == false {
-		_imp := _val.(_impQueryF)
-		ret, err := _imp.FindObjectById4All(Id, &ActiveEp, &InactiveEp)
+	if _rspJson_ != nil {
+		_rspJson_["activeEp"] = ActiveEp
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		err = _os.Write_int32(int32(len(ActiveEp)), 0)
 		if err != nil {
 			return err
 		}
+		for _, v := range ActiveEp {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_rspTup_.PutBuffer("activeEp", _os.ToBytes())
 	} else {
-		_imp := _val.(_impQueryFWithContext)
-		ret, err := _imp.FindObjectById4All(ctx, Id, &ActiveEp, &InactiveEp)
+		err = _os.WriteHead(codec.LIST, 3)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		err = _os.Write_int32(int32(len(ActiveEp)), 0)
 		if err != nil {
 			return err
 		}
-	}
+		for _, v := range ActiveEp {
 
-	err = _os.WriteHead(codec.LIST, 2)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(ActiveEp)), 0)
-	if err != nil {
-		return err
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
 	}
-	for _, v := range ActiveEp {
-
-		err = v.WriteBlock(_os, 0)
+	if _rspJson_ != nil {
+		_rspJson_["inactiveEp"] = InactiveEp
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.WriteHead(codec.LIST, 3)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(InactiveEp)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range InactiveEp {
+		err = _os.Write_int32(int32(len(InactiveEp)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range InactiveEp {
 
-		err = v.WriteBlock(_os, 0)
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_rspTup_.PutBuffer("inactiveEp", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 4)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(InactiveEp)), 0)
 		if err != nil {
 			return err
 		}
+		for _, v := range InactiveEp {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func findObjectByIdInSameGroup(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func findObjectByIdInSameSet(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var Id string
-	err = _is.Read_string(&Id, 1, true)
-	if err != nil {
-		return err
-	}
-	var ActiveEp []endpointf.EndpointF
-	var InactiveEp []endpointf.EndpointF
-	if withContext == false {
-		_imp := _val.(_impQueryF)
-		ret, err := _imp.FindObjectByIdInSameGroup(Id, &ActiveEp, &InactiveEp)
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["id"]; ok {
+			err = json.Unmarshal(_raw_, &Id)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("id", &_tupBuffer_)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&Id, 0, true)
 		if err != nil {
 			return err
 		}
 	} else {
-		_imp := _val.(_impQueryFWithContext)
-		ret, err := _imp.FindObjectByIdInSameGroup(ctx, Id, &ActiveEp, &InactiveEp)
+		err = _is.Read_string(&Id, 1, true)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+	}
+	var SetId string
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["setId"]; ok {
+			err = json.Unmarshal(_raw_, &SetId)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("setId", &_tupBuffer_)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.WriteHead(codec.LIST, 2)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(ActiveEp)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range ActiveEp {
-
-		err = v.WriteBlock(_os, 0)
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_string(&SetId, 0, true)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.WriteHead(codec.LIST, 3)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(InactiveEp)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range InactiveEp {
-
-		err = v.WriteBlock(_os, 0)
+	} else {
+		err = _is.Read_string(&SetId, 2, true)
 		if err != nil {
 			return err
 		}
 	}
-
-	_ = length
-	_ = have
-	_ = ty
-	return nil
-}
-func findObjectByIdInSameStation(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
-	var length int32
-	var have bool
-	var ty byte
-	var Id string
-	err = _is.Read_string(&Id, 1, true)
-	if err != nil {
-		return err
-	}
-	var SStation string
-	err = _is.Read_string(&SStation, 2, true)
-	if err != nil {
-		return err
-	}
 	var ActiveEp []endpointf.EndpointF
+
 	var InactiveEp []endpointf.EndpointF
+
 	if withContext == false {
 		_imp := _val.(_impQueryF)
-		ret, err := _imp.FindObjectByIdInSameStation(Id, SStation, &ActiveEp, &InactiveEp)
+		ret, err := _imp.FindObjectByIdInSameSet(Id, SetId, &ActiveEp, &InactiveEp)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impQueryFWithContext)
-		ret, err := _imp.FindObjectByIdInSameStation(ctx, Id, SStation, &ActiveEp, &InactiveEp)
+		ret, err := _imp.FindObjectByIdInSameSet(ctx, Id, SetId, &ActiveEp, &InactiveEp)
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["activeEp"] = ActiveEp
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.WriteHead(codec.LIST, 3)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(ActiveEp)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range ActiveEp {
-
-		err = v.WriteBlock(_os, 0)
+		err = _os.Write_int32(int32(len(ActiveEp)), 0)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.WriteHead(codec.LIST, 4)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(InactiveEp)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range InactiveEp {
+		for _, v := range ActiveEp {
 
-		err = v.WriteBlock(_os, 0)
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_rspTup_.PutBuffer("activeEp", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 3)
 		if err != nil {
 			return err
 		}
-	}
-
-	_ = length
-	_ = have
-	_ = ty
-	return nil
-}
-func findObjectByIdInSameSet(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
-	var length int32
-	var have bool
-	var ty byte
-	var Id string
-	err = _is.Read_string(&Id, 1, true)
-	if err != nil {
-		return err
-	}
-	var SetId string
-	err = _is.Read_string(&SetId, 2, true)
-	if err != nil {
-		return err
-	}
-	var ActiveEp []endpointf.EndpointF
-	var InactiveEp []endpointf.EndpointF
-	if withContext == false {
-		_imp := _val.(_impQueryF)
-		ret, err := _imp.FindObjectByIdInSameSet(Id, SetId, &ActiveEp, &InactiveEp)
+		err = _os.Write_int32(int32(len(ActiveEp)), 0)
 		if err != nil {
 			return err
 		}
+		for _, v := range ActiveEp {
 
-		err = _os.Write_int32(ret, 0)
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		_rspJson_["inactiveEp"] = InactiveEp
+	} else if _rspTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
 		if err != nil {
 			return err
 		}
-	} else {
-		_imp := _val.(_impQueryFWithContext)
-		ret, err := _imp.FindObjectByIdInSameSet(ctx, Id, SetId, &ActiveEp, &InactiveEp)
+		err = _os.Write_int32(int32(len(InactiveEp)), 0)
 		if err != nil {
 			return err
 		}
+		for _, v := range InactiveEp {
 
-		err = _os.Write_int32(ret, 0)
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_rspTup_.PutBuffer("inactiveEp", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 4)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.WriteHead(codec.LIST, 3)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(ActiveEp)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range ActiveEp {
-
-		err = v.WriteBlock(_os, 0)
+		err = _os.Write_int32(int32(len(InactiveEp)), 0)
 		if err != nil {
 			return err
 		}
-	}
-
-	err = _os.WriteHead(codec.LIST, 4)
-	if err != nil {
-		return err
-	}
-	err = _os.Write_int32(int32(len(InactiveEp)), 0)
-	if err != nil {
-		return err
-	}
-	for _, v := range InactiveEp {
+		for _, v := range InactiveEp {
 
-		err = v.WriteBlock(_os, 0)
-		if err != nil {
-			return err
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
 
-//Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
+// Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
 func (_obj *QueryF) Dispatch(ctx context.Context, _val interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool) (err error) {
-	_is := codec.NewReader(tools.Int8ToByte(req.SBuffer))
+	_is := codec.NewReader(req.SBuffer)
 	_os := codec.NewBuffer()
+	var _reqTup_, _rspTup_ *tup.UniAttribute
+	var _reqJson_ map[string]json.RawMessage
+	var _rspJson_ map[string]interface{}
+	switch req.IVersion {
+	case basef.TUPVERSION:
+		// TUP requests carry the arguments by name
+		_reqTup_ = tup.NewUniAttribute()
+		err = _reqTup_.Decode(_is)
+		if err != nil {
+			return err
+		}
+		_rspTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		// JSON requests carry the arguments as an object by name
+		_reqJson_ = make(map[string]json.RawMessage)
+		if len(req.SBuffer) > 0 {
+			err = json.Unmarshal(req.SBuffer, &_reqJson_)
+			if err != nil {
+				return err
+			}
+			if _reqJson_ == nil {
+				// a null object has no vars
+				_reqJson_ = make(map[string]json.RawMessage)
+			}
+		}
+		_rspJson_ = make(map[string]interface{})
+	}
 	switch req.SFuncName {
 	case "findObjectById":
-		err := findObjectById(ctx, _val, _os, _is, withContext)
+		err := findObjectById(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "findObjectById4Any":
-		err := findObjectById4Any(ctx, _val, _os, _is, withContext)
+		err := findObjectById4Any(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "findObjectById4All":
-		err := findObjectById4All(ctx, _val, _os, _is, withContext)
+		err := findObjectById4All(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "findObjectByIdInSameGroup":
-		err := findObjectByIdInSameGroup(ctx, _val, _os, _is, withContext)
+		err := findObjectByIdInSameGroup(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "findObjectByIdInSameStation":
-		err := findObjectByIdInSameStation(ctx, _val, _os, _is, withContext)
+		err := findObjectByIdInSameStation(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "findObjectByIdInSameSet":
-		err := findObjectByIdInSameSet(ctx, _val, _os, _is, withContext)
+		err := findObjectByIdInSameSet(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
@@ -1671,6 +5087,25 @@ func (_obj *QueryF) Dispatch(ctx context.Context, _val interface{}, req *request
 	default:
 		return fmt.Errorf("func mismatch")
 	}
+	if _rspTup_ != nil {
+		_os.Reset()
+		err = _rspTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _rspJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_rspJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
 	var _status map[string]string
 	s, ok := current.GetResponseStatus(ctx)
 	if ok && s != nil {
@@ -1682,12 +5117,12 @@ func (_obj *QueryF) Dispatch(ctx context.Context, _val interface{}, req *request
 		_context = c
 	}
 	*resp = requestf.ResponsePacket{
-		IVersion:     1,
+		IVersion:     req.IVersion,
 		CPacketType:  0,
 		IRequestId:   req.IRequestId,
 		IMessageType: 0,
 		IRet:         0,
-		SBuffer:      tools.ByteToInt8(_os.ToBytes()),
+		SBuffer:      _os.ToBytes(),
 		Status:       _status,
 		SResultDesc:  "",
 		Context:      _context,
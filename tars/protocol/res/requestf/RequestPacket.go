@@ -1,15 +1,14 @@
-//Package requestf comment
+// Package requestf comment
 // This file war generated by tars2go 1.1
 // Generated from RequestF.tars
 package requestf
 
 import (
 	"fmt"
-
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//RequestPacket strcut implement
+// RequestPacket strcut implement
 type RequestPacket struct {
 	IVersion     int16             `json:"iVersion"`
 	CPacketType  int8              `json:"cPacketType"`
@@ -17,7 +16,7 @@ type RequestPacket struct {
 	IRequestId   int32             `json:"iRequestId"`
 	SServantName string            `json:"sServantName"`
 	SFuncName    string            `json:"sFuncName"`
-	SBuffer      []int8            `json:"sBuffer"`
+	SBuffer      []byte            `json:"sBuffer"`
 	ITimeout     int32             `json:"iTimeout"`
 	Context      map[string]string `json:"context"`
 	Status       map[string]string `json:"status"`
@@ -31,7 +30,7 @@ func (st *RequestPacket) resetDefault() {
 	st.ITimeout = 0
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *RequestPacket) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -79,10 +78,10 @@ func (st *RequestPacket) ReadFrom(_is *codec.Reader) error {
 		if err != nil {
 			return err
 		}
-		st.SBuffer = make([]int8, length, length)
+		st.SBuffer = make([]byte, length, length)
 		for i0, e0 := int32(0), length; i0 < e0; i0++ {
 
-			err = _is.Read_int8(&st.SBuffer[i0], 0, false)
+			err = _is.Read_uint8(&st.SBuffer[i0], 0, false)
 			if err != nil {
 				return err
 			}
@@ -97,7 +96,7 @@ func (st *RequestPacket) ReadFrom(_is *codec.Reader) error {
 		if err != nil {
 			return err
 		}
-		err = _is.Read_slice_int8(&st.SBuffer, length, true)
+		err = _is.Read_bytes(&st.SBuffer, length, true)
 		if err != nil {
 			return err
 		}
@@ -114,7 +113,7 @@ func (st *RequestPacket) ReadFrom(_is *codec.Reader) error {
 		return err
 	}
 
-	err, _ = _is.SkipTo(codec.MAP, 9, true)
+	err, have = _is.SkipTo(codec.MAP, 9, true)
 	if err != nil {
 		return err
 	}
@@ -174,7 +173,7 @@ func (st *RequestPacket) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *RequestPacket) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -202,7 +201,7 @@ func (st *RequestPacket) ReadBlock(_is *codec.Reader, tag byte, require bool) er
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *RequestPacket) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -248,7 +247,7 @@ func (st *RequestPacket) WriteTo(_os *codec.Buffer) error {
 	if err != nil {
 		return err
 	}
-	err = _os.Write_slice_int8(st.SBuffer)
+	err = _os.Write_slice_uint8(st.SBuffer)
 	if err != nil {
 		return err
 	}
@@ -303,7 +302,7 @@ func (st *RequestPacket) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *RequestPacket) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
@@ -1,22 +1,21 @@
-//Package requestf comment
+// Package requestf comment
 // This file war generated by tars2go 1.1
 // Generated from RequestF.tars
 package requestf
 
 import (
 	"fmt"
-
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//ResponsePacket strcut implement
+// ResponsePacket strcut implement
 type ResponsePacket struct {
 	IVersion     int16             `json:"iVersion"`
 	CPacketType  int8              `json:"cPacketType"`
 	IRequestId   int32             `json:"iRequestId"`
 	IMessageType int32             `json:"iMessageType"`
 	IRet         int32             `json:"iRet"`
-	SBuffer      []int8            `json:"sBuffer"`
+	SBuffer      []byte            `json:"sBuffer"`
 	Status       map[string]string `json:"status"`
 	SResultDesc  string            `json:"sResultDesc"`
 	Context      map[string]string `json:"context"`
@@ -28,7 +27,7 @@ func (st *ResponsePacket) resetDefault() {
 	st.IRet = 0
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *ResponsePacket) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -71,10 +70,10 @@ func (st *ResponsePacket) ReadFrom(_is *codec.Reader) error {
 		if err != nil {
 			return err
 		}
-		st.SBuffer = make([]int8, length, length)
+		st.SBuffer = make([]byte, length, length)
 		for i0, e0 := int32(0), length; i0 < e0; i0++ {
 
-			err = _is.Read_int8(&st.SBuffer[i0], 0, false)
+			err = _is.Read_uint8(&st.SBuffer[i0], 0, false)
 			if err != nil {
 				return err
 			}
@@ -89,7 +88,7 @@ func (st *ResponsePacket) ReadFrom(_is *codec.Reader) error {
 		if err != nil {
 			return err
 		}
-		err = _is.Read_slice_int8(&st.SBuffer, length, true)
+		err = _is.Read_bytes(&st.SBuffer, length, true)
 		if err != nil {
 			return err
 		}
@@ -101,7 +100,7 @@ func (st *ResponsePacket) ReadFrom(_is *codec.Reader) error {
 		}
 	}
 
-	err, _ = _is.SkipTo(codec.MAP, 7, true)
+	err, have = _is.SkipTo(codec.MAP, 7, true)
 	if err != nil {
 		return err
 	}
@@ -167,7 +166,7 @@ func (st *ResponsePacket) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *ResponsePacket) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -195,7 +194,7 @@ func (st *ResponsePacket) ReadBlock(_is *codec.Reader, tag byte, require bool) e
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *ResponsePacket) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -236,7 +235,7 @@ func (st *ResponsePacket) WriteTo(_os *codec.Buffer) error {
 	if err != nil {
 		return err
 	}
-	err = _os.Write_slice_int8(st.SBuffer)
+	err = _os.Write_slice_uint8(st.SBuffer)
 	if err != nil {
 		return err
 	}
@@ -291,7 +290,7 @@ func (st *ResponsePacket) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *ResponsePacket) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
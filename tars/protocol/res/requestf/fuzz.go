@@ -0,0 +1,17 @@
+// +build gofuzz
+
+package requestf
+
+import (
+	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+)
+
+//Fuzz is the entry for go-fuzz, run with the corpus in testdata/corpus:
+//	go-fuzz-build && go-fuzz -workdir=testdata
+func Fuzz(data []byte) int {
+	req := RequestPacket{}
+	if err := req.ReadFrom(codec.NewReader(data)); err != nil {
+		return 0
+	}
+	return 1
+}
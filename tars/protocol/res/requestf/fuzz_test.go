@@ -0,0 +1,153 @@
+package requestf
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+)
+
+func readCorpus(t *testing.T) map[string][]byte {
+	files, err := filepath.Glob("testdata/corpus/*")
+	if err != nil || len(files) == 0 {
+		t.Fatal("no corpus", err)
+	}
+	corpus := make(map[string][]byte)
+	for _, f := range files {
+		data, err := ioutil.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		corpus[filepath.Base(f)] = data
+	}
+	return corpus
+}
+
+func decode(t *testing.T, data []byte) (req RequestPacket, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("panic for % x: %v", data, r)
+		}
+	}()
+	err = req.ReadFrom(codec.NewReader(data))
+	return
+}
+
+//TestReadFromCorpus tests the corpus are valid and encoded the same, and their corruptions are decoded without panic.
+func TestReadFromCorpus(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for name, data := range readCorpus(t) {
+		req, err := decode(t, data)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		if req.SServantName != "App.Server.HelloObj" {
+			t.Errorf("%s: unexpected servant %s", name, req.SServantName)
+		}
+		if name == "request" || name == "tup" {
+			// the buffer is written as a simple list, the maps are empty for a stable order
+			os := codec.NewBuffer()
+			req.WriteTo(os)
+			if !bytes.Equal(os.ToBytes(), data) {
+				t.Errorf("%s: expected the same encoding", name)
+			}
+		}
+		for i := 0; i < len(data); i++ {
+			decode(t, data[:i])
+		}
+		for i := 0; i < 10000; i++ {
+			b := append([]byte(nil), data...)
+			for j := rnd.Intn(4); j >= 0; j-- {
+				b[rnd.Intn(len(b))] = byte(rnd.Intn(256))
+			}
+			decode(t, b)
+		}
+	}
+}
+
+func header(ty byte) *codec.Buffer {
+	os := codec.NewBuffer()
+	os.Write_int16(1, 1)
+	os.Write_int8(0, 2)
+	os.Write_int32(0, 3)
+	os.Write_int32(1, 4)
+	os.Write_string("App.Server.HelloObj", 5)
+	os.Write_string("hello", 6)
+	os.WriteHead(ty, 7)
+	return os
+}
+
+//TestReadFromMalformed tests the malformed packets are refused with decode errors.
+func TestReadFromMalformed(t *testing.T) {
+	hugeList := header(codec.LIST)
+	hugeList.Write_int32(0x7fffffff, 0)
+
+	negativeList := header(codec.LIST)
+	negativeList.Write_int32(-1, 0)
+
+	hugeSimpleList := header(codec.SIMPLE_LIST)
+	hugeSimpleList.WriteHead(codec.BYTE, 0)
+	hugeSimpleList.Write_int32(0x7fffffff, 0)
+
+	hugeMap := header(codec.SIMPLE_LIST)
+	hugeMap.WriteHead(codec.BYTE, 0)
+	hugeMap.Write_int32(0, 0)
+	hugeMap.Write_int32(0, 8)
+	hugeMap.WriteHead(codec.MAP, 9)
+	hugeMap.Write_int32(0x7fffffff, 0)
+
+	hugeString := codec.NewBuffer()
+	hugeString.Write_int16(1, 1)
+	hugeString.Write_int8(0, 2)
+	hugeString.Write_int32(0, 3)
+	hugeString.Write_int32(1, 4)
+	hugeString.WriteHead(codec.STRING4, 5)
+	hugeString.Write_slice_uint8([]byte{0xff, 0xff, 0xff, 0xff})
+
+	// an unknown field nested deeply before the required ones
+	deep := codec.NewBuffer()
+	for i := 0; i < 10000; i++ {
+		deep.WriteHead(codec.STRUCT_BEGIN, 0)
+	}
+
+	cases := map[string][]byte{
+		"huge list":        hugeList.ToBytes(),
+		"negative list":    negativeList.ToBytes(),
+		"huge simple list": hugeSimpleList.ToBytes(),
+		"huge map":         hugeMap.ToBytes(),
+		"huge string":      hugeString.ToBytes(),
+		"deep":             deep.ToBytes(),
+	}
+	for name, data := range cases {
+		_, err := decode(t, data)
+		if !codec.IsDecodeError(err) {
+			t.Errorf("%s: expected decode error, got %v", name, err)
+		}
+	}
+}
+
+//TestReadFromLimits tests the limits of the reader are configurable.
+func TestReadFromLimits(t *testing.T) {
+	req := RequestPacket{IVersion: 1, SServantName: "App.Server.HelloObj", SFuncName: "hello", SBuffer: make([]byte, 100)}
+	os := codec.NewBuffer()
+	req.WriteTo(os)
+
+	is := codec.NewReader(os.ToBytes())
+	is.SetLimits(codec.Limits{MaxDepth: 1, MaxLength: 10, MaxStringLength: 100})
+	if err := req.ReadFrom(is); !codec.IsDecodeError(err) {
+		t.Errorf("expected decode error for the length limit, got %v", err)
+	}
+	is = codec.NewReader(os.ToBytes())
+	is.SetLimits(codec.Limits{MaxDepth: 1, MaxLength: 100, MaxStringLength: 10})
+	if err := req.ReadFrom(is); !codec.IsDecodeError(err) {
+		t.Errorf("expected decode error for the string limit, got %v", err)
+	}
+	is = codec.NewReader(os.ToBytes())
+	is.SetLimits(codec.Limits{MaxDepth: 1, MaxLength: 100, MaxStringLength: 100})
+	if err := req.ReadFrom(is); err != nil {
+		t.Error(err)
+	}
+}
@@ -1,4 +1,4 @@
-//Package statf comment
+// Package statf comment
 // This file war generated by tars2go 1.1
 // Generated from StatF.tars
 package statf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//ProxyInfo strcut implement
+// ProxyInfo strcut implement
 type ProxyInfo struct {
 	BFromClient bool `json:"bFromClient"`
 }
@@ -16,7 +16,7 @@ type ProxyInfo struct {
 func (st *ProxyInfo) resetDefault() {
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *ProxyInfo) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -35,7 +35,7 @@ func (st *ProxyInfo) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *ProxyInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -63,7 +63,7 @@ func (st *ProxyInfo) ReadBlock(_is *codec.Reader, tag byte, require bool) error
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *ProxyInfo) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -75,7 +75,7 @@ func (st *ProxyInfo) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *ProxyInfo) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
@@ -1,55 +1,291 @@
-//Package statf comment
+// Package statf comment
 // This file war generated by tars2go 1.1
 // Generated from StatF.tars
 package statf
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	m "github.com/TarsCloud/TarsGo/tars/model"
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/tup"
 	"github.com/TarsCloud/TarsGo/tars/util/current"
-	"github.com/TarsCloud/TarsGo/tars/util/tools"
 )
 
-//StatF struct
+// StatF struct
 type StatF struct {
 	s m.Servant
 }
 
-//ReportMicMsg is the proxy function for the method defined in the tars file, with the context
+// ReportMicMsg is the proxy function for the method defined in the tars file, with the context
 func (_obj *StatF) ReportMicMsg(Msg map[StatMicMsgHead]StatMicMsgBody, BFromClient bool, _opt ...map[string]string) (ret int32, err error) {
 
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	_os := codec.NewBuffer()
-	err = _os.WriteHead(codec.MAP, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-	err = _os.Write_int32(int32(len(Msg)), 0)
-	if err != nil {
-		return ret, err
-	}
-	for k0, v0 := range Msg {
+	if _reqJson_ != nil {
+		_reqJson_["msg"] = Msg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.MAP, 0)
+		if err != nil {
+			return ret, err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return ret, err
+		}
+		for k0, v0 := range Msg {
+
+			err = k0.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
 
-		err = k0.WriteBlock(_os, 0)
+			err = v0.WriteBlock(_os, 1)
+			if err != nil {
+				return ret, err
+			}
+		}
+		_reqTup_.PutBuffer("msg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.MAP, 1)
+		if err != nil {
+			return ret, err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
 		if err != nil {
 			return ret, err
 		}
+		for k1, v1 := range Msg {
 
-		err = v0.WriteBlock(_os, 1)
+			err = k1.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
+
+			err = v1.WriteBlock(_os, 1)
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["bFromClient"] = BFromClient
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_bool(BFromClient, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("bFromClient", _os.ToBytes())
+	} else {
+		err = _os.Write_bool(BFromClient, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
 		if err != nil {
 			return ret, err
 		}
 	}
 
-	err = _os.Write_bool(BFromClient, 2)
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "reportMicMsg", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// ReportMicMsgWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *StatF) ReportMicMsgWithContext(ctx context.Context, Msg map[StatMicMsgHead]StatMicMsgBody, BFromClient bool, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["msg"] = Msg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.MAP, 0)
+		if err != nil {
+			return ret, err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return ret, err
+		}
+		for k2, v2 := range Msg {
+
+			err = k2.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
+
+			err = v2.WriteBlock(_os, 1)
+			if err != nil {
+				return ret, err
+			}
+		}
+		_reqTup_.PutBuffer("msg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.MAP, 1)
+		if err != nil {
+			return ret, err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return ret, err
+		}
+		for k3, v3 := range Msg {
+
+			err = k3.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
+
+			err = v3.WriteBlock(_os, 1)
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["bFromClient"] = BFromClient
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_bool(BFromClient, 0)
+		if err != nil {
+			return ret, err
+		}
+		_reqTup_.PutBuffer("bFromClient", _os.ToBytes())
+	} else {
+		err = _os.Write_bool(BFromClient, 2)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
 
 	var _status map[string]string
 	var _context map[string]string
@@ -60,56 +296,516 @@ func (_obj *StatF) ReportMicMsg(Msg map[StatMicMsgHead]StatMicMsgBody, BFromClie
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
 	err = _obj.s.Tars_invoke(ctx, 0, "reportMicMsg", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
 	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//ReportMicMsgWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *StatF) ReportMicMsgWithContext(ctx context.Context, Msg map[StatMicMsgHead]StatMicMsgBody, BFromClient bool, _opt ...map[string]string) (ret int32, err error) {
+// ReportMicMsgOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *StatF) ReportMicMsgOneway(Msg map[StatMicMsgHead]StatMicMsgBody, BFromClient bool, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.WriteHead(codec.MAP, 1)
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["msg"] = Msg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.MAP, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return err
+		}
+		for k4, v4 := range Msg {
+
+			err = k4.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+
+			err = v4.WriteBlock(_os, 1)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("msg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.MAP, 1)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return err
+		}
+		for k5, v5 := range Msg {
+
+			err = k5.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+
+			err = v5.WriteBlock(_os, 1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["bFromClient"] = BFromClient
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_bool(BFromClient, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("bFromClient", _os.ToBytes())
+	} else {
+		err = _os.Write_bool(BFromClient, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportMicMsg", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
+	}
+
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// ReportMicMsgOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *StatF) ReportMicMsgOnewayWithContext(ctx context.Context, Msg map[StatMicMsgHead]StatMicMsgBody, BFromClient bool, _opt ...map[string]string) (err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["msg"] = Msg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.MAP, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return err
+		}
+		for k6, v6 := range Msg {
+
+			err = k6.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+
+			err = v6.WriteBlock(_os, 1)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("msg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.MAP, 1)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return err
+		}
+		for k7, v7 := range Msg {
+
+			err = k7.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+
+			err = v7.WriteBlock(_os, 1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqJson_ != nil {
+		_reqJson_["bFromClient"] = BFromClient
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.Write_bool(BFromClient, 0)
+		if err != nil {
+			return err
+		}
+		_reqTup_.PutBuffer("bFromClient", _os.ToBytes())
+	} else {
+		err = _os.Write_bool(BFromClient, 2)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
+
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
 	}
-	err = _os.Write_int32(int32(len(Msg)), 0)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportMicMsg", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
-	for k1, v1 := range Msg {
 
-		err = k1.WriteBlock(_os, 0)
+	_ = length
+	_ = have
+	_ = ty
+	return nil
+}
+
+// ReportSampleMsg is the proxy function for the method defined in the tars file, with the context
+func (_obj *StatF) ReportSampleMsg(Msg []StatSampleMsg, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["msg"] = Msg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
 		if err != nil {
 			return ret, err
 		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return ret, err
+		}
+		for _, v := range Msg {
 
-		err = v1.WriteBlock(_os, 1)
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
+		}
+		_reqTup_.PutBuffer("msg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 1)
+		if err != nil {
+			return ret, err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return ret, err
+		}
+		for _, v := range Msg {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
 		if err != nil {
 			return ret, err
 		}
 	}
 
-	err = _os.Write_bool(BFromClient, 2)
+	ctx := context.Background()
+	var _status map[string]string
+	var _context map[string]string
+	if len(_opt) == 1 {
+		_context = _opt[0]
+	} else if len(_opt) == 2 {
+		_context = _opt[0]
+		_status = _opt[1]
+	}
+	_resp := new(requestf.ResponsePacket)
+	err = _obj.s.Tars_invoke(ctx, 0, "reportSampleMsg", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
+
+	_ = length
+	_ = have
+	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
+	return ret, nil
+}
+
+// ReportSampleMsgWithContext is the proxy function for the method defined in the tars file, with the context
+func (_obj *StatF) ReportSampleMsgWithContext(ctx context.Context, Msg []StatSampleMsg, _opt ...map[string]string) (ret int32, err error) {
+
+	var length int32
+	var have bool
+	var ty byte
+	var _tupBuffer_ []byte
+	_os := codec.NewBuffer()
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
+	}
+	if _reqJson_ != nil {
+		_reqJson_["msg"] = Msg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return ret, err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return ret, err
+		}
+		for _, v := range Msg {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
+		}
+		_reqTup_.PutBuffer("msg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 1)
+		if err != nil {
+			return ret, err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return ret, err
+		}
+		for _, v := range Msg {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return ret, err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return ret, err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return ret, err
+		}
+	}
 
 	var _status map[string]string
 	var _context map[string]string
@@ -120,46 +816,140 @@ func (_obj *StatF) ReportMicMsgWithContext(ctx context.Context, Msg map[StatMicM
 		_status = _opt[1]
 	}
 	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "reportMicMsg", _os.ToBytes(), _status, _context, _resp)
+	err = _obj.s.Tars_invoke(ctx, 0, "reportSampleMsg", _os.ToBytes(), _status, _context, _resp)
 	if err != nil {
 		return ret, err
 	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
-	if err != nil {
-		return ret, err
+	var _rspTup_ *tup.UniAttribute
+	var _rspJson_ map[string]json.RawMessage
+	_is := codec.NewReader(_resp.SBuffer)
+	if _reqTup_ != nil {
+		_rspTup_ = tup.NewUniAttribute()
+		err = _rspTup_.Decode(_is)
+		if err != nil {
+			return ret, err
+		}
+	}
+	if _reqJson_ != nil {
+		_rspJson_ = make(map[string]json.RawMessage)
+		if len(_resp.SBuffer) > 0 {
+			err = json.Unmarshal(_resp.SBuffer, &_rspJson_)
+			if err != nil {
+				return ret, err
+			}
+			if _rspJson_ == nil {
+				// a null object has no vars
+				_rspJson_ = make(map[string]json.RawMessage)
+			}
+		}
+	}
+	if _rspJson_ != nil {
+		if _raw_, ok := _rspJson_["tars_ret"]; ok {
+			err = json.Unmarshal(_raw_, &ret)
+			if err != nil {
+				return ret, err
+			}
+		}
+	} else if _rspTup_ != nil {
+		err = _rspTup_.GetBuffer(tup.RetKey, &_tupBuffer_)
+		if err != nil {
+			return ret, err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
+	} else {
+		err = _is.Read_int32(&ret, 0, true)
+		if err != nil {
+			return ret, err
+		}
 	}
+	_obj.setMap(len(_opt), _resp, _context, _status)
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
+	_ = _rspTup_
+	_ = _rspJson_
 	return ret, nil
 }
 
-//ReportSampleMsg is the proxy function for the method defined in the tars file, with the context
-func (_obj *StatF) ReportSampleMsg(Msg []StatSampleMsg, _opt ...map[string]string) (ret int32, err error) {
+// ReportSampleMsgOneway sends the request for the method defined in the tars file without waiting for the response
+func (_obj *StatF) ReportSampleMsgOneway(Msg []StatSampleMsg, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.WriteHead(codec.LIST, 1)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-	err = _os.Write_int32(int32(len(Msg)), 0)
-	if err != nil {
-		return ret, err
-	}
-	for _, v := range Msg {
+	if _reqJson_ != nil {
+		_reqJson_["msg"] = Msg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Msg {
 
-		err = v.WriteBlock(_os, 0)
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("msg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 1)
 		if err != nil {
-			return ret, err
+			return err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Msg {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
 		}
 	}
 
+	ctx := context.Background()
 	var _status map[string]string
 	var _context map[string]string
 	if len(_opt) == 1 {
@@ -168,45 +958,86 @@ func (_obj *StatF) ReportSampleMsg(Msg []StatSampleMsg, _opt ...map[string]strin
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	ctx := context.Background()
-	err = _obj.s.Tars_invoke(ctx, 0, "reportSampleMsg", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportSampleMsg", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//ReportSampleMsgWithContext is the proxy function for the method defined in the tars file, with the context
-func (_obj *StatF) ReportSampleMsgWithContext(ctx context.Context, Msg []StatSampleMsg, _opt ...map[string]string) (ret int32, err error) {
+// ReportSampleMsgOnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context
+func (_obj *StatF) ReportSampleMsgOnewayWithContext(ctx context.Context, Msg []StatSampleMsg, _opt ...map[string]string) (err error) {
 
 	var length int32
 	var have bool
 	var ty byte
 	_os := codec.NewBuffer()
-	err = _os.WriteHead(codec.LIST, 1)
-	if err != nil {
-		return ret, err
-	}
-	err = _os.Write_int32(int32(len(Msg)), 0)
-	if err != nil {
-		return ret, err
+	var _reqTup_ *tup.UniAttribute
+	var _reqJson_ map[string]interface{}
+	switch m.GetVersion(_obj.s) {
+	case basef.TUPVERSION:
+		_reqTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		_reqJson_ = make(map[string]interface{})
 	}
-	for _, v := range Msg {
+	if _reqJson_ != nil {
+		_reqJson_["msg"] = Msg
+	} else if _reqTup_ != nil {
+		_os.Reset()
+		err = _os.WriteHead(codec.LIST, 0)
+		if err != nil {
+			return err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Msg {
 
-		err = v.WriteBlock(_os, 0)
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+		_reqTup_.PutBuffer("msg", _os.ToBytes())
+	} else {
+		err = _os.WriteHead(codec.LIST, 1)
 		if err != nil {
-			return ret, err
+			return err
+		}
+		err = _os.Write_int32(int32(len(Msg)), 0)
+		if err != nil {
+			return err
+		}
+		for _, v := range Msg {
+
+			err = v.WriteBlock(_os, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _reqTup_ != nil {
+		_os.Reset()
+		err = _reqTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _reqJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_reqJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -218,49 +1049,51 @@ func (_obj *StatF) ReportSampleMsgWithContext(ctx context.Context, Msg []StatSam
 		_context = _opt[0]
 		_status = _opt[1]
 	}
-	_resp := new(requestf.ResponsePacket)
-	err = _obj.s.Tars_invoke(ctx, 0, "reportSampleMsg", _os.ToBytes(), _status, _context, _resp)
-	if err != nil {
-		return ret, err
-	}
-	_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))
-	err = _is.Read_int32(&ret, 0, true)
+	err = _obj.s.Tars_invoke(ctx, byte(basef.TARSONEWAY), "reportSampleMsg", _os.ToBytes(), _status, _context, nil)
 	if err != nil {
-		return ret, err
+		return err
 	}
 
-	setMap(len(_opt), _resp, _context, _status)
 	_ = length
 	_ = have
 	_ = ty
-	return ret, nil
+	return nil
 }
 
-//SetServant sets servant for the service.
+// SetServant sets servant for the service.
 func (_obj *StatF) SetServant(s m.Servant) {
 	_obj.s = s
 }
 
-//TarsSetTimeout sets the timeout for the servant which is in ms.
+// TarsSetTimeout sets the timeout for the servant which is in ms.
 func (_obj *StatF) TarsSetTimeout(t int) {
 	_obj.s.TarsSetTimeout(t)
 }
-func setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
+
+// TarsSetVersion sets the protocol version of the servant, basef.TUPVERSION for the servants speaking TUP,
+// basef.JSONVERSION for encoding the arguments as a JSON object. The servants other than m.VersionServant
+// only speak tars, it takes no effect on them.
+func (_obj *StatF) TarsSetVersion(v int16) {
+	if vs, ok := _obj.s.(m.VersionServant); ok {
+		vs.TarsSetVersion(v)
+	}
+}
+func (_obj *StatF) setMap(l int, res *requestf.ResponsePacket, ctx map[string]string, sts map[string]string) {
 	if l == 1 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
 	} else if l == 2 {
-		for k := range ctx {
+		for k, _ := range ctx {
 			delete(ctx, k)
 		}
 		for k, v := range res.Context {
 			ctx[k] = v
 		}
-		for k := range sts {
+		for k, _ := range sts {
 			delete(sts, k)
 		}
 		for k, v := range res.Status {
@@ -278,41 +1111,102 @@ type _impStatFWithContext interface {
 	ReportSampleMsg(ctx context.Context, Msg []StatSampleMsg) (ret int32, err error)
 }
 
-func reportMicMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func reportMicMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var Msg map[StatMicMsgHead]StatMicMsgBody
-	err, have = _is.SkipTo(codec.MAP, 1, true)
-	if err != nil {
-		return err
-	}
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["msg"]; ok {
+			err = json.Unmarshal(_raw_, &Msg)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("msg", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err, have = _is.SkipTo(codec.MAP, 0, true)
+		if err != nil {
+			return err
+		}
 
-	err = _is.Read_int32(&length, 0, true)
-	if err != nil {
-		return err
-	}
-	Msg = make(map[StatMicMsgHead]StatMicMsgBody)
-	for i2, e2 := int32(0), length; i2 < e2; i2++ {
-		var k2 StatMicMsgHead
-		var v2 StatMicMsgBody
+		err = _is.Read_int32(&length, 0, true)
+		if err != nil {
+			return err
+		}
+		Msg = make(map[StatMicMsgHead]StatMicMsgBody)
+		for i8, e8 := int32(0), length; i8 < e8; i8++ {
+			var k8 StatMicMsgHead
+			var v8 StatMicMsgBody
 
-		err = k2.ReadBlock(_is, 0, false)
+			err = k8.ReadBlock(_is, 0, false)
+			if err != nil {
+				return err
+			}
+
+			err = v8.ReadBlock(_is, 1, false)
+			if err != nil {
+				return err
+			}
+
+			Msg[k8] = v8
+		}
+	} else {
+		err, have = _is.SkipTo(codec.MAP, 1, true)
 		if err != nil {
 			return err
 		}
 
-		err = v2.ReadBlock(_is, 1, false)
+		err = _is.Read_int32(&length, 0, true)
 		if err != nil {
 			return err
 		}
+		Msg = make(map[StatMicMsgHead]StatMicMsgBody)
+		for i9, e9 := int32(0), length; i9 < e9; i9++ {
+			var k9 StatMicMsgHead
+			var v9 StatMicMsgBody
+
+			err = k9.ReadBlock(_is, 0, false)
+			if err != nil {
+				return err
+			}
+
+			err = v9.ReadBlock(_is, 1, false)
+			if err != nil {
+				return err
+			}
 
-		Msg[k2] = v2
+			Msg[k9] = v9
+		}
 	}
 	var BFromClient bool
-	err = _is.Read_bool(&BFromClient, 2, true)
-	if err != nil {
-		return err
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["bFromClient"]; ok {
+			err = json.Unmarshal(_raw_, &BFromClient)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("bFromClient", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err = _is.Read_bool(&BFromClient, 0, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = _is.Read_bool(&BFromClient, 2, true)
+		if err != nil {
+			return err
+		}
 	}
 	if withContext == false {
 		_imp := _val.(_impStatF)
@@ -320,10 +1214,21 @@ func reportMicMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _is
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impStatFWithContext)
@@ -331,51 +1236,108 @@ func reportMicMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _is
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
-func reportSampleMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, withContext bool) (err error) {
+func reportSampleMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool) (err error) {
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	var Msg []StatSampleMsg
-	err, _, ty = _is.SkipToNoCheck(1, true)
-	if err != nil {
-		return err
-	}
-
-	if ty == codec.LIST {
-		err = _is.Read_int32(&length, 0, true)
+	if _reqJson_ != nil {
+		if _raw_, ok := _reqJson_["msg"]; ok {
+			err = json.Unmarshal(_raw_, &Msg)
+			if err != nil {
+				return err
+			}
+		}
+	} else if _reqTup_ != nil {
+		err = _reqTup_.GetBuffer("msg", &_tupBuffer_)
+		if err != nil {
+			return err
+		}
+		_is.Reset(_tupBuffer_)
+		err, _, ty = _is.SkipToNoCheck(0, true)
 		if err != nil {
 			return err
 		}
-		Msg = make([]StatSampleMsg, length, length)
-		for i3, e3 := int32(0), length; i3 < e3; i3++ {
 
-			err = Msg[i3].ReadBlock(_is, 0, false)
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return err
+			}
+			Msg = make([]StatSampleMsg, length, length)
+			for i10, e10 := int32(0), length; i10 < e10; i10++ {
+
+				err = Msg[i10].ReadBlock(_is, 0, false)
+				if err != nil {
+					return err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
 			if err != nil {
 				return err
 			}
-		}
-	} else if ty == codec.SIMPLE_LIST {
-		err = fmt.Errorf("not support simple_list type")
-		if err != nil {
-			return err
 		}
 	} else {
-		err = fmt.Errorf("require vector, but not")
+		err, _, ty = _is.SkipToNoCheck(1, true)
 		if err != nil {
 			return err
 		}
+
+		if ty == codec.LIST {
+			err = _is.Read_int32(&length, 0, true)
+			if err != nil {
+				return err
+			}
+			Msg = make([]StatSampleMsg, length, length)
+			for i11, e11 := int32(0), length; i11 < e11; i11++ {
+
+				err = Msg[i11].ReadBlock(_is, 0, false)
+				if err != nil {
+					return err
+				}
+			}
+		} else if ty == codec.SIMPLE_LIST {
+			err = fmt.Errorf("not support simple_list type")
+			if err != nil {
+				return err
+			}
+		} else {
+			err = fmt.Errorf("require vector, but not")
+			if err != nil {
+				return err
+			}
+		}
 	}
 	if withContext == false {
 		_imp := _val.(_impStatF)
@@ -383,10 +1345,21 @@ func reportSampleMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		_imp := _val.(_impStatFWithContext)
@@ -394,31 +1367,70 @@ func reportSampleMsg(ctx context.Context, _val interface{}, _os *codec.Buffer, _
 		if err != nil {
 			return err
 		}
-
-		err = _os.Write_int32(ret, 0)
-		if err != nil {
-			return err
+		if _rspJson_ != nil {
+			_rspJson_["tars_ret"] = ret
+		} else if _rspTup_ != nil {
+			_os.Reset()
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
+			_rspTup_.PutBuffer(tup.RetKey, _os.ToBytes())
+			_rspTup_.PutBuffer(tup.TarsRetKey, _os.ToBytes())
+		} else {
+			err = _os.Write_int32(ret, 0)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	_ = length
 	_ = have
 	_ = ty
+	_ = _tupBuffer_
 	return nil
 }
 
-//Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
+// Dispatch is used to call the server side implemnet for the method defined in the tars file. withContext shows using context or not.
 func (_obj *StatF) Dispatch(ctx context.Context, _val interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool) (err error) {
-	_is := codec.NewReader(tools.Int8ToByte(req.SBuffer))
+	_is := codec.NewReader(req.SBuffer)
 	_os := codec.NewBuffer()
+	var _reqTup_, _rspTup_ *tup.UniAttribute
+	var _reqJson_ map[string]json.RawMessage
+	var _rspJson_ map[string]interface{}
+	switch req.IVersion {
+	case basef.TUPVERSION:
+		// TUP requests carry the arguments by name
+		_reqTup_ = tup.NewUniAttribute()
+		err = _reqTup_.Decode(_is)
+		if err != nil {
+			return err
+		}
+		_rspTup_ = tup.NewUniAttribute()
+	case basef.JSONVERSION:
+		// JSON requests carry the arguments as an object by name
+		_reqJson_ = make(map[string]json.RawMessage)
+		if len(req.SBuffer) > 0 {
+			err = json.Unmarshal(req.SBuffer, &_reqJson_)
+			if err != nil {
+				return err
+			}
+			if _reqJson_ == nil {
+				// a null object has no vars
+				_reqJson_ = make(map[string]json.RawMessage)
+			}
+		}
+		_rspJson_ = make(map[string]interface{})
+	}
 	switch req.SFuncName {
 	case "reportMicMsg":
-		err := reportMicMsg(ctx, _val, _os, _is, withContext)
+		err := reportMicMsg(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
 	case "reportSampleMsg":
-		err := reportSampleMsg(ctx, _val, _os, _is, withContext)
+		err := reportSampleMsg(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
@@ -426,6 +1438,25 @@ func (_obj *StatF) Dispatch(ctx context.Context, _val interface{}, req *requestf
 	default:
 		return fmt.Errorf("func mismatch")
 	}
+	if _rspTup_ != nil {
+		_os.Reset()
+		err = _rspTup_.Encode(_os)
+		if err != nil {
+			return err
+		}
+	}
+	if _rspJson_ != nil {
+		var _jsonBuffer_ []byte
+		_jsonBuffer_, err = json.Marshal(_rspJson_)
+		if err != nil {
+			return err
+		}
+		_os.Reset()
+		err = _os.Write_slice_uint8(_jsonBuffer_)
+		if err != nil {
+			return err
+		}
+	}
 	var _status map[string]string
 	s, ok := current.GetResponseStatus(ctx)
 	if ok && s != nil {
@@ -437,12 +1468,12 @@ func (_obj *StatF) Dispatch(ctx context.Context, _val interface{}, req *requestf
 		_context = c
 	}
 	*resp = requestf.ResponsePacket{
-		IVersion:     1,
+		IVersion:     req.IVersion,
 		CPacketType:  0,
 		IRequestId:   req.IRequestId,
 		IMessageType: 0,
 		IRet:         0,
-		SBuffer:      tools.ByteToInt8(_os.ToBytes()),
+		SBuffer:      _os.ToBytes(),
 		Status:       _status,
 		SResultDesc:  "",
 		Context:      _context,
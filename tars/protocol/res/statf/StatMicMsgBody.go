@@ -1,4 +1,4 @@
-//Package statf comment
+// Package statf comment
 // This file war generated by tars2go 1.1
 // Generated from StatF.tars
 package statf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//StatMicMsgBody strcut implement
+// StatMicMsgBody strcut implement
 type StatMicMsgBody struct {
 	Count         int32           `json:"count"`
 	TimeoutCount  int32           `json:"timeoutCount"`
@@ -22,7 +22,7 @@ type StatMicMsgBody struct {
 func (st *StatMicMsgBody) resetDefault() {
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *StatMicMsgBody) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -93,7 +93,7 @@ func (st *StatMicMsgBody) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *StatMicMsgBody) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -121,7 +121,7 @@ func (st *StatMicMsgBody) ReadBlock(_is *codec.Reader, tag byte, require bool) e
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *StatMicMsgBody) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -179,7 +179,7 @@ func (st *StatMicMsgBody) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *StatMicMsgBody) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
@@ -1,4 +1,4 @@
-//Package statf comment
+// Package statf comment
 // This file war generated by tars2go 1.1
 // Generated from StatF.tars
 package statf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//StatMicMsgHead strcut implement
+// StatMicMsgHead strcut implement
 type StatMicMsgHead struct {
 	MasterName    string `json:"masterName"`
 	SlaveName     string `json:"slaveName"`
@@ -26,7 +26,7 @@ type StatMicMsgHead struct {
 func (st *StatMicMsgHead) resetDefault() {
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *StatMicMsgHead) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -95,7 +95,7 @@ func (st *StatMicMsgHead) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *StatMicMsgHead) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -123,7 +123,7 @@ func (st *StatMicMsgHead) ReadBlock(_is *codec.Reader, tag byte, require bool) e
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *StatMicMsgHead) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -185,7 +185,7 @@ func (st *StatMicMsgHead) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *StatMicMsgHead) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
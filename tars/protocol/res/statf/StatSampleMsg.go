@@ -1,4 +1,4 @@
-//Package statf comment
+// Package statf comment
 // This file war generated by tars2go 1.1
 // Generated from StatF.tars
 package statf
@@ -8,7 +8,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 )
 
-//StatSampleMsg strcut implement
+// StatSampleMsg strcut implement
 type StatSampleMsg struct {
 	Unid          string `json:"unid"`
 	MasterName    string `json:"masterName"`
@@ -24,7 +24,7 @@ type StatSampleMsg struct {
 func (st *StatSampleMsg) resetDefault() {
 }
 
-//ReadFrom reads  from _is and put into struct.
+// ReadFrom reads  from _is and put into struct.
 func (st *StatSampleMsg) ReadFrom(_is *codec.Reader) error {
 	var err error
 	var length int32
@@ -83,7 +83,7 @@ func (st *StatSampleMsg) ReadFrom(_is *codec.Reader) error {
 	return nil
 }
 
-//ReadBlock reads struct from the given tag , require or optional.
+// ReadBlock reads struct from the given tag , require or optional.
 func (st *StatSampleMsg) ReadBlock(_is *codec.Reader, tag byte, require bool) error {
 	var err error
 	var have bool
@@ -111,7 +111,7 @@ func (st *StatSampleMsg) ReadBlock(_is *codec.Reader, tag byte, require bool) er
 	return nil
 }
 
-//WriteTo encode struct to buffer
+// WriteTo encode struct to buffer
 func (st *StatSampleMsg) WriteTo(_os *codec.Buffer) error {
 	var err error
 
@@ -163,7 +163,7 @@ func (st *StatSampleMsg) WriteTo(_os *codec.Buffer) error {
 	return nil
 }
 
-//WriteBlock encode struct
+// WriteBlock encode struct
 func (st *StatSampleMsg) WriteBlock(_os *codec.Buffer, tag byte) error {
 	var err error
 	err = _os.WriteHead(codec.STRUCT_BEGIN, tag)
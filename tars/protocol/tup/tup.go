@@ -0,0 +1,296 @@
+// Package tup implements the UniAttribute of the tars TUP protocol (version 3),
+// in which the arguments and the results are put into a map by name instead of by tag.
+// TUP servers answer with a RequestPacket carrying the result code in the status.
+package tup
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+)
+
+// Keys of the result in the status of TUP responses.
+const (
+	StatusResultCode = "STATUS_RESULT_CODE"
+	StatusResultDesc = "STATUS_RESULT_DESC"
+)
+
+// RetKey is the name of the return value, TarsRetKey is the alias some clients read.
+const (
+	RetKey     = ""
+	TarsRetKey = "tars_ret"
+)
+
+// UniAttribute is the map of the named values, every value is encoded with tag 0.
+type UniAttribute struct {
+	data map[string][]byte
+}
+
+// NewUniAttribute returns an empty UniAttribute.
+func NewUniAttribute() *UniAttribute {
+	return &UniAttribute{data: make(map[string][]byte)}
+}
+
+// PutBuffer puts the encoded value with the name, the buffer is copied.
+func (u *UniAttribute) PutBuffer(k string, buf []byte) {
+	v := make([]byte, len(buf))
+	copy(v, buf)
+	u.data[k] = v
+}
+
+// GetBuffer gets the encoded value of the name.
+func (u *UniAttribute) GetBuffer(k string, buf *[]byte) error {
+	v, ok := u.data[k]
+	if !ok {
+		return fmt.Errorf("tup: %q not found", k)
+	}
+	*buf = v
+	return nil
+}
+
+// ContainsKey returns whether the name has a value.
+func (u *UniAttribute) ContainsKey(k string) bool {
+	_, ok := u.data[k]
+	return ok
+}
+
+// Remove removes the value of the name.
+func (u *UniAttribute) Remove(k string) {
+	delete(u.data, k)
+}
+
+// Len returns the number of the values.
+func (u *UniAttribute) Len() int {
+	return len(u.data)
+}
+
+type writer interface {
+	WriteBlock(_os *codec.Buffer, tag byte) error
+}
+
+type reader interface {
+	ReadBlock(_is *codec.Reader, tag byte, require bool) error
+}
+
+// Put encodes the value with the name, v is a basic type, []byte, or a struct generated by tars2go.
+func (u *UniAttribute) Put(k string, v interface{}) error {
+	os := codec.NewBuffer()
+	var err error
+	switch d := v.(type) {
+	case bool:
+		err = os.Write_bool(d, 0)
+	case int8:
+		err = os.Write_int8(d, 0)
+	case uint8:
+		err = os.Write_uint8(d, 0)
+	case int16:
+		err = os.Write_int16(d, 0)
+	case uint16:
+		err = os.Write_uint16(d, 0)
+	case int32:
+		err = os.Write_int32(d, 0)
+	case uint32:
+		err = os.Write_uint32(d, 0)
+	case int64:
+		err = os.Write_int64(d, 0)
+	case float32:
+		err = os.Write_float32(d, 0)
+	case float64:
+		err = os.Write_float64(d, 0)
+	case string:
+		err = os.Write_string(d, 0)
+	case []byte:
+		err = writeBytes(os, d, 0)
+	case writer:
+		err = d.WriteBlock(os, 0)
+	default:
+		return fmt.Errorf("tup: unsupported type %T of %q", v, k)
+	}
+	if err != nil {
+		return err
+	}
+	u.data[k] = os.ToBytes()
+	return nil
+}
+
+// Get decodes the value of the name into v, which is a pointer to a type supported by Put.
+func (u *UniAttribute) Get(k string, v interface{}) error {
+	var buf []byte
+	if err := u.GetBuffer(k, &buf); err != nil {
+		return err
+	}
+	is := codec.NewReader(buf)
+	switch d := v.(type) {
+	case *bool:
+		return is.Read_bool(d, 0, true)
+	case *int8:
+		return is.Read_int8(d, 0, true)
+	case *uint8:
+		return is.Read_uint8(d, 0, true)
+	case *int16:
+		return is.Read_int16(d, 0, true)
+	case *uint16:
+		return is.Read_uint16(d, 0, true)
+	case *int32:
+		return is.Read_int32(d, 0, true)
+	case *uint32:
+		return is.Read_uint32(d, 0, true)
+	case *int64:
+		return is.Read_int64(d, 0, true)
+	case *float32:
+		return is.Read_float32(d, 0, true)
+	case *float64:
+		return is.Read_float64(d, 0, true)
+	case *string:
+		return is.Read_string(d, 0, true)
+	case *[]byte:
+		return readBytes(is, d, 0)
+	case reader:
+		return d.ReadBlock(is, 0, true)
+	}
+	return fmt.Errorf("tup: unsupported type %T of %q", v, k)
+}
+
+func writeBytes(os *codec.Buffer, data []byte, tag byte) error {
+	if err := os.WriteHead(codec.SIMPLE_LIST, tag); err != nil {
+		return err
+	}
+	if err := os.WriteHead(codec.BYTE, 0); err != nil {
+		return err
+	}
+	if err := os.Write_int32(int32(len(data)), 0); err != nil {
+		return err
+	}
+	return os.Write_slice_uint8(data)
+}
+
+func readBytes(is *codec.Reader, data *[]byte, tag byte) error {
+	err, _, ty := is.SkipToNoCheck(tag, true)
+	if err != nil {
+		return err
+	}
+	var length int32
+	switch ty {
+	case codec.SIMPLE_LIST:
+		if err, _ = is.SkipTo(codec.BYTE, 0, true); err != nil {
+			return err
+		}
+		if err = is.Read_int32(&length, 0, true); err != nil {
+			return err
+		}
+		return is.Read_slice_uint8(data, length, true)
+	case codec.LIST:
+		if err = is.Read_int32(&length, 0, true); err != nil {
+			return err
+		}
+		*data = make([]byte, length)
+		for i := range *data {
+			if err = is.Read_uint8(&(*data)[i], 0, true); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("tup: require vector<byte>, but type %d", ty)
+}
+
+// Encode writes the map of the values with tag 0, the names are sorted for stable output.
+func (u *UniAttribute) Encode(os *codec.Buffer) error {
+	keys := make([]string, 0, len(u.data))
+	for k := range u.data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if err := os.WriteHead(codec.MAP, 0); err != nil {
+		return err
+	}
+	if err := os.Write_int32(int32(len(keys)), 0); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := os.Write_string(k, 0); err != nil {
+			return err
+		}
+		if err := writeBytes(os, u.data[k], 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Decode reads the map of the values written by Encode.
+func (u *UniAttribute) Decode(is *codec.Reader) error {
+	err, _ := is.SkipTo(codec.MAP, 0, true)
+	if err != nil {
+		return err
+	}
+	var length int32
+	if err = is.Read_int32(&length, 0, true); err != nil {
+		return err
+	}
+	data := make(map[string][]byte, length)
+	for i := int32(0); i < length; i++ {
+		var k string
+		var v []byte
+		if err = is.Read_string(&k, 0, true); err != nil {
+			return err
+		}
+		if err = readBytes(is, &v, 1); err != nil {
+			return err
+		}
+		data[k] = v
+	}
+	u.data = data
+	return nil
+}
+
+// ResponseToPacket converts the response of the request to the packet TUP clients expect,
+// which is a RequestPacket with the result in the status.
+func ResponseToPacket(req *requestf.RequestPacket, rsp *requestf.ResponsePacket) *requestf.RequestPacket {
+	status := make(map[string]string, len(rsp.Status)+2)
+	for k, v := range rsp.Status {
+		status[k] = v
+	}
+	status[StatusResultCode] = strconv.Itoa(int(rsp.IRet))
+	status[StatusResultDesc] = rsp.SResultDesc
+	return &requestf.RequestPacket{
+		IVersion:     req.IVersion,
+		CPacketType:  rsp.CPacketType,
+		IMessageType: rsp.IMessageType,
+		IRequestId:   req.IRequestId,
+		SServantName: req.SServantName,
+		SFuncName:    req.SFuncName,
+		SBuffer:      rsp.SBuffer,
+		ITimeout:     req.ITimeout,
+		Context:      rsp.Context,
+		Status:       status,
+	}
+}
+
+// PacketToResponse converts the packet from a TUP server back to the response.
+func PacketToResponse(pkt *requestf.RequestPacket) *requestf.ResponsePacket {
+	rsp := &requestf.ResponsePacket{
+		IVersion:     pkt.IVersion,
+		CPacketType:  pkt.CPacketType,
+		IRequestId:   pkt.IRequestId,
+		IMessageType: pkt.IMessageType,
+		SBuffer:      pkt.SBuffer,
+		Status:       make(map[string]string, len(pkt.Status)),
+		Context:      pkt.Context,
+	}
+	for k, v := range pkt.Status {
+		switch k {
+		case StatusResultCode:
+			ret, _ := strconv.Atoi(v)
+			rsp.IRet = int32(ret)
+		case StatusResultDesc:
+			rsp.SResultDesc = v
+		default:
+			rsp.Status[k] = v
+		}
+	}
+	return rsp
+}
@@ -0,0 +1,74 @@
+package tup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+)
+
+func TestUniAttribute(t *testing.T) {
+	u := NewUniAttribute()
+	if err := u.Put("a", int32(7)); err != nil {
+		t.Fatal(err)
+	}
+	if err := u.Put("s", "hello"); err != nil {
+		t.Fatal(err)
+	}
+	if err := u.Put("b", []byte{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := u.Put("x", struct{}{}); err == nil {
+		t.Error("Expected error putting unsupported type")
+	}
+
+	os := codec.NewBuffer()
+	if err := u.Encode(os); err != nil {
+		t.Fatal(err)
+	}
+	d := NewUniAttribute()
+	if err := d.Decode(codec.NewReader(os.ToBytes())); err != nil {
+		t.Fatal(err)
+	}
+	if d.Len() != 3 {
+		t.Fatalf("Expected 3 values, got %d", d.Len())
+	}
+	var a int32
+	var s string
+	var b []byte
+	if err := d.Get("a", &a); err != nil || a != 7 {
+		t.Errorf("Unexpected a %d %v", a, err)
+	}
+	if err := d.Get("s", &s); err != nil || s != "hello" {
+		t.Errorf("Unexpected s %q %v", s, err)
+	}
+	if err := d.Get("b", &b); err != nil || !bytes.Equal(b, []byte{1, 2, 3}) {
+		t.Errorf("Unexpected b %v %v", b, err)
+	}
+	if err := d.Get("missing", &a); err == nil {
+		t.Error("Expected error getting missing value")
+	}
+
+	// the encoding is stable
+	os2 := codec.NewBuffer()
+	d.Encode(os2)
+	if !bytes.Equal(os.ToBytes(), os2.ToBytes()) {
+		t.Error("Expected the same encoding")
+	}
+}
+
+func TestResponsePacket(t *testing.T) {
+	req := &requestf.RequestPacket{IVersion: basef.TUPVERSION, IRequestId: 5, SServantName: "App.Server.Obj", SFuncName: "add"}
+	rsp := &requestf.ResponsePacket{IVersion: basef.TUPVERSION, IRequestId: 5, IRet: -3, SResultDesc: "no func",
+		SBuffer: []byte{1, 2}, Status: map[string]string{"k": "v"}}
+	pkt := ResponseToPacket(req, rsp)
+	if pkt.SFuncName != "add" || pkt.Status[StatusResultCode] != "-3" || pkt.Status[StatusResultDesc] != "no func" {
+		t.Fatalf("Unexpected packet %+v", pkt)
+	}
+	back := PacketToResponse(pkt)
+	if back.IRequestId != 5 || back.IRet != -3 || back.SResultDesc != "no func" || back.Status["k"] != "v" || len(back.Status) != 1 {
+		t.Errorf("Unexpected response %+v", back)
+	}
+}
@@ -0,0 +1,264 @@
+// Package consul is a consul implementation of registry
+//
+// Every endpoint is registered as a consul service instance with a TTL check,
+// which is kept passing until the endpoint is deregistered, and registered
+// again if the agent loses it.
+package consul
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/registry"
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
+	"github.com/hashicorp/consul/api"
+)
+
+// DefaultTTL is the default ttl of the registration
+var DefaultTTL = 30 * time.Second
+
+type consulRegistry struct {
+	opts   registry.Options
+	client *api.Client
+
+	sync.Mutex
+	heartbeats map[string]chan struct{}
+}
+
+func serviceID(name string, e endpoint.Endpoint) string {
+	return fmt.Sprintf("%s-%s-%d", name, e.Host, e.Port)
+}
+
+func (c *consulRegistry) Init(opts ...registry.Option) error {
+	for _, o := range opts {
+		o(&c.opts)
+	}
+	config := api.DefaultConfig()
+	// set host
+	if len(c.opts.Addrs) > 0 {
+		addr, port, err := net.SplitHostPort(c.opts.Addrs[0])
+		if ae, ok := err.(*net.AddrError); ok && ae.Err == "missing port in address" {
+			port = "8500"
+			config.Address = fmt.Sprintf("%s:%s", c.opts.Addrs[0], port)
+		} else if err == nil {
+			config.Address = fmt.Sprintf("%s:%s", addr, port)
+		}
+	}
+	if c.opts.Timeout > 0 {
+		config.WaitTime = c.opts.Timeout
+	}
+	client, err := api.NewClient(config)
+	if err != nil {
+		return err
+	}
+	c.client = client
+	return nil
+}
+
+func (c *consulRegistry) Options() registry.Options {
+	return c.opts
+}
+
+func (c *consulRegistry) Register(s *registry.Service, opts ...registry.RegisterOption) error {
+	var options registry.RegisterOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	if options.TTL <= 0 {
+		options.TTL = DefaultTTL
+	}
+	for _, e := range s.Endpoints {
+		reg := registration(s.Name, e, options.TTL)
+		if err := c.client.Agent().ServiceRegister(reg); err != nil {
+			return err
+		}
+		c.startHeartbeat(reg, options.TTL)
+	}
+	return nil
+}
+
+// registration returns the consul service instance of the endpoint with its ttl check.
+func registration(name string, e endpoint.Endpoint, ttl time.Duration) *api.AgentServiceRegistration {
+	return &api.AgentServiceRegistration{
+		ID:      serviceID(name, e),
+		Name:    name,
+		Address: e.Host,
+		Port:    int(e.Port),
+		Tags:    []string{"tars"},
+		Meta: map[string]string{
+			"proto":   e.Proto,
+			"timeout": strconv.Itoa(int(e.Timeout)),
+			"setid":   e.SetId,
+		},
+		Check: &api.AgentServiceCheck{
+			TTL:                            ttl.String(),
+			Status:                         api.HealthPassing,
+			DeregisterCriticalServiceAfter: (ttl * 10).String(),
+		},
+	}
+}
+
+// startHeartbeat keeps the ttl check of the service passing. The service is registered again
+// if the check fails to pass, as the agent loses the services registered through its API when
+// it restarts without its data.
+func (c *consulRegistry) startHeartbeat(reg *api.AgentServiceRegistration, ttl time.Duration) {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.heartbeats[reg.ID]; ok {
+		return
+	}
+	exit := make(chan struct{})
+	c.heartbeats[reg.ID] = exit
+	go func() {
+		loop := time.NewTicker(ttl / 3)
+		defer loop.Stop()
+		for {
+			select {
+			case <-exit:
+				return
+			case <-loop.C:
+				if err := c.client.Agent().PassTTL("service:"+reg.ID, ""); err != nil {
+					select {
+					case <-exit:
+						return
+					default:
+					}
+					// retried on the next tick if the agent is still unreachable
+					c.client.Agent().ServiceRegister(reg)
+				}
+			}
+		}
+	}()
+}
+
+func (c *consulRegistry) Deregister(s *registry.Service) error {
+	for _, e := range s.Endpoints {
+		id := serviceID(s.Name, e)
+		c.Lock()
+		if exit, ok := c.heartbeats[id]; ok {
+			close(exit)
+			delete(c.heartbeats, id)
+		}
+		c.Unlock()
+		if err := c.client.Agent().ServiceDeregister(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toService(name string, entries []*api.ServiceEntry) *registry.Service {
+	svc := &registry.Service{Name: name}
+	for _, entry := range entries {
+		address := entry.Service.Address
+		if address == "" {
+			address = entry.Node.Address
+		}
+		e := endpoint.Endpoint{
+			Host:    address,
+			Port:    int32(entry.Service.Port),
+			Timeout: 3000,
+			Istcp:   1,
+			Proto:   "tcp",
+		}
+		if entry.Service.Meta["proto"] == "udp" {
+			e.Proto = "udp"
+			e.Istcp = 0
+		}
+		if t, err := strconv.Atoi(entry.Service.Meta["timeout"]); err == nil && t > 0 {
+			e.Timeout = int32(t)
+		}
+		e.SetId = entry.Service.Meta["setid"]
+		svc.Endpoints = append(svc.Endpoints, e)
+	}
+	// keep a stable order, so that the watcher only reports real changes
+	sort.Slice(svc.Endpoints, func(i, j int) bool {
+		if svc.Endpoints[i].Host != svc.Endpoints[j].Host {
+			return svc.Endpoints[i].Host < svc.Endpoints[j].Host
+		}
+		return svc.Endpoints[i].Port < svc.Endpoints[j].Port
+	})
+	return svc
+}
+
+func (c *consulRegistry) GetService(name string) (*registry.Service, error) {
+	entries, _, err := c.client.Health().Service(name, "", true, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, registry.ErrNotFound
+	}
+	return toService(name, entries), nil
+}
+
+func (c *consulRegistry) Watch(name string) (registry.Watcher, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &consulWatcher{c: c, name: name, ctx: ctx, cancel: cancel}, nil
+}
+
+func (c *consulRegistry) String() string {
+	return "consul"
+}
+
+// consulWatcher watches the service with consul blocking queries.
+type consulWatcher struct {
+	c     *consulRegistry
+	name  string
+	index uint64
+	last  *registry.Service
+	// cancel aborts the pending blocking query
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func (w *consulWatcher) Next() (*registry.Service, error) {
+	for {
+		select {
+		case <-w.ctx.Done():
+			return nil, registry.ErrWatcherStopped
+		default:
+		}
+		q := &api.QueryOptions{WaitIndex: w.index}
+		entries, meta, err := w.c.client.Health().Service(w.name, "", true, q.WithContext(w.ctx))
+		if err != nil {
+			if w.ctx.Err() != nil {
+				return nil, registry.ErrWatcherStopped
+			}
+			if w.index == 0 {
+				return nil, err
+			}
+			// back off a little, consul may be restarting
+			select {
+			case <-w.ctx.Done():
+				return nil, registry.ErrWatcherStopped
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		w.index = meta.LastIndex
+		svc := toService(w.name, entries)
+		if !registry.Equal(svc, w.last) {
+			w.last = svc
+			return svc, nil
+		}
+	}
+}
+
+func (w *consulWatcher) Stop() {
+	w.cancel()
+}
+
+// NewRegistry returns a consul registry
+func NewRegistry(opts ...registry.Option) registry.Registry {
+	c := &consulRegistry{
+		heartbeats: make(map[string]chan struct{}),
+	}
+	c.Init(opts...)
+	return c
+}
@@ -0,0 +1,91 @@
+package consul
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/registry"
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
+	"github.com/hashicorp/consul/api"
+)
+
+// fakeAgent serves the agent API of the service registration and the ttl checks.
+type fakeAgent struct {
+	sync.Mutex
+	services map[string]bool
+}
+
+func (a *fakeAgent) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.Lock()
+	defer a.Unlock()
+	switch {
+	case r.URL.Path == "/v1/agent/service/register":
+		var reg api.AgentServiceRegistration
+		if err := json.NewDecoder(r.Body).Decode(&reg); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		a.services[reg.ID] = true
+	case strings.HasPrefix(r.URL.Path, "/v1/agent/check/pass/service:"):
+		id := strings.TrimPrefix(r.URL.Path, "/v1/agent/check/pass/service:")
+		if !a.services[id] {
+			http.Error(w, "Unknown check \"service:"+id+"\"", http.StatusInternalServerError)
+		}
+	case strings.HasPrefix(r.URL.Path, "/v1/agent/service/deregister/"):
+		delete(a.services, strings.TrimPrefix(r.URL.Path, "/v1/agent/service/deregister/"))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// forget drops all the services, like an agent restarted without its data.
+func (a *fakeAgent) forget() {
+	a.Lock()
+	defer a.Unlock()
+	a.services = make(map[string]bool)
+}
+
+func (a *fakeAgent) registered(id string) bool {
+	a.Lock()
+	defer a.Unlock()
+	return a.services[id]
+}
+
+func TestHeartbeatRegisterAgain(t *testing.T) {
+	agent := &fakeAgent{services: make(map[string]bool)}
+	server := httptest.NewServer(agent)
+	defer server.Close()
+
+	r := NewRegistry(registry.Addrs(strings.TrimPrefix(server.URL, "http://")))
+	e := endpoint.Endpoint{Host: "127.0.0.1", Port: 10015, Proto: "tcp"}
+	svc := &registry.Service{Name: "App.Server.Obj", Endpoints: []endpoint.Endpoint{e}}
+	id := serviceID(svc.Name, e)
+	if err := r.Register(svc, registry.RegisterTTL(30*time.Millisecond)); err != nil {
+		t.Fatalf("Unexpected error registering %v", err)
+	}
+	if !agent.registered(id) {
+		t.Fatal("Expected the service registered")
+	}
+
+	agent.forget()
+	deadline := time.Now().Add(time.Second)
+	for !agent.registered(id) {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the service registered again by the heartbeat")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if err := r.Deregister(svc); err != nil {
+		t.Fatalf("Unexpected error deregistering %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if agent.registered(id) {
+		t.Fatal("Expected the service not registered again after Deregister")
+	}
+}
@@ -0,0 +1,142 @@
+// Package dns provides a registry resolving the endpoints from DNS SRV records
+//
+// The servant App.Server.Obj is looked up as _tars._tcp.app.server.obj.<domain>,
+// and every target of the SRV records becomes an endpoint.
+package dns
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/registry"
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
+)
+
+type domainKey struct{}
+type serviceKey struct{}
+type intervalKey struct{}
+
+// DefaultInterval is the default interval of resolving the records again
+var DefaultInterval = 30 * time.Second
+
+type dnsRegistry struct {
+	opts     registry.Options
+	domain   string
+	service  string
+	interval time.Duration
+	lookup   func(service, proto, name string) (string, []*net.SRV, error)
+}
+
+// Domain sets the domain appended to the servant names
+func Domain(d string) registry.Option {
+	return setOption(domainKey{}, d)
+}
+
+// Service sets the service of the SRV records, tars by default
+func Service(s string) registry.Option {
+	return setOption(serviceKey{}, s)
+}
+
+// Interval sets the interval of resolving the records again
+func Interval(d time.Duration) registry.Option {
+	return setOption(intervalKey{}, d)
+}
+
+func setOption(k, v interface{}) registry.Option {
+	return func(o *registry.Options) {
+		if o.Context == nil {
+			o.Context = context.Background()
+		}
+		o.Context = context.WithValue(o.Context, k, v)
+	}
+}
+
+func (r *dnsRegistry) Init(opts ...registry.Option) error {
+	for _, o := range opts {
+		o(&r.opts)
+	}
+	if r.opts.Context == nil {
+		return nil
+	}
+	if d, ok := r.opts.Context.Value(domainKey{}).(string); ok {
+		r.domain = strings.Trim(d, ".")
+	}
+	if s, ok := r.opts.Context.Value(serviceKey{}).(string); ok && s != "" {
+		r.service = s
+	}
+	if d, ok := r.opts.Context.Value(intervalKey{}).(time.Duration); ok && d > 0 {
+		r.interval = d
+	}
+	return nil
+}
+
+func (r *dnsRegistry) Options() registry.Options {
+	return r.opts
+}
+
+func (r *dnsRegistry) Register(s *registry.Service, opts ...registry.RegisterOption) error {
+	return registry.ErrNotSupport
+}
+
+func (r *dnsRegistry) Deregister(s *registry.Service) error {
+	return registry.ErrNotSupport
+}
+
+func (r *dnsRegistry) GetService(name string) (*registry.Service, error) {
+	host := strings.ToLower(name)
+	if r.domain != "" {
+		host += "." + r.domain
+	}
+	_, addrs, err := r.lookup(r.service, "tcp", host)
+	if err != nil {
+		if e, ok := err.(*net.DNSError); ok && e.IsNotFound {
+			return nil, registry.ErrNotFound
+		}
+		return nil, err
+	}
+	// keep a stable order, so that the watcher only reports real changes
+	sort.Slice(addrs, func(i, j int) bool {
+		if addrs[i].Target != addrs[j].Target {
+			return addrs[i].Target < addrs[j].Target
+		}
+		return addrs[i].Port < addrs[j].Port
+	})
+	svc := &registry.Service{Name: name}
+	for _, a := range addrs {
+		svc.Endpoints = append(svc.Endpoints, endpoint.Endpoint{
+			Host:    strings.TrimSuffix(a.Target, "."),
+			Port:    int32(a.Port),
+			Timeout: 3000,
+			Istcp:   1,
+			Proto:   "tcp",
+		})
+	}
+	if len(svc.Endpoints) == 0 {
+		return nil, registry.ErrNotFound
+	}
+	return svc, nil
+}
+
+func (r *dnsRegistry) Watch(name string) (registry.Watcher, error) {
+	return registry.NewPollWatcher(func() (*registry.Service, error) {
+		return r.GetService(name)
+	}, r.interval), nil
+}
+
+func (r *dnsRegistry) String() string {
+	return "dns"
+}
+
+// NewRegistry returns a registry resolving the endpoints from DNS SRV records
+func NewRegistry(opts ...registry.Option) registry.Registry {
+	r := &dnsRegistry{
+		service:  "tars",
+		interval: DefaultInterval,
+		lookup:   net.LookupSRV,
+	}
+	r.Init(opts...)
+	return r
+}
@@ -0,0 +1,29 @@
+package dns
+
+import (
+	"net"
+	"testing"
+)
+
+func TestDNSRegistry(t *testing.T) {
+	r := NewRegistry(Domain("svc.local.")).(*dnsRegistry)
+	var query string
+	r.lookup = func(service, proto, name string) (string, []*net.SRV, error) {
+		query = "_" + service + "._" + proto + "." + name
+		return "", []*net.SRV{
+			{Target: "b.svc.local.", Port: 10015},
+			{Target: "a.svc.local.", Port: 10015},
+		}, nil
+	}
+
+	svc, err := r.GetService("App.Server.Obj")
+	if err != nil {
+		t.Fatalf("Unexpected error getting service %v", err)
+	}
+	if query != "_tars._tcp.app.server.obj.svc.local" {
+		t.Errorf("Unexpected query %s", query)
+	}
+	if len(svc.Endpoints) != 2 || svc.Endpoints[0].Host != "a.svc.local" || svc.Endpoints[0].Port != 10015 {
+		t.Errorf("Unexpected endpoints %v", svc.Endpoints)
+	}
+}
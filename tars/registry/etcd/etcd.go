@@ -0,0 +1,217 @@
+// Package etcd is an etcd implementation of registry
+//
+// Every endpoint is stored under /tars/registry/<servant>/<host>:<port>
+// with a lease, which is kept alive until the endpoint is deregistered.
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/registry"
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
+	client "github.com/coreos/etcd/clientv3"
+)
+
+// DefaultTTL is the default ttl of the registration
+var DefaultTTL = 30 * time.Second
+
+var prefix = "/tars/registry"
+
+type etcdRegistry struct {
+	opts   registry.Options
+	client *client.Client
+
+	sync.Mutex
+	leases map[string]context.CancelFunc
+}
+
+func servicePath(name string) string {
+	return path.Join(prefix, name) + "/"
+}
+
+func nodePath(name string, e endpoint.Endpoint) string {
+	return path.Join(prefix, name, fmt.Sprintf("%s:%d", e.Host, e.Port))
+}
+
+func (e *etcdRegistry) Init(opts ...registry.Option) error {
+	for _, o := range opts {
+		o(&e.opts)
+	}
+	var endpoints []string
+	for _, addr := range e.opts.Addrs {
+		if len(addr) > 0 {
+			endpoints = append(endpoints, addr)
+		}
+	}
+	if len(endpoints) == 0 {
+		endpoints = []string{"http://127.0.0.1:2379"}
+	}
+	timeout := e.opts.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	c, err := client.New(client.Config{
+		Endpoints:   endpoints,
+		DialTimeout: timeout,
+	})
+	if err != nil {
+		return err
+	}
+	e.client = c
+	return nil
+}
+
+func (e *etcdRegistry) Options() registry.Options {
+	return e.opts
+}
+
+func (e *etcdRegistry) Register(s *registry.Service, opts ...registry.RegisterOption) error {
+	var options registry.RegisterOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	if options.TTL <= 0 {
+		options.TTL = DefaultTTL
+	}
+	for _, end := range s.Endpoints {
+		key := nodePath(s.Name, end)
+		value, err := json.Marshal(end)
+		if err != nil {
+			return err
+		}
+		lease, err := e.client.Grant(context.Background(), int64(options.TTL.Seconds()))
+		if err != nil {
+			return err
+		}
+		if _, err := e.client.Put(context.Background(), key, string(value), client.WithLease(lease.ID)); err != nil {
+			return err
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		ch, err := e.client.KeepAlive(ctx, lease.ID)
+		if err != nil {
+			cancel()
+			return err
+		}
+		go func() {
+			// drain the responses, the client keeps the lease alive until ctx is canceled
+			for range ch {
+			}
+		}()
+		e.Lock()
+		if old, ok := e.leases[key]; ok {
+			old()
+		}
+		e.leases[key] = cancel
+		e.Unlock()
+	}
+	return nil
+}
+
+func (e *etcdRegistry) Deregister(s *registry.Service) error {
+	for _, end := range s.Endpoints {
+		key := nodePath(s.Name, end)
+		e.Lock()
+		if cancel, ok := e.leases[key]; ok {
+			cancel()
+			delete(e.leases, key)
+		}
+		e.Unlock()
+		if _, err := e.client.Delete(context.Background(), key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *etcdRegistry) GetService(name string) (*registry.Service, error) {
+	rsp, err := e.client.Get(context.Background(), servicePath(name), client.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.Kvs) == 0 {
+		return nil, registry.ErrNotFound
+	}
+	svc := &registry.Service{Name: name}
+	for _, kv := range rsp.Kvs {
+		var end endpoint.Endpoint
+		if err := json.Unmarshal(kv.Value, &end); err != nil {
+			continue
+		}
+		svc.Endpoints = append(svc.Endpoints, end)
+	}
+	// keep a stable order, so that the watcher only reports real changes
+	sort.Slice(svc.Endpoints, func(i, j int) bool {
+		if svc.Endpoints[i].Host != svc.Endpoints[j].Host {
+			return svc.Endpoints[i].Host < svc.Endpoints[j].Host
+		}
+		return svc.Endpoints[i].Port < svc.Endpoints[j].Port
+	})
+	return svc, nil
+}
+
+func (e *etcdRegistry) Watch(name string) (registry.Watcher, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &etcdWatcher{
+		e:      e,
+		name:   name,
+		ch:     e.client.Watch(ctx, servicePath(name), client.WithPrefix()),
+		cancel: cancel,
+	}, nil
+}
+
+func (e *etcdRegistry) String() string {
+	return "etcd"
+}
+
+// etcdWatcher gets the service again on every change under the service path.
+type etcdWatcher struct {
+	e       *etcdRegistry
+	name    string
+	ch      client.WatchChan
+	cancel  context.CancelFunc
+	started bool
+	last    *registry.Service
+}
+
+func (w *etcdWatcher) Next() (*registry.Service, error) {
+	for {
+		if w.started {
+			if _, ok := <-w.ch; !ok {
+				return nil, registry.ErrWatcherStopped
+			}
+		}
+		w.started = true
+		svc, err := w.e.GetService(w.name)
+		if err == registry.ErrNotFound {
+			svc = &registry.Service{Name: w.name}
+		} else if err != nil {
+			if w.last == nil {
+				return nil, err
+			}
+			continue
+		}
+		if !registry.Equal(svc, w.last) {
+			w.last = svc
+			return svc, nil
+		}
+	}
+}
+
+func (w *etcdWatcher) Stop() {
+	w.cancel()
+}
+
+// NewRegistry returns an etcd registry
+func NewRegistry(opts ...registry.Option) registry.Registry {
+	e := &etcdRegistry{
+		leases: make(map[string]context.CancelFunc),
+	}
+	e.Init(opts...)
+	return e
+}
@@ -0,0 +1,168 @@
+// Package file provides a registry reading the endpoints from a json or yaml file
+//
+// The file maps the servant names to their endpoints, for example in yaml:
+//
+//	App.Server.Obj:
+//	  - tcp -h 127.0.0.1 -p 10015 -t 60000
+//	  - tcp -h 127.0.0.2 -p 10015 -t 60000
+//
+// The file is watched and reloaded when it is modified.
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/registry"
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
+	yaml "gopkg.in/yaml.v2"
+)
+
+type pathKey struct{}
+type intervalKey struct{}
+
+// DefaultInterval is the default interval of checking the file for changes
+var DefaultInterval = 5 * time.Second
+
+type fileRegistry struct {
+	opts     registry.Options
+	path     string
+	interval time.Duration
+
+	sync.RWMutex
+	modTime  time.Time
+	services map[string]*registry.Service
+}
+
+// Path sets the path of the file
+func Path(p string) registry.Option {
+	return setOption(pathKey{}, p)
+}
+
+// Interval sets the interval of checking the file for changes
+func Interval(d time.Duration) registry.Option {
+	return setOption(intervalKey{}, d)
+}
+
+func setOption(k, v interface{}) registry.Option {
+	return func(o *registry.Options) {
+		if o.Context == nil {
+			o.Context = context.Background()
+		}
+		o.Context = context.WithValue(o.Context, k, v)
+	}
+}
+
+func (r *fileRegistry) Init(opts ...registry.Option) error {
+	for _, o := range opts {
+		o(&r.opts)
+	}
+	if r.opts.Context != nil {
+		if p, ok := r.opts.Context.Value(pathKey{}).(string); ok {
+			r.path = p
+		}
+		if d, ok := r.opts.Context.Value(intervalKey{}).(time.Duration); ok && d > 0 {
+			r.interval = d
+		}
+	}
+	if r.path == "" && len(r.opts.Addrs) > 0 {
+		r.path = r.opts.Addrs[0]
+	}
+	return nil
+}
+
+func (r *fileRegistry) Options() registry.Options {
+	return r.opts
+}
+
+func (r *fileRegistry) Register(s *registry.Service, opts ...registry.RegisterOption) error {
+	return registry.ErrNotSupport
+}
+
+func (r *fileRegistry) Deregister(s *registry.Service) error {
+	return registry.ErrNotSupport
+}
+
+// load reads the file again if it is modified since the last load.
+func (r *fileRegistry) load() error {
+	fi, err := os.Stat(r.path)
+	if err != nil {
+		return err
+	}
+	r.RLock()
+	loaded := r.services != nil && fi.ModTime().Equal(r.modTime)
+	r.RUnlock()
+	if loaded {
+		return nil
+	}
+	data, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		return err
+	}
+	content := make(map[string][]string)
+	ext := strings.ToLower(filepath.Ext(r.path))
+	if ext == ".yaml" || ext == ".yml" {
+		err = yaml.Unmarshal(data, &content)
+	} else {
+		err = json.Unmarshal(data, &content)
+	}
+	if err != nil {
+		return err
+	}
+	services := make(map[string]*registry.Service, len(content))
+	for name, ends := range content {
+		svc := &registry.Service{Name: name}
+		for _, end := range ends {
+			ep, err := endpoint.Parse(end)
+			if err != nil {
+				return fmt.Errorf("endpoint of %s: %v", name, err)
+			}
+			svc.Endpoints = append(svc.Endpoints, ep)
+		}
+		services[name] = svc
+	}
+	r.Lock()
+	r.services = services
+	r.modTime = fi.ModTime()
+	r.Unlock()
+	return nil
+}
+
+func (r *fileRegistry) GetService(name string) (*registry.Service, error) {
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	r.RLock()
+	defer r.RUnlock()
+	svc, ok := r.services[name]
+	if !ok {
+		return nil, registry.ErrNotFound
+	}
+	return svc, nil
+}
+
+func (r *fileRegistry) Watch(name string) (registry.Watcher, error) {
+	return registry.NewPollWatcher(func() (*registry.Service, error) {
+		return r.GetService(name)
+	}, r.interval), nil
+}
+
+func (r *fileRegistry) String() string {
+	return "file"
+}
+
+// NewRegistry returns a registry reading the endpoints from the file
+func NewRegistry(opts ...registry.Option) registry.Registry {
+	r := &fileRegistry{
+		interval: DefaultInterval,
+	}
+	r.Init(opts...)
+	return r
+}
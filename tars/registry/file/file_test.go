@@ -0,0 +1,35 @@
+package file
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileRegistry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"endpoints.json": `{"App.Server.Obj": ["tcp -h 127.0.0.1 -p 10015 -t 60000"]}`,
+		"endpoints.yaml": "App.Server.Obj:\n  - tcp -h 127.0.0.1 -p 10015 -t 60000\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		r := NewRegistry(Path(path))
+		svc, err := r.GetService("App.Server.Obj")
+		if err != nil {
+			t.Fatalf("Unexpected error reading %s: %v", name, err)
+		}
+		if len(svc.Endpoints) != 1 || svc.Endpoints[0].Port != 10015 || svc.Endpoints[0].Timeout != 60000 {
+			t.Errorf("Unexpected endpoints from %s: %v", name, svc.Endpoints)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package registry
+
+import (
+	"context"
+	"time"
+)
+
+type Options struct {
+	Addrs   []string
+	Timeout time.Duration
+	// Other options for implementations of the interface
+	// can be stored in a context
+	Context context.Context
+}
+
+type RegisterOptions struct {
+	// TTL is how long the registration lives without being refreshed
+	TTL time.Duration
+	// Other options for implementations of the interface
+	// can be stored in a context
+	Context context.Context
+}
+
+type Option func(*Options)
+
+type RegisterOption func(*RegisterOptions)
+
+// Addrs sets the addresses of the registry
+func Addrs(addrs ...string) Option {
+	return func(o *Options) {
+		o.Addrs = addrs
+	}
+}
+
+// Timeout sets the timeout of the requests to the registry
+func Timeout(t time.Duration) Option {
+	return func(o *Options) {
+		o.Timeout = t
+	}
+}
+
+// RegisterTTL sets the ttl of the registration
+func RegisterTTL(t time.Duration) RegisterOption {
+	return func(o *RegisterOptions) {
+		o.TTL = t
+	}
+}
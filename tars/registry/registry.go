@@ -0,0 +1,65 @@
+// Package registry is an interface for service discovery and registration
+package registry
+
+import (
+	"errors"
+
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
+)
+
+var (
+	// ErrNotFound is returned when the service is unknown to the registry
+	ErrNotFound = errors.New("service not found")
+	// ErrNotSupport is returned when the registry can not do the operation
+	ErrNotSupport = errors.New("not support")
+	// ErrWatcherStopped is returned by Next of a stopped watcher
+	ErrWatcherStopped = errors.New("watcher stopped")
+)
+
+// Registry provides the endpoints of servants, and registers them when it is supported.
+type Registry interface {
+	Init(...Option) error
+	Options() Options
+	Register(s *Service, opts ...RegisterOption) error
+	Deregister(s *Service) error
+	GetService(name string) (*Service, error)
+	Watch(name string) (Watcher, error)
+	String() string
+}
+
+// Service is a servant with its endpoints.
+type Service struct {
+	// Name is the servant name, like App.Server.Obj
+	Name      string
+	Endpoints []endpoint.Endpoint
+	// Inactive endpoints are known to the registry but should not be called
+	Inactive []endpoint.Endpoint
+}
+
+// Watcher watches the changes of a service.
+type Watcher interface {
+	// Next blocks until the service changes and returns the latest service
+	Next() (*Service, error)
+	Stop()
+}
+
+// Equal reports whether the two services have the same endpoints in the same order.
+func Equal(a, b *Service) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name || len(a.Endpoints) != len(b.Endpoints) || len(a.Inactive) != len(b.Inactive) {
+		return false
+	}
+	for i := range a.Endpoints {
+		if a.Endpoints[i] != b.Endpoints[i] {
+			return false
+		}
+	}
+	for i := range a.Inactive {
+		if a.Inactive[i] != b.Inactive[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+// Package static provides a registry with fixed endpoint lists
+package static
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/registry"
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
+)
+
+type servicesKey struct{}
+
+type staticRegistry struct {
+	opts registry.Options
+
+	sync.RWMutex
+	services map[string]*registry.Service
+}
+
+// Services sets the initial services of the registry
+func Services(s ...*registry.Service) registry.Option {
+	return func(o *registry.Options) {
+		if o.Context == nil {
+			o.Context = context.Background()
+		}
+		o.Context = context.WithValue(o.Context, servicesKey{}, s)
+	}
+}
+
+// Parse parses the services like App.Server.Obj@tcp -h 127.0.0.1 -p 10015:tcp -h 127.0.0.2 -p 10015,
+// several services are separated by ';'.
+func Parse(s string) ([]*registry.Service, error) {
+	var services []*registry.Service
+	for _, obj := range strings.Split(s, ";") {
+		obj = strings.TrimSpace(obj)
+		pos := strings.Index(obj, "@")
+		if pos <= 0 {
+			continue
+		}
+		svc := &registry.Service{Name: obj[:pos]}
+		for _, end := range strings.Split(obj[pos+1:], ":") {
+			if strings.TrimSpace(end) == "" {
+				continue
+			}
+			ep, err := endpoint.Parse(end)
+			if err != nil {
+				return nil, fmt.Errorf("endpoint of %s: %v", svc.Name, err)
+			}
+			svc.Endpoints = append(svc.Endpoints, ep)
+		}
+		services = append(services, svc)
+	}
+	return services, nil
+}
+
+func (r *staticRegistry) Init(opts ...registry.Option) error {
+	for _, o := range opts {
+		o(&r.opts)
+	}
+	if r.opts.Context == nil {
+		return nil
+	}
+	if s, ok := r.opts.Context.Value(servicesKey{}).([]*registry.Service); ok {
+		for _, svc := range s {
+			r.Register(svc)
+		}
+	}
+	return nil
+}
+
+func (r *staticRegistry) Options() registry.Options {
+	return r.opts
+}
+
+// Register adds the endpoints to the service
+func (r *staticRegistry) Register(s *registry.Service, opts ...registry.RegisterOption) error {
+	r.Lock()
+	defer r.Unlock()
+	old, ok := r.services[s.Name]
+	if !ok {
+		old = &registry.Service{Name: s.Name}
+	}
+	svc := &registry.Service{Name: s.Name}
+	svc.Endpoints = append(svc.Endpoints, old.Endpoints...)
+	for _, e := range s.Endpoints {
+		if !contains(svc.Endpoints, e) {
+			svc.Endpoints = append(svc.Endpoints, e)
+		}
+	}
+	r.services[s.Name] = svc
+	return nil
+}
+
+// Deregister removes the endpoints from the service
+func (r *staticRegistry) Deregister(s *registry.Service) error {
+	r.Lock()
+	defer r.Unlock()
+	old, ok := r.services[s.Name]
+	if !ok {
+		return registry.ErrNotFound
+	}
+	svc := &registry.Service{Name: s.Name}
+	for _, e := range old.Endpoints {
+		if !contains(s.Endpoints, e) {
+			svc.Endpoints = append(svc.Endpoints, e)
+		}
+	}
+	if len(svc.Endpoints) == 0 {
+		delete(r.services, s.Name)
+	} else {
+		r.services[s.Name] = svc
+	}
+	return nil
+}
+
+func (r *staticRegistry) GetService(name string) (*registry.Service, error) {
+	r.RLock()
+	defer r.RUnlock()
+	svc, ok := r.services[name]
+	if !ok {
+		return nil, registry.ErrNotFound
+	}
+	return svc, nil
+}
+
+func (r *staticRegistry) Watch(name string) (registry.Watcher, error) {
+	return registry.NewPollWatcher(func() (*registry.Service, error) {
+		return r.GetService(name)
+	}, time.Second), nil
+}
+
+func (r *staticRegistry) String() string {
+	return "static"
+}
+
+func contains(eps []endpoint.Endpoint, e endpoint.Endpoint) bool {
+	for _, v := range eps {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
+
+// NewRegistry returns a registry holding the services in memory
+func NewRegistry(opts ...registry.Option) registry.Registry {
+	r := &staticRegistry{
+		services: make(map[string]*registry.Service),
+	}
+	r.Init(opts...)
+	return r
+}
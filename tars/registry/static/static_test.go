@@ -0,0 +1,38 @@
+package static
+
+import (
+	"testing"
+
+	"github.com/TarsCloud/TarsGo/tars/registry"
+)
+
+func TestStaticRegistry(t *testing.T) {
+	services, err := Parse("App.Server.Obj@tcp -h 127.0.0.1 -p 10015:tcp -h 127.0.0.2 -p 10015")
+	if err != nil {
+		t.Fatalf("Unexpected error parsing %v", err)
+	}
+	if len(services) != 1 || len(services[0].Endpoints) != 2 {
+		t.Fatalf("Unexpected parse result %v", services)
+	}
+	r := NewRegistry(Services(services...))
+
+	svc, err := r.GetService("App.Server.Obj")
+	if err != nil {
+		t.Fatalf("Unexpected error getting service %v", err)
+	}
+	if svc.Endpoints[1].Host != "127.0.0.2" || svc.Endpoints[1].Port != 10015 {
+		t.Errorf("Unexpected endpoint %v", svc.Endpoints[1])
+	}
+
+	if err := r.Deregister(&registry.Service{Name: "App.Server.Obj", Endpoints: svc.Endpoints[:1]}); err != nil {
+		t.Fatalf("Unexpected error deregistering %v", err)
+	}
+	svc, _ = r.GetService("App.Server.Obj")
+	if len(svc.Endpoints) != 1 || svc.Endpoints[0].Host != "127.0.0.2" {
+		t.Errorf("Unexpected endpoints after deregister %v", svc.Endpoints)
+	}
+
+	if _, err := r.GetService("App.Server.Other"); err != registry.ErrNotFound {
+		t.Errorf("Expected not found, got %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+package registry
+
+import (
+	"sync"
+	"time"
+)
+
+type pollWatcher struct {
+	get      func() (*Service, error)
+	interval time.Duration
+	last     *Service
+	started  bool
+	exit     chan struct{}
+	once     sync.Once
+}
+
+// NewPollWatcher returns a watcher calling get every interval, for the registries
+// which can not push the changes. The first Next returns the current service.
+func NewPollWatcher(get func() (*Service, error), interval time.Duration) Watcher {
+	return &pollWatcher{
+		get:      get,
+		interval: interval,
+		exit:     make(chan struct{}),
+	}
+}
+
+func (w *pollWatcher) Next() (*Service, error) {
+	for {
+		select {
+		case <-w.exit:
+			return nil, ErrWatcherStopped
+		default:
+		}
+		if w.started {
+			select {
+			case <-w.exit:
+				return nil, ErrWatcherStopped
+			case <-time.After(w.interval):
+			}
+		}
+		w.started = true
+		s, err := w.get()
+		if err != nil {
+			if w.last == nil {
+				return nil, err
+			}
+			// keep the last known service, the registry may come back
+			continue
+		}
+		if !Equal(s, w.last) {
+			w.last = s
+			return s, nil
+		}
+	}
+}
+
+func (w *pollWatcher) Stop() {
+	w.once.Do(func() {
+		close(w.exit)
+	})
+}
@@ -7,9 +7,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/TarsCloud/TarsGo/tars/model"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
-	"github.com/TarsCloud/TarsGo/tars/util/tools"
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
 )
 
 //ServantProxy is the struct for proxy servants.
@@ -19,6 +20,9 @@ type ServantProxy struct {
 	comm    *Communicator
 	obj     *ObjectProxy
 	timeout int
+	version int16
+	// ownComm is set for the proxy from Dail, whose communicator is closed with it
+	ownComm bool
 }
 
 //Init init the ServantProxy struct.
@@ -33,6 +37,7 @@ func (s *ServantProxy) Init(comm *Communicator, objName string) {
 	of := new(ObjectProxyFactory)
 	of.Init(comm)
 	s.timeout = s.comm.Client.AsyncInvokeTimeout
+	s.version = basef.TARSVERSION
 	s.obj = of.GetObjectProxy(objName)
 }
 
@@ -41,40 +46,131 @@ func (s *ServantProxy) TarsSetTimeout(t int) {
 	s.timeout = t
 }
 
+//TarsSetVersion sets the protocol version of the requests, basef.TUPVERSION calls the servants speaking TUP,
+//basef.JSONVERSION encodes the arguments as a JSON object by name.
+func (s *ServantProxy) TarsSetVersion(v int16) {
+	s.version = v
+}
+
+//TarsGetVersion returns the protocol version of the requests.
+func (s *ServantProxy) TarsGetVersion() int16 {
+	return s.version
+}
+
+//Limit returns the current concurrency limit of the servant proxy.
+func (s *ServantProxy) Limit() int {
+	return s.obj.Limit()
+}
+
+//QueueLen returns the number of requests of the servant proxy waiting for response.
+func (s *ServantProxy) QueueLen() int {
+	return s.obj.QueueLen()
+}
+
+//Close closes the connections of the servant proxy and stops refreshing its endpoints.
+//The proxy should not be used after Close, get a new one from the communicator instead.
+func (s *ServantProxy) Close() {
+	if s.ownComm {
+		s.comm.Close()
+		return
+	}
+	s.comm.s.remove(s)
+	s.obj.Close()
+}
+
 //Tars_invoke is use for client inoking server.
+//ctype is the packet type, basef.TARSONEWAY sends the request without waiting for the response.
 func (s *ServantProxy) Tars_invoke(ctx context.Context, ctype byte,
 	sFuncName string,
 	buf []byte,
 	status map[string]string,
 	reqContext map[string]string,
 	Resp *requestf.ResponsePacket) error {
+	return s.Tars_invokeWithOptions(ctx, ctype, sFuncName, buf, &model.CallOptions{Status: status, Context: reqContext}, Resp)
+}
+
+//Tars_invokeWithOptions is Tars_invoke with the options of the call, set by the CallOption functions.
+func (s *ServantProxy) Tars_invokeWithOptions(ctx context.Context, ctype byte,
+	sFuncName string,
+	buf []byte,
+	opts *model.CallOptions,
+	Resp *requestf.ResponsePacket) error {
 	defer checkPanic()
+	var target *endpoint.Endpoint
+	if opts.Endpoint != "" {
+		ep, err := endpoint.Parse(opts.Endpoint)
+		if err != nil {
+			return err
+		}
+		target = &ep
+	}
+	timeout := time.Duration(s.timeout) * time.Millisecond
+	if opts.Timeout > 0 {
+		timeout = opts.Timeout
+	}
+	for retry := 0; ; retry++ {
+		msg := s.newMessage(ctype, sFuncName, buf, opts)
+		msg.endpoint = target
+		err := s.invoke(ctx, msg, timeout)
+		// only the calls failed without a response are retried, and the timed out ones only if idempotent
+		if err == nil || msg.Resp != nil || msg.Status == TarsInvokeCanceled || retry >= opts.Retries || ctx.Err() != nil ||
+			(msg.Status == basef.TARSINVOKETIMEOUT && !opts.Idempotent) {
+			if err == nil && msg.Resp != nil {
+				*Resp = *msg.Resp
+			}
+			return err
+		}
+		TLOG.Debugf("Retry Obj:%s,fun:%s,retry:%d", s.name, sFuncName, retry+1)
+	}
+}
+
+func (s *ServantProxy) newMessage(ctype byte, sFuncName string, buf []byte, opts *model.CallOptions) *Message {
 	//TODO 重置sid，防止溢出
 	atomic.CompareAndSwapInt32(&s.sid, 1<<31-1, 1)
 	req := requestf.RequestPacket{
-		IVersion:     1,
-		CPacketType:  0,
+		IVersion:     s.version,
+		CPacketType:  int8(ctype),
 		IRequestId:   atomic.AddInt32(&s.sid, 1),
 		SServantName: s.name,
 		SFuncName:    sFuncName,
-		SBuffer:      tools.ByteToInt8(buf),
+		SBuffer:      buf,
 		ITimeout:     ReqDefaultTimeout,
-		Context:      reqContext,
-		Status:       status,
+		Context:      opts.Context,
+		Status:       opts.Status,
+	}
+	msg := &Message{Req: &req, Ser: s, Obj: s.obj, setName: opts.SetName}
+	if opts.IsHash {
+		msg.SetHashCode(opts.HashCode)
 	}
-	msg := &Message{Req: &req, Ser: s, Obj: s.obj}
+	return msg
+}
+
+func (s *ServantProxy) invoke(ctx context.Context, msg *Message, timeout time.Duration) error {
+	sFuncName := msg.Req.SFuncName
 	msg.Init()
 	var err error
+	invoke := s.obj.Invoke
+	if faults.active() {
+		// fault injection sits right before the network, after the user filter
+		invoke = func(ctx context.Context, msg *Message, timeout time.Duration) error {
+			return FaultClientFilter(ctx, msg, s.obj.Invoke, timeout)
+		}
+	}
 	if allFilters.cf != nil {
-		err = allFilters.cf(ctx, msg, s.obj.Invoke, time.Duration(s.timeout)*time.Millisecond)
+		err = allFilters.cf(ctx, msg, invoke, timeout)
 	} else {
-		err = s.obj.Invoke(ctx, msg, time.Duration(s.timeout)*time.Millisecond)
+		err = invoke(ctx, msg, timeout)
 	}
 	if err != nil {
+		msg.End()
+		if msg.Status == TarsInvokeCanceled {
+			// canceled by the caller, not a failure of the server
+			TLOG.Debugf("Invoke Obj:%s,fun:%s,canceled:%s", s.name, sFuncName, err.Error())
+			ReportStat(msg, 0, 0, 0)
+			return err
+		}
 		TLOG.Errorf("Invoke Obj:%s,fun:%s,error:%s", s.name, sFuncName, err.Error())
-		if msg.Resp == nil {
-			ReportStat(msg, 0, 0, 1)
-		} else if msg.Status == basef.TARSINVOKETIMEOUT {
+		if msg.Status == basef.TARSINVOKETIMEOUT {
 			ReportStat(msg, 0, 1, 0)
 		} else {
 			ReportStat(msg, 0, 0, 1)
@@ -82,7 +178,6 @@ func (s *ServantProxy) Tars_invoke(ctx context.Context, ctype byte,
 		return err
 	}
 	msg.End()
-	*Resp = *msg.Resp
 	//report
 	ReportStat(msg, 1, 0, 0)
 	return err
@@ -117,3 +212,24 @@ func (o *ServantProxyFactory) GetServantProxy(objName string) *ServantProxy {
 	o.fm.Unlock()
 	return obj
 }
+
+func (o *ServantProxyFactory) remove(s *ServantProxy) {
+	o.fm.Lock()
+	defer o.fm.Unlock()
+	for name, obj := range o.objs {
+		if obj == s {
+			delete(o.objs, name)
+		}
+	}
+}
+
+//Close closes all the servant proxies created by the factory.
+func (o *ServantProxyFactory) Close() {
+	o.fm.Lock()
+	objs := o.objs
+	o.objs = make(map[string]*ServantProxy)
+	o.fm.Unlock()
+	for _, obj := range objs {
+		obj.obj.Close()
+	}
+}
@@ -0,0 +1,46 @@
+package tars
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/model"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+)
+
+func TestInvokeRetry(t *testing.T) {
+	defer RegisterClientFilter(nil)
+	tests := []struct {
+		name     string
+		status   int32
+		opts     []CallOption
+		expected int
+	}{
+		{name: "send failure", opts: []CallOption{WithRetry(2)}, expected: 3},
+		{name: "send failure without retry", expected: 1},
+		{name: "timeout", status: basef.TARSINVOKETIMEOUT, opts: []CallOption{WithRetry(2)}, expected: 1},
+		{name: "idempotent timeout", status: basef.TARSINVOKETIMEOUT, opts: []CallOption{WithRetry(2), WithIdempotent()}, expected: 3},
+		{name: "canceled", status: TarsInvokeCanceled, opts: []CallOption{WithRetry(2), WithIdempotent()}, expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			RegisterClientFilter(func(ctx context.Context, msg *Message, invoke Invoke, timeout time.Duration) error {
+				calls++
+				msg.Status = tt.status
+				return errors.New("invoke fail")
+			})
+			s := &ServantProxy{name: "App.Server.Obj", timeout: 100}
+			err := s.Tars_invokeWithOptions(context.Background(), 0, "echo", nil, model.NewCallOptions(tt.opts...), new(requestf.ResponsePacket))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if calls != tt.expected {
+				t.Errorf("called %d times, expected %d", calls, tt.expected)
+			}
+		})
+	}
+}
@@ -44,6 +44,16 @@ const (
 	ReqDefaultTimeout int32 = 3000
 	//ObjQueueMax obj queue max number
 	ObjQueueMax int32 = 10000
+	//ObjLimitInit initial adaptive concurrency limit of an obj proxy, the old queue cap
+	ObjLimitInit int = 10000
+	//ObjLimitMin min adaptive concurrency limit of an obj proxy
+	ObjLimitMin int = 10
+	//AdapterLimitInit initial adaptive concurrency limit of an endpoint
+	AdapterLimitInit int = 10000
+	//AdapterLimitMin min adaptive concurrency limit of an endpoint
+	AdapterLimitMin int = 5
+	//AdapterLimitMax max adaptive concurrency limit of an endpoint
+	AdapterLimitMax int = 10000
 
 	//log
 	remotelogBuff int = 500000
@@ -72,11 +82,32 @@ const (
 	//AdapterProxyResetCount adapter proxy reset count
 	AdapterProxyResetCount int = 5
 
+	//outlier detection
+
+	//OutlierCheckInterval interval of checking endpoints for latency outliers
+	OutlierCheckInterval time.Duration = 10 * time.Second
+	//OutlierEjectTime how long an outlier endpoint is ejected
+	OutlierEjectTime time.Duration = 30 * time.Second
+	//OutlierLatencyFactor an endpoint is an outlier if its latency is over this factor of the median of its peers
+	OutlierLatencyFactor float64 = 3
+	//OutlierMinLatency latency below this value is never considered as an outlier
+	OutlierMinLatency time.Duration = 10 * time.Millisecond
+	//OutlierMinRequests min requests of an endpoint before its latency is considered
+	OutlierMinRequests int = 20
+	//OutlierMaxEjectPercent max percent of endpoints ejected at the same time
+	OutlierMaxEjectPercent int = 30
+
 	//communicator default ,update from remote config
 	refreshEndpointInterval int = 60000
 	reportInterval          int = 10000
 	//AsyncInvokeTimeout async invoke timeout
 	AsyncInvokeTimeout int = 3000
+	//RegistryTTL ttl of the adapters registered to the registry
+	RegistryTTL time.Duration = 30 * time.Second
+	//WatchRetryMin backoff of watching the endpoints from the registry after a failure, doubled up to WatchRetryMax
+	WatchRetryMin time.Duration = time.Second
+	//WatchRetryMax max backoff of watching the endpoints from the registry
+	WatchRetryMax time.Duration = 30 * time.Second
 
 	//tcp network config
 
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/statf"
 )
 
@@ -177,6 +178,10 @@ func ReportStatFromClient(msg *Message, succ int32, timeout int32, exec int32) {
 	}
 	if msg.Resp != nil {
 		head.ReturnValue = msg.Resp.IRet
+	} else if msg.Req.CPacketType == basef.TARSONEWAY && succ > 0 {
+		head.ReturnValue = basef.TARSSERVERSUCCESS
+	} else if msg.Status != 0 {
+		head.ReturnValue = msg.Status
 	} else {
 		head.ReturnValue = -1
 	}
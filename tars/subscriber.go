@@ -23,6 +23,15 @@ const (
 	subSig = "func(context.Context, interface{}) error"
 )
 
+type eventKey struct{}
+
+// EventFromContext returns the broker event of the message the subscriber handles, the handlers
+// subscribed with DisableAutoAck acknowledge the message with its Ack.
+func EventFromContext(ctx context.Context) (broker.Event, bool) {
+	e, ok := ctx.Value(eventKey{}).(broker.Event)
+	return e, ok
+}
+
 type subscriberHelper struct {
 	sync.RWMutex
 	wg *sync.WaitGroup
@@ -46,6 +55,28 @@ func (s *subscriberHelper) RegisterSubscriber(topic string, h interface{}, opts
 	return s.Subscribe(subscriber)
 }
 
+// ReplayDeadLetters publishes the messages dead-lettered to the topic before the call back to the
+// topics they failed on, see broker.ReplayDeadLetters. The instances replaying with the same
+// SubscriberQueue share the dead letters, so each one is replayed once.
+func (s *subscriberHelper) ReplayDeadLetters(deadLetter string, opts ...SubscriberOption) (broker.Subscriber, error) {
+	return broker.ReplayDeadLetters(getOptions().Broker(), deadLetter, brokerSubscribeOptions(NewSubscriberOptions(opts...))...)
+}
+
+// brokerSubscribeOptions returns the broker options of the subscriber options.
+func brokerSubscribeOptions(o SubscriberOptions) []broker.SubscribeOption {
+	var opts []broker.SubscribeOption
+	if len(o.Queue) > 0 {
+		opts = append(opts, broker.Queue(o.Queue))
+	}
+	if !o.AutoAck {
+		opts = append(opts, broker.DisableAutoAck())
+	}
+	if o.Context != nil {
+		opts = append(opts, broker.SubscribeContext(o.Context))
+	}
+	return opts
+}
+
 func (s *subscriberHelper) NewSubscriber(topic string, handler interface{}, opts ...SubscriberOption) Subscriber {
 	return newSubscriber(topic, handler, opts...)
 }
@@ -59,6 +90,8 @@ func (s *subscriberHelper) Unsubscribe(topic string) error {
 		return fmt.Errorf("subscriber %v not exists", topic)
 	}
 
+	// cancel the retries waiting for the backoff before waiting for the handlers
+	close(sub.stop)
 	for _, v := range sub.subscribers {
 		v.Unsubscribe()
 	}
@@ -67,6 +100,20 @@ func (s *subscriberHelper) Unsubscribe(topic string) error {
 	return nil
 }
 
+// unsubscribeAll unsubscribes all the subscribers, before the broker disconnects.
+func (s *subscriberHelper) unsubscribeAll() {
+	s.RLock()
+	var topics []string
+	for topic := range s.subscribers {
+		topics = append(topics, topic)
+	}
+	s.RUnlock()
+
+	for _, topic := range topics {
+		s.Unsubscribe(topic)
+	}
+}
+
 func (s *subscriberHelper) Subscribe(sb Subscriber) error {
 	sub, ok := sb.(*subscriber)
 	if !ok {
@@ -90,16 +137,12 @@ func (s *subscriberHelper) Subscribe(sb Subscriber) error {
 	}
 
 	handler := s.createSubHandler(sub, *s.opts)
-	var opts []broker.SubscribeOption
-	if queue := sb.Options().Queue; len(queue) > 0 {
-		opts = append(opts, broker.Queue(queue))
-	}
-
-	if !sb.Options().AutoAck {
-		opts = append(opts, broker.DisableAutoAck())
+	sub.stop = make(chan struct{})
+	if o := sb.Options(); o.Retry.Attempts > 1 || o.DeadLetter != "" {
+		handler = broker.RetryHandler(getOptions().Broker(), handler, o.Retry, o.DeadLetter, sub.stop)
 	}
 
-	bsub, err := getOptions().Broker().Subscribe(sub.Topic(), handler, opts...)
+	bsub, err := getOptions().Broker().Subscribe(sub.Topic(), handler, brokerSubscribeOptions(sb.Options())...)
 	if err != nil {
 		return err
 	}
@@ -134,6 +177,8 @@ type subscriber struct {
 	opts SubscriberOptions
 
 	subscribers []broker.Subscriber
+	// stop is closed after the subscriber is unsubscribed
+	stop chan struct{}
 }
 
 func newSubscriber(topic string, sub interface{}, opts ...SubscriberOption) Subscriber {
@@ -295,6 +340,7 @@ func (s *subscriberHelper) createSubHandler(sb *subscriber, opts BrokerOptions)
 
 		// create context
 		ctx := metadata.NewContext(context.Background(), hdr)
+		ctx = context.WithValue(ctx, eventKey{}, p)
 
 		results := make(chan error, len(sb.handlers))
 
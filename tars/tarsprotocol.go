@@ -1,7 +1,6 @@
 package tars
 
 import (
-	"bytes"
 	"context"
 	"encoding/binary"
 	"time"
@@ -11,6 +10,7 @@ import (
 	"github.com/TarsCloud/TarsGo/tars/protocol/codec"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
 	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/tup"
 )
 
 type dispatch interface {
@@ -37,7 +37,20 @@ func (s *TarsProtocol) Invoke(ctx context.Context, req []byte) (rsp []byte) {
 	reqPackage := requestf.RequestPacket{}
 	rspPackage := requestf.ResponsePacket{}
 	is := codec.NewReader(req)
-	reqPackage.ReadFrom(is)
+	if err := reqPackage.ReadFrom(is); err != nil {
+		TLOG.Error("decode request fail:", reqPackage.IRequestId, err)
+		if reqPackage.CPacketType == basef.TARSONEWAY {
+			return nil
+		}
+		rspPackage.IVersion = reqPackage.IVersion
+		rspPackage.IRequestId = reqPackage.IRequestId
+		rspPackage.IRet = basef.TARSSERVERDECODEERR
+		rspPackage.SResultDesc = err.Error()
+		if reqPackage.IVersion == basef.TUPVERSION {
+			return s.tupRsp2Byte(&reqPackage, &rspPackage)
+		}
+		return s.rsp2Byte(&rspPackage)
+	}
 	TLOG.Debug("invoke:", reqPackage.IRequestId)
 	if reqPackage.CPacketType == basef.TARSONEWAY {
 		defer func() func() {
@@ -59,31 +72,65 @@ func (s *TarsProtocol) Invoke(ctx context.Context, req []byte) (rsp []byte) {
 			TLOG.Error("Set request context in context fail!")
 		}
 	}
+	dispatch := s.dispatcher.Dispatch
+	if faults.active() {
+		dispatch = func(ctx context.Context, f interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool) error {
+			return FaultServerFilter(ctx, s.dispatcher.Dispatch, f, req, resp, withContext)
+		}
+	}
 	if allFilters.sf != nil {
-		err = allFilters.sf(ctx, s.dispatcher.Dispatch, s.serverImp, &reqPackage, &rspPackage, s.withContext)
+		err = allFilters.sf(ctx, dispatch, s.serverImp, &reqPackage, &rspPackage, s.withContext)
 	} else {
-		err = s.dispatcher.Dispatch(ctx, s.serverImp, &reqPackage, &rspPackage, s.withContext)
+		err = dispatch(ctx, s.serverImp, &reqPackage, &rspPackage, s.withContext)
+	}
+	if err == errFaultDrop {
+		return nil
 	}
 	if err != nil {
-		rspPackage.IVersion = basef.TARSVERSION
+		rspPackage.IVersion = reqPackage.IVersion
 		rspPackage.CPacketType = basef.TARSNORMAL
 		rspPackage.IRequestId = reqPackage.IRequestId
 		rspPackage.IRet = 1
+		if codec.IsDecodeError(err) {
+			rspPackage.IRet = basef.TARSSERVERDECODEERR
+		}
 		rspPackage.SResultDesc = err.Error()
 	}
+	if reqPackage.CPacketType == basef.TARSONEWAY {
+		// no response is expected by the client for oneway requests
+		return nil
+	}
+	if reqPackage.IVersion == basef.TUPVERSION {
+		return s.tupRsp2Byte(&reqPackage, &rspPackage)
+	}
 	return s.rsp2Byte(&rspPackage)
 }
 
+// tupRsp2Byte encodes the response in the request packet TUP clients expect.
+func (s *TarsProtocol) tupRsp2Byte(req *requestf.RequestPacket, rsp *requestf.ResponsePacket) []byte {
+	return encodePacket(tup.ResponseToPacket(req, rsp))
+}
+
 func (s *TarsProtocol) rsp2Byte(rsp *requestf.ResponsePacket) []byte {
-	os := codec.NewBuffer()
-	rsp.WriteTo(os)
-	bs := os.ToBytes()
-	sbuf := bytes.NewBuffer(nil)
-	sbuf.Write(make([]byte, 4))
-	sbuf.Write(bs)
-	len := sbuf.Len()
-	binary.BigEndian.PutUint32(sbuf.Bytes(), uint32(len))
-	return sbuf.Bytes()
+	return encodePacket(rsp)
+}
+
+type packet interface {
+	WriteTo(*codec.Buffer) error
+}
+
+// encodePacket encodes the packet prefixed with its length in a pooled buffer,
+// the result is copied out of the buffer only once since it is sent asynchronously.
+func encodePacket(p packet) []byte {
+	os := codec.AcquireBuffer()
+	defer codec.ReleaseBuffer(os)
+	var head [4]byte
+	os.Write_slice_uint8(head[:])
+	p.WriteTo(os)
+	bs := make([]byte, len(os.ToBytes()))
+	copy(bs, os.ToBytes())
+	binary.BigEndian.PutUint32(bs, uint32(len(bs)))
+	return bs
 }
 
 //ParsePackage parse the []byte according to the tars protocol.
@@ -93,8 +140,23 @@ func (s *TarsProtocol) ParsePackage(buff []byte) (int, int) {
 
 //InvokeTimeout indicates how to deal with timeout.
 func (s *TarsProtocol) InvokeTimeout(ctx context.Context, pkg []byte) []byte {
+	reqPackage := requestf.RequestPacket{}
 	rspPackage := requestf.ResponsePacket{}
-	rspPackage.IRet = 1
-	rspPackage.SResultDesc = "server invoke timeout"
+	if err := reqPackage.ReadFrom(codec.NewReader(pkg)); err != nil {
+		TLOG.Error("decode request fail:", reqPackage.IRequestId, err)
+		rspPackage.IRet = basef.TARSSERVERDECODEERR
+		rspPackage.SResultDesc = err.Error()
+	} else {
+		rspPackage.IRet = 1
+		rspPackage.SResultDesc = "server invoke timeout"
+	}
+	if reqPackage.CPacketType == basef.TARSONEWAY {
+		return nil
+	}
+	rspPackage.IVersion = reqPackage.IVersion
+	rspPackage.IRequestId = reqPackage.IRequestId
+	if reqPackage.IVersion == basef.TUPVERSION {
+		return s.tupRsp2Byte(&reqPackage, &rspPackage)
+	}
 	return s.rsp2Byte(&rspPackage)
 }
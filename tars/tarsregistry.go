@@ -0,0 +1,141 @@
+package tars
+
+import (
+	"strings"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/endpointf"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/queryf"
+	"github.com/TarsCloud/TarsGo/tars/registry"
+	"github.com/TarsCloud/TarsGo/tars/registry/consul"
+	"github.com/TarsCloud/TarsGo/tars/registry/dns"
+	"github.com/TarsCloud/TarsGo/tars/registry/file"
+	"github.com/TarsCloud/TarsGo/tars/registry/static"
+	"github.com/TarsCloud/TarsGo/tars/util/endpoint"
+)
+
+// tarsRegistry finds the endpoints from the tars registry through the QueryF servant of the locator.
+type tarsRegistry struct {
+	opts registry.Options
+	comm *Communicator
+	q    *queryf.QueryF
+}
+
+func newTarsRegistry(comm *Communicator) *tarsRegistry {
+	r := &tarsRegistry{comm: comm, q: new(queryf.QueryF)}
+	obj, _ := comm.GetProperty("locator")
+	comm.StringToProxy(obj, r.q)
+	return r
+}
+
+func (r *tarsRegistry) Init(opts ...registry.Option) error {
+	for _, o := range opts {
+		o(&r.opts)
+	}
+	return nil
+}
+
+func (r *tarsRegistry) Options() registry.Options {
+	return r.opts
+}
+
+// Register is done by tarsnode, not by the servant itself.
+func (r *tarsRegistry) Register(s *registry.Service, opts ...registry.RegisterOption) error {
+	return registry.ErrNotSupport
+}
+
+func (r *tarsRegistry) Deregister(s *registry.Service) error {
+	return registry.ErrNotSupport
+}
+
+func (r *tarsRegistry) GetService(name string) (*registry.Service, error) {
+	activeEp := new([]endpointf.EndpointF)
+	inactiveEp := new([]endpointf.EndpointF)
+	var setable, ok bool
+	var setID string
+	var ret int32
+	var err error
+	if setable, ok = r.comm.GetPropertyBool("enableset"); ok {
+		setID, _ = r.comm.GetProperty("setdivision")
+	}
+	if setable {
+		ret, err = r.q.FindObjectByIdInSameSet(name, setID, activeEp, inactiveEp)
+	} else {
+		ret, err = r.q.FindObjectByIdInSameGroup(name, activeEp, inactiveEp)
+	}
+	if err != nil {
+		return nil, err
+	}
+	TLOG.Debug("find obj endpoint:", name, ret, *activeEp, *inactiveEp)
+	svc := &registry.Service{Name: name}
+	for _, ep := range *activeEp {
+		svc.Endpoints = append(svc.Endpoints, endpoint.Tars2endpoint(ep))
+	}
+	for _, ep := range *inactiveEp {
+		svc.Inactive = append(svc.Inactive, endpoint.Tars2endpoint(ep))
+	}
+	return svc, nil
+}
+
+func (r *tarsRegistry) Watch(name string) (registry.Watcher, error) {
+	return registry.NewPollWatcher(func() (*registry.Service, error) {
+		return r.GetService(name)
+	}, time.Duration(r.comm.Client.refreshEndpointInterval)*time.Millisecond), nil
+}
+
+func (r *tarsRegistry) String() string {
+	return "tars"
+}
+
+// newRegistry creates the registry from the config, like file@/path/to/endpoints.yaml,
+// dns@svc.local, consul@127.0.0.1:8500 or static@App.Server.Obj@tcp -h 127.0.0.1 -p 10015.
+// The etcd registry is set with the Registry option.
+func newRegistry(config string) registry.Registry {
+	if strings.HasPrefix(config, "consul@") {
+		return consul.NewRegistry(registry.Addrs(strings.TrimPrefix(config, "consul@")))
+	} else if strings.HasPrefix(config, "static@") {
+		services, err := static.Parse(strings.TrimPrefix(config, "static@"))
+		if err != nil {
+			TLOG.Error("parse static registry fail:", err)
+			return nil
+		}
+		return static.NewRegistry(static.Services(services...))
+	} else if strings.HasPrefix(config, "file@") {
+		return file.NewRegistry(file.Path(strings.TrimPrefix(config, "file@")))
+	} else if strings.HasPrefix(config, "dns@") {
+		return dns.NewRegistry(dns.Domain(strings.TrimPrefix(config, "dns@")))
+	}
+	TLOG.Error("unknown registry config:", config)
+	return nil
+}
+
+// adapterServices returns the services of the adapters of the server, except the admin adapter.
+func adapterServices() []*registry.Service {
+	var services []*registry.Service
+	for name, adapter := range svrCfg.Adapters {
+		if name == "AdminAdapter" || adapter.Protocol == "not_tars" {
+			continue
+		}
+		services = append(services, &registry.Service{
+			Name:      adapter.Obj,
+			Endpoints: []endpoint.Endpoint{adapter.Endpoint},
+		})
+	}
+	return services
+}
+
+func registerAdapters(r registry.Registry) {
+	for _, svc := range adapterServices() {
+		if err := r.Register(svc, registry.RegisterTTL(RegistryTTL)); err != nil {
+			TLOG.Errorf("register %s to %s error: %v", svc.Name, r.String(), err)
+		}
+	}
+}
+
+func deregisterAdapters(r registry.Registry) {
+	for _, svc := range adapterServices() {
+		if err := r.Deregister(svc); err != nil {
+			TLOG.Errorf("deregister %s from %s error: %v", svc.Name, r.String(), err)
+		}
+	}
+}
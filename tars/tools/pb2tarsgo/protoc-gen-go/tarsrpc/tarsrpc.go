@@ -48,7 +48,6 @@ const (
 	modelPkgPath   = "github.com/TarsCloud/TarsGo/tars/model"
 	requestPkgPath = "github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
 	tarsPkgPath    = "github.com/TarsCloud/TarsGo/tars"
-	toolsPath      = "github.com/TarsCloud/TarsGo/tars/util/tools"
 )
 
 func init() {
@@ -109,7 +108,6 @@ func (t *tarsrpc) Generate(file *generator.FileDescriptor) {
 	_ = t.gen.AddImport(modelPkgPath)
 	_ = t.gen.AddImport(requestPkgPath)
 	_ = t.gen.AddImport(tarsPkgPath)
-	_ = t.gen.AddImport(toolsPath)
 	_ = t.gen.AddImport("context")
 	for i, service := range file.FileDescriptorProto.Service {
 		t.generateService(file, service, i)
@@ -185,7 +183,7 @@ func (t *tarsrpc) generateClientCode(service *pb.ServiceDescriptorProto, method
 			if err != nil {
 				return output, err
 			}
-			if err = proto.Unmarshal(tools.Int8ToByte(resp.SBuffer), &output); err != nil{
+			if err = proto.Unmarshal(resp.SBuffer, &output); err != nil{
 				return output, err
 			}
 			return output, nil
@@ -196,7 +194,7 @@ func (t *tarsrpc) generateDispatch(service *pb.ServiceDescriptorProto) {
 	serviceName := upperFirstLatter(service.GetName())
 	t.P(fmt.Sprintf(`//Dispatch is used to call the user implement of the defined method.
 	func (obj *%s) Dispatch(ctx context.Context, val interface{}, req * requestf.RequestPacket, resp *requestf.ResponsePacket, withContext bool)(err error){
-		input := tools.Int8ToByte(req.SBuffer)
+		input := req.SBuffer
 		var output []byte
 		imp := val.(imp%s)
 		funcName := req.SFuncName
@@ -229,7 +227,7 @@ func (t *tarsrpc) generateDispatch(service *pb.ServiceDescriptorProto) {
 		IRequestId:   req.IRequestId,
 		IMessageType: 0,
 		IRet:         0,
-		SBuffer:      tools.ByteToInt8(output),
+		SBuffer:      output,
 		Status:       status,
 		SResultDesc:  "",
 		Context:      req.Context,
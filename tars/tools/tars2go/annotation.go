@@ -0,0 +1,126 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ValidateRule is a validation annotation of a struct member in its comments, one of
+//
+//	@range(min, max)   the bounds of a number
+//	@length(min, max)  the bounds of the length of a string in bytes, or of a vector or map
+//	@required          a string, vector or map must not be empty
+//
+// An empty bound is unbounded, like @range(0, ).
+type ValidateRule struct {
+	Name string
+	Min  string
+	Max  string
+}
+
+var annotationRe = regexp.MustCompile(`@(range|length|required)\b(?:\(([^)]*)\))?`)
+
+// hasLength checks if len() applies to the type.
+func hasLength(ty *VarType) bool {
+	return ty.Type == tkTString || ty.Type == tkTVector || ty.Type == tkTMap
+}
+
+// intBits returns the bits of the integer type, or 0 for the other types.
+func intBits(ty *VarType) int {
+	switch ty.Type {
+	case tkTByte:
+		return 8
+	case tkTShort:
+		return 16
+	case tkTInt:
+		return 32
+	case tkTLong:
+		return 64
+	}
+	return 0
+}
+
+// checkBound checks if the bound is a constant of the type, the lengths are of int.
+func checkBound(ty *VarType, name string, v string) bool {
+	if v == "" {
+		return true
+	}
+	if name == "length" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		return err == nil && n >= 0
+	}
+	if ty.Type == tkTFloat || ty.Type == tkTDouble {
+		_, err := strconv.ParseFloat(v, 64)
+		return err == nil
+	}
+	var err error
+	if ty.Unsigned {
+		_, err = strconv.ParseUint(v, 10, intBits(ty))
+	} else {
+		_, err = strconv.ParseInt(v, 10, intBits(ty))
+	}
+	return err == nil
+}
+
+// parseRules parses the annotations in the comment of the member.
+func (p *Parse) parseRules(st *StructInfo, m *StructMember, text string) {
+	where := st.TName + "::" + m.Key + " "
+	for _, match := range annotationRe.FindAllStringSubmatch(text, -1) {
+		rule := ValidateRule{Name: match[1]}
+		switch rule.Name {
+		case "required":
+			if strings.TrimSpace(match[2]) != "" {
+				p.parseErr(where + "@required takes no arguments")
+			}
+			if !hasLength(m.Type) {
+				p.parseErr(where + "@required is only for string, vector and map")
+			}
+		case "range", "length":
+			if rule.Name == "range" && (!isNumberType(m.Type.Type) || m.Type.Type == tkTBool) {
+				p.parseErr(where + "@range is only for numbers")
+			}
+			if rule.Name == "length" && !hasLength(m.Type) {
+				p.parseErr(where + "@length is only for string, vector and map")
+			}
+			bounds := strings.Split(match[2], ",")
+			if len(bounds) != 2 {
+				p.parseErr(where + "@" + rule.Name + " expects (min, max)")
+			}
+			rule.Min, rule.Max = strings.TrimSpace(bounds[0]), strings.TrimSpace(bounds[1])
+			if rule.Min == "" && rule.Max == "" {
+				p.parseErr(where + "@" + rule.Name + " expects a bound at least")
+			}
+			if !checkBound(m.Type, rule.Name, rule.Min) || !checkBound(m.Type, rule.Name, rule.Max) {
+				p.parseErr(where + "invalid bound of @" + rule.Name + "(" + match[2] + ")")
+			}
+			if rule.Min != "" && rule.Max != "" {
+				min, _ := strconv.ParseFloat(rule.Min, 64)
+				max, _ := strconv.ParseFloat(rule.Max, 64)
+				if min > max {
+					p.parseErr(where + "min > max of @" + rule.Name + "(" + match[2] + ")")
+				}
+			}
+		}
+		m.Rules = append(m.Rules, rule)
+	}
+}
+
+// parseAnnotations parses the annotations of the members in the comments, the comments of a member
+// are the ones after the line of the previous member or the struct till the end of its line.
+func (p *Parse) parseAnnotations(st *StructInfo, start int) {
+	prev := start
+	if len(st.Mb) > 0 && st.Mb[0].line == start {
+		// the first member follows the brace
+		prev--
+	}
+	for i := range st.Mb {
+		m := &st.Mb[i]
+		for _, c := range p.lex.comments {
+			if c.line > prev && c.line <= m.line {
+				p.parseRules(st, m, c.text)
+			}
+		}
+		prev = m.line
+	}
+}
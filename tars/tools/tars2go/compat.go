@@ -0,0 +1,339 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// change levels, errors break the callers or the data encoded with the old file.
+const (
+	levelError   = "error"
+	levelWarning = "warning"
+)
+
+// Change is an incompatible or suspicious change between two versions of a tars file.
+type Change struct {
+	Level   string `json:"level"`
+	Kind    string `json:"kind"`
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// CompatReport is the machine-readable result of the compatibility check.
+type CompatReport struct {
+	Old        string   `json:"old"`
+	New        string   `json:"new"`
+	Compatible bool     `json:"compatible"`
+	Changes    []Change `json:"changes"`
+}
+
+type compatChecker struct {
+	changes []Change
+}
+
+func (c *compatChecker) add(level, kind, path, format string, a ...interface{}) {
+	c.changes = append(c.changes, Change{Level: level, Kind: kind, Path: path, Message: fmt.Sprintf(format, a...)})
+}
+
+// typeString returns the type as it is written in the tars file.
+func typeString(ty *VarType) string {
+	if ty == nil {
+		return "void"
+	}
+	unsigned := ""
+	if ty.Unsigned {
+		unsigned = "unsigned "
+	}
+	switch ty.Type {
+	case tkTVector:
+		return "vector<" + typeString(ty.TypeK) + ">"
+	case tkTMap:
+		return "map<" + typeString(ty.TypeK) + ", " + typeString(ty.TypeV) + ">"
+	case tkName:
+		return ty.TypeSt
+	}
+	return unsigned + TokenMap[ty.Type]
+}
+
+// intRank orders the integer types, the decoder accepts the narrower ones for the wider.
+func intRank(ty *VarType) int {
+	switch ty.Type {
+	case tkTByte:
+		return 1
+	case tkTShort:
+		return 2
+	case tkTInt:
+		return 3
+	case tkTLong:
+		return 4
+	}
+	return 0
+}
+
+// widened returns whether the new type only widens the integers of the old one, the elements of
+// the vectors and the maps included. vector<byte> is encoded as bytes, so its element can not be widened.
+func widened(oldTy, newTy *VarType) bool {
+	if oldTy == nil || newTy == nil {
+		return false
+	}
+	if typeString(oldTy) == typeString(newTy) {
+		return true
+	}
+	switch {
+	case oldTy.Type == tkTVector && newTy.Type == tkTVector:
+		return oldTy.TypeK.Type != tkTByte && widened(oldTy.TypeK, newTy.TypeK)
+	case oldTy.Type == tkTMap && newTy.Type == tkTMap:
+		return widened(oldTy.TypeK, newTy.TypeK) && widened(oldTy.TypeV, newTy.TypeV)
+	}
+	return intRank(oldTy) > 0 && intRank(newTy) > intRank(oldTy)
+}
+
+func (c *compatChecker) checkType(path string, oldTy, newTy *VarType) {
+	o, n := typeString(oldTy), typeString(newTy)
+	if o == n {
+		return
+	}
+	if widened(oldTy, newTy) {
+		c.add(levelWarning, "type-widened", path,
+			"type widened from %s to %s, the old decoders fail on the values out of range", o, n)
+		return
+	}
+	c.add(levelError, "type-changed", path, "type changed from %s to %s", o, n)
+}
+
+func (c *compatChecker) checkStruct(path string, oldSt, newSt *StructInfo) {
+	newByTag := make(map[int32]*StructMember)
+	newByName := make(map[string]*StructMember)
+	for i := range newSt.Mb {
+		newByTag[newSt.Mb[i].Tag] = &newSt.Mb[i]
+		newByName[newSt.Mb[i].Key] = &newSt.Mb[i]
+	}
+	oldByTag := make(map[int32]*StructMember)
+	for i := range oldSt.Mb {
+		o := &oldSt.Mb[i]
+		oldByTag[o.Tag] = o
+		mbPath := path + "." + o.Key
+		if moved, ok := newByName[o.Key]; ok && moved.Tag != o.Tag {
+			c.add(levelError, "tag-changed", mbPath, "tag changed from %d to %d", o.Tag, moved.Tag)
+		}
+		n, ok := newByTag[o.Tag]
+		if !ok {
+			if o.Require {
+				c.add(levelError, "field-removed", mbPath, "required field with tag %d removed", o.Tag)
+			} else {
+				c.add(levelWarning, "field-removed", mbPath, "optional field with tag %d removed, the tag must not be reused", o.Tag)
+			}
+			continue
+		}
+		if n.Key != o.Key {
+			if _, ok := newByName[o.Key]; ok {
+				c.add(levelError, "tag-reused", mbPath, "tag %d reused by %s", o.Tag, n.Key)
+			} else {
+				c.add(levelWarning, "field-renamed", mbPath, "field with tag %d renamed to %s, the JSON names change", o.Tag, n.Key)
+			}
+		}
+		c.checkType(mbPath, o.Type, n.Type)
+		if !o.Require && n.Require {
+			c.add(levelError, "field-required", mbPath, "optional field with tag %d turned required", o.Tag)
+		} else if o.Require && !n.Require {
+			c.add(levelWarning, "field-optional", mbPath, "required field with tag %d turned optional", o.Tag)
+		}
+	}
+	for i := range newSt.Mb {
+		n := &newSt.Mb[i]
+		if _, ok := oldByTag[n.Tag]; !ok && n.Require {
+			c.add(levelError, "field-added", path+"."+n.Key, "required field with tag %d added", n.Tag)
+		}
+	}
+}
+
+func (c *compatChecker) checkFun(path string, oldFun, newFun *FunInfo) {
+	c.checkType(path+".return", oldFun.RetType, newFun.RetType)
+	for i, o := range oldFun.Args {
+		argPath := path + "." + o.Name
+		if j, ok := argIndex(newFun, o.Name); ok && j != i {
+			c.add(levelError, "parameter-moved", argPath, "parameter moved from position %d to %d", i+1, j+1)
+			continue
+		}
+		if i >= len(newFun.Args) {
+			c.add(levelError, "parameter-removed", argPath, "parameter at position %d removed", i+1)
+			continue
+		}
+		n := newFun.Args[i]
+		if n.Name != o.Name {
+			c.add(levelError, "parameter-renamed", argPath,
+				"parameter at position %d renamed to %s, the TUP and JSON callers pass it by name", i+1, n.Name)
+		}
+		if n.IsOut != o.IsOut {
+			c.add(levelError, "parameter-direction", argPath, "parameter changed from %s to %s", direction(o), direction(n))
+		}
+		c.checkType(argPath, o.Type, n.Type)
+	}
+	for i := len(oldFun.Args); i < len(newFun.Args); i++ {
+		n := newFun.Args[i]
+		if _, ok := argIndex(oldFun, n.Name); !ok {
+			c.add(levelError, "parameter-added", path+"."+n.Name, "parameter added at position %d", i+1)
+		}
+	}
+}
+
+func argIndex(fun *FunInfo, name string) (int, bool) {
+	for i, a := range fun.Args {
+		if a.Name == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func direction(a ArgInfo) string {
+	if a.IsOut {
+		return "out"
+	}
+	return "in"
+}
+
+func (c *compatChecker) checkInterface(path string, oldItf, newItf *InterfaceInfo) {
+	funs := make(map[string]*FunInfo)
+	for i := range newItf.Fun {
+		funs[newItf.Fun[i].Name] = &newItf.Fun[i]
+	}
+	for i := range oldItf.Fun {
+		o := &oldItf.Fun[i]
+		n, ok := funs[o.Name]
+		if !ok {
+			c.add(levelError, "method-removed", path+"."+o.Name, "method removed")
+			continue
+		}
+		c.checkFun(path+"."+o.Name, o, n)
+	}
+}
+
+func (c *compatChecker) checkEnum(path string, oldEnum, newEnum *EnumInfo) {
+	values := make(map[string]int32)
+	for _, m := range newEnum.Mb {
+		values[m.Key] = m.Value
+	}
+	for _, m := range oldEnum.Mb {
+		v, ok := values[m.Key]
+		if !ok {
+			c.add(levelError, "enum-removed", path+"."+m.Key, "enum member removed")
+		} else if v != m.Value {
+			c.add(levelError, "enum-changed", path+"."+m.Key, "value changed from %d to %d", m.Value, v)
+		}
+	}
+}
+
+func (c *compatChecker) check(oldP, newP *Parse) {
+	if oldP.Module != newP.Module {
+		c.add(levelError, "module-renamed", oldP.Module, "module renamed to %s", newP.Module)
+	}
+	prefix := oldP.Module + "::"
+
+	structs := make(map[string]*StructInfo)
+	for i := range newP.Struct {
+		structs[newP.Struct[i].TName] = &newP.Struct[i]
+	}
+	for i := range oldP.Struct {
+		o := &oldP.Struct[i]
+		if n, ok := structs[o.TName]; ok {
+			c.checkStruct(prefix+o.TName, o, n)
+		} else {
+			c.add(levelError, "struct-removed", prefix+o.TName, "struct removed")
+		}
+	}
+
+	itfs := make(map[string]*InterfaceInfo)
+	for i := range newP.Interface {
+		itfs[newP.Interface[i].TName] = &newP.Interface[i]
+	}
+	for i := range oldP.Interface {
+		o := &oldP.Interface[i]
+		if n, ok := itfs[o.TName]; ok {
+			c.checkInterface(prefix+o.TName, o, n)
+		} else {
+			c.add(levelError, "interface-removed", prefix+o.TName, "interface removed")
+		}
+	}
+
+	enums := make(map[string]*EnumInfo)
+	for i := range newP.Enum {
+		enums[newP.Enum[i].TName] = &newP.Enum[i]
+	}
+	for i := range oldP.Enum {
+		o := &oldP.Enum[i]
+		if n, ok := enums[o.TName]; ok {
+			c.checkEnum(prefix+o.TName, o, n)
+		} else {
+			c.add(levelError, "enum-removed", prefix+o.TName, "enum removed")
+		}
+	}
+
+	consts := make(map[string]*ConstInfo)
+	for i := range newP.Const {
+		consts[newP.Const[i].Key] = &newP.Const[i]
+	}
+	for i := range oldP.Const {
+		o := &oldP.Const[i]
+		n, ok := consts[o.Key]
+		if !ok {
+			c.add(levelError, "const-removed", prefix+o.Key, "const removed")
+			continue
+		}
+		c.checkType(prefix+o.Key, o.Type, n.Type)
+		if n.Value != o.Value {
+			c.add(levelWarning, "const-changed", prefix+o.Key, "value changed from %s to %s", strconv.Quote(o.Value), strconv.Quote(n.Value))
+		}
+	}
+}
+
+// parseForCompat parses the file, returns the error instead of exiting.
+func parseForCompat(path string) (p *Parse, err error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return ParseFile(path), nil
+}
+
+// checkCompat reports the changes of the new tars file which break the callers built with
+// or the data encoded by the old one, it returns the exit code: 0 for compatible,
+// 1 for incompatible and 2 for failing to parse.
+func checkCompat(oldPath, newPath string, w io.Writer) int {
+	oldP, err := parseForCompat(oldPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	newP, err := parseForCompat(newPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	c := &compatChecker{}
+	c.check(oldP, newP)
+
+	report := CompatReport{Old: oldPath, New: newPath, Compatible: true, Changes: c.changes}
+	if report.Changes == nil {
+		report.Changes = []Change{}
+	}
+	for _, ch := range report.Changes {
+		if ch.Level == levelError {
+			report.Compatible = false
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(report)
+	if !report.Compatible {
+		return 1
+	}
+	return 0
+}
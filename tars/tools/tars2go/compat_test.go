@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeTars writes the tars file of the module with the body into the dir.
+func writeTars(t *testing.T, dir, name, body string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte("module App\n{\n"+body+"\n};\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCheckCompat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name     string
+		old, new string
+		code     int
+		changes  []Change
+	}{
+		{
+			name: "same",
+			old:  "struct A { 0 require int x; };",
+			new:  "struct A { 0 require int x; };",
+		},
+		{
+			name: "optional field added",
+			old:  "struct A { 0 require int x; };",
+			new:  "struct A { 0 require int x; 1 optional string s; };",
+		},
+		{
+			name:    "required field added",
+			old:     "struct A { 0 require int x; };",
+			new:     "struct A { 0 require int x; 1 require string s; };",
+			code:    1,
+			changes: []Change{{Level: levelError, Kind: "field-added", Path: "App::A.s"}},
+		},
+		{
+			name:    "int widened",
+			old:     "struct A { 0 require int x; };",
+			new:     "struct A { 0 require long x; };",
+			changes: []Change{{Level: levelWarning, Kind: "type-widened", Path: "App::A.x"}},
+		},
+		{
+			name:    "int narrowed",
+			old:     "struct A { 0 require long x; };",
+			new:     "struct A { 0 require int x; };",
+			code:    1,
+			changes: []Change{{Level: levelError, Kind: "type-changed", Path: "App::A.x"}},
+		},
+		{
+			name:    "vector element widened",
+			old:     "struct A { 0 optional vector<int> v; };",
+			new:     "struct A { 0 optional vector<long> v; };",
+			changes: []Change{{Level: levelWarning, Kind: "type-widened", Path: "App::A.v"}},
+		},
+		{
+			name:    "vector of bytes widened",
+			old:     "struct A { 0 optional vector<byte> v; };",
+			new:     "struct A { 0 optional vector<short> v; };",
+			code:    1,
+			changes: []Change{{Level: levelError, Kind: "type-changed", Path: "App::A.v"}},
+		},
+		{
+			name:    "map value widened",
+			old:     "struct A { 0 optional map<string, short> m; };",
+			new:     "struct A { 0 optional map<string, int> m; };",
+			changes: []Change{{Level: levelWarning, Kind: "type-widened", Path: "App::A.m"}},
+		},
+		{
+			name:    "map value changed",
+			old:     "struct A { 0 optional map<string, int> m; };",
+			new:     "struct A { 0 optional map<string, string> m; };",
+			code:    1,
+			changes: []Change{{Level: levelError, Kind: "type-changed", Path: "App::A.m"}},
+		},
+		{
+			name: "tag reused",
+			old:  "struct A { 0 require int x; 1 optional int y; };",
+			new:  "struct A { 0 require int x; 1 optional int z; 2 optional int y; };",
+			code: 1,
+			changes: []Change{
+				{Level: levelError, Kind: "tag-changed", Path: "App::A.y"},
+				{Level: levelError, Kind: "tag-reused", Path: "App::A.y"},
+			},
+		},
+		{
+			name:    "optional field removed",
+			old:     "struct A { 0 require int x; 1 optional int y; };",
+			new:     "struct A { 0 require int x; };",
+			changes: []Change{{Level: levelWarning, Kind: "field-removed", Path: "App::A.y"}},
+		},
+		{
+			name:    "method removed",
+			old:     "interface I { int f(int a); int g(); };",
+			new:     "interface I { int f(int a); };",
+			code:    1,
+			changes: []Change{{Level: levelError, Kind: "method-removed", Path: "App::I.g"}},
+		},
+		{
+			name:    "parameter widened",
+			old:     "interface I { int f(int a, out vector<int> b); };",
+			new:     "interface I { int f(long a, out vector<long> b); };",
+			changes: []Change{{Level: levelWarning, Kind: "type-widened", Path: "App::I.f.a"}, {Level: levelWarning, Kind: "type-widened", Path: "App::I.f.b"}},
+		},
+		{
+			name:    "parameter renamed",
+			old:     "interface I { int f(int a); };",
+			new:     "interface I { int f(int b); };",
+			code:    1,
+			changes: []Change{{Level: levelError, Kind: "parameter-renamed", Path: "App::I.f.a"}},
+		},
+		{
+			name:    "enum value changed",
+			old:     "enum E { A = 1, B = 2 };",
+			new:     "enum E { A = 1, B = 3 };",
+			code:    1,
+			changes: []Change{{Level: levelError, Kind: "enum-changed", Path: "App::E.B"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldPath := writeTars(t, dir, "old.tars", tt.old)
+			newPath := writeTars(t, dir, "new.tars", tt.new)
+			var out bytes.Buffer
+			if code := checkCompat(oldPath, newPath, &out); code != tt.code {
+				t.Errorf("expected exit code %d, got %d: %s", tt.code, code, out.String())
+			}
+			var report CompatReport
+			if err := json.Unmarshal(out.Bytes(), &report); err != nil {
+				t.Fatal(err)
+			}
+			if report.Compatible != (tt.code == 0) {
+				t.Errorf("expected compatible %v", tt.code == 0)
+			}
+			if len(report.Changes) != len(tt.changes) {
+				t.Fatalf("expected %d changes, got %+v", len(tt.changes), report.Changes)
+			}
+			for i, ch := range report.Changes {
+				exp := tt.changes[i]
+				if ch.Level != exp.Level || ch.Kind != exp.Kind || ch.Path != exp.Path {
+					t.Errorf("expected change %+v, got %+v", exp, ch)
+				}
+			}
+		})
+	}
+}
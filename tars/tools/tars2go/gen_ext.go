@@ -0,0 +1,295 @@
+package main
+
+import (
+	"flag"
+	"strconv"
+	"strings"
+)
+
+var gJSON = flag.Bool("json", false, "Generate MarshalJSON and UnmarshalJSON for the structs and enums, and String for the enums")
+var gCopy = flag.Bool("copy", false, "Generate the deep Copy and Equal for the structs")
+var gValidate = flag.Bool("validate", false, "Generate Validate for the structs by the @range, @length and @required annotations in the comments")
+
+// isValue checks if the type is copied and compared as a value.
+func isValue(ty *VarType) bool {
+	switch ty.Type {
+	case tkTVector, tkTMap:
+		return false
+	case tkName:
+		return ty.CType == tkEnum
+	}
+	return true
+}
+
+// hasStruct checks if the type is or contains a struct.
+func hasStruct(ty *VarType) bool {
+	switch ty.Type {
+	case tkTVector:
+		return hasStruct(ty.TypeK)
+	case tkTMap:
+		return hasStruct(ty.TypeV)
+	case tkName:
+		return ty.CType == tkStruct
+	}
+	return false
+}
+
+func (gen *GenGo) nextVc() string {
+	vc := strconv.Itoa(gen.vc)
+	gen.vc++
+	return vc
+}
+
+func (gen *GenGo) genFunJSON(st *StructInfo) {
+	c := &gen.code
+	c.WriteString(`//MarshalJSON encodes the struct as JSON, the nil vectors and maps as empty ones.
+func (st ` + st.TName + `) MarshalJSON() ([]byte, error) {
+	type alias ` + st.TName + "\n")
+	for _, v := range st.Mb {
+		if v.Type.Type == tkTVector || v.Type.Type == tkTMap {
+			c.WriteString("if st." + v.Key + " == nil {\n")
+			c.WriteString("st." + v.Key + " = make(" + gen.genType(v.Type) + ", 0)\n}\n")
+		}
+	}
+	c.WriteString(`return json.Marshal((*alias)(&st))
+}
+
+//UnmarshalJSON decodes the struct from JSON, the members missing get the default values in the tars file.
+func (st *` + st.TName + `) UnmarshalJSON(data []byte) error {
+	type alias ` + st.TName + `
+	if string(data) == "null" {
+		return nil
+	}
+	var v ` + st.TName + `
+	v.resetDefault()
+	if err := json.Unmarshal(data, (*alias)(&v)); err != nil {
+		return err
+	}
+	*st = v
+	return nil
+}
+`)
+}
+
+func (gen *GenGo) genCopyValue(dst string, src string, ty *VarType) {
+	c := &gen.code
+	switch ty.Type {
+	case tkTVector:
+		c.WriteString("if " + src + " != nil {\n")
+		c.WriteString(dst + " = make(" + gen.genType(ty) + ", len(" + src + "))\n")
+		if isValue(ty.TypeK) {
+			c.WriteString("copy(" + dst + ", " + src + ")\n")
+		} else {
+			i := "i" + gen.nextVc()
+			c.WriteString("for " + i + " := range " + src + " {\n")
+			gen.genCopyValue(dst+"["+i+"]", src+"["+i+"]", ty.TypeK)
+			c.WriteString("}\n")
+		}
+		c.WriteString("}\n")
+	case tkTMap:
+		vc := gen.nextVc()
+		k, v := "k"+vc, "v"+vc
+		c.WriteString("if " + src + " != nil {\n")
+		c.WriteString(dst + " = make(" + gen.genType(ty) + ", len(" + src + "))\n")
+		c.WriteString("for " + k + ", " + v + " := range " + src + " {\n")
+		if isValue(ty.TypeV) {
+			c.WriteString(dst + "[" + k + "] = " + v + "\n")
+		} else {
+			e := "e" + vc
+			c.WriteString("var " + e + " " + gen.genType(ty.TypeV) + "\n")
+			gen.genCopyValue(e, v, ty.TypeV)
+			c.WriteString(dst + "[" + k + "] = " + e + "\n")
+		}
+		c.WriteString("}\n}\n")
+	case tkName:
+		if ty.CType == tkStruct {
+			c.WriteString(dst + " = *" + src + ".Copy()\n")
+		} else {
+			c.WriteString(dst + " = " + src + "\n")
+		}
+	default:
+		c.WriteString(dst + " = " + src + "\n")
+	}
+}
+
+func (gen *GenGo) genEqualValue(a string, b string, ty *VarType) {
+	c := &gen.code
+	switch ty.Type {
+	case tkTVector:
+		i := "i" + gen.nextVc()
+		c.WriteString("if len(" + a + ") != len(" + b + ") {\nreturn false\n}\n")
+		c.WriteString("for " + i + " := range " + a + " {\n")
+		gen.genEqualValue(a+"["+i+"]", b+"["+i+"]", ty.TypeK)
+		c.WriteString("}\n")
+	case tkTMap:
+		vc := gen.nextVc()
+		k, va, vb := "k"+vc, "va"+vc, "vb"+vc
+		c.WriteString("if len(" + a + ") != len(" + b + ") {\nreturn false\n}\n")
+		c.WriteString("for " + k + ", " + va + " := range " + a + " {\n")
+		c.WriteString(vb + ", ok := " + b + "[" + k + "]\n")
+		c.WriteString("if !ok {\nreturn false\n}\n")
+		gen.genEqualValue(va, vb, ty.TypeV)
+		c.WriteString("}\n")
+	case tkName:
+		if ty.CType == tkStruct {
+			c.WriteString("if !" + a + ".Equal(&" + b + ") {\nreturn false\n}\n")
+			return
+		}
+		fallthrough
+	default:
+		c.WriteString("if " + a + " != " + b + " {\nreturn false\n}\n")
+	}
+}
+
+func (gen *GenGo) genFunCopy(st *StructInfo) {
+	c := &gen.code
+	c.WriteString(`//Copy returns a deep copy of the struct.
+func (st *` + st.TName + `) Copy() *` + st.TName + ` {
+	if st == nil {
+		return nil
+	}
+	c := *st
+`)
+	for _, v := range st.Mb {
+		if !isValue(v.Type) {
+			gen.genCopyValue("c."+v.Key, "st."+v.Key, v.Type)
+		}
+	}
+	c.WriteString(`return &c
+}
+
+//Equal checks if the struct equals to the other, the nil vectors and maps equal to the empty ones.
+func (st *` + st.TName + `) Equal(other *` + st.TName + `) bool {
+	if st == nil || other == nil {
+		return st == other
+	}
+`)
+	for _, v := range st.Mb {
+		gen.genEqualValue("st."+v.Key, "other."+v.Key, v.Type)
+	}
+	c.WriteString("return true\n}\n")
+}
+
+// genValidateValue validates the structs in the value, path is the format of the value in the errors.
+func (gen *GenGo) genValidateValue(value string, ty *VarType, path string, args []string) {
+	c := &gen.code
+	switch ty.Type {
+	case tkTVector:
+		i := "i" + gen.nextVc()
+		c.WriteString("for " + i + " := range " + value + " {\n")
+		gen.genValidateValue(value+"["+i+"]", ty.TypeK, path+"[%d]", append(args, i))
+		c.WriteString("}\n")
+	case tkTMap:
+		vc := gen.nextVc()
+		k, v := "k"+vc, "v"+vc
+		c.WriteString("for " + k + ", " + v + " := range " + value + " {\n")
+		gen.genValidateValue(v, ty.TypeV, path+"[%v]", append(args, k))
+		c.WriteString("}\n")
+	case tkName:
+		c.WriteString("if err := " + value + ".Validate(); err != nil {\n")
+		c.WriteString("return fmt.Errorf(\"" + path + ": %v\", " + strings.Join(append(args, "err"), ", ") + ")\n}\n")
+	}
+}
+
+func (gen *GenGo) genRule(v *StructMember, path string, rule *ValidateRule) {
+	c := &gen.code
+	value := "st." + v.Key
+	what := ""
+	switch rule.Name {
+	case "required":
+		c.WriteString("if len(" + value + ") == 0 {\n")
+		c.WriteString("return fmt.Errorf(\"" + path + " is required\")\n}\n")
+		return
+	case "length":
+		value = "len(" + value + ")"
+		what = "length "
+	}
+	// the lengths and the unsigned numbers are never less than 0
+	if rule.Min != "" && !(rule.Min == "0" && (rule.Name == "length" || v.Type.Unsigned)) {
+		c.WriteString("if " + value + " < " + rule.Min + " {\n")
+		c.WriteString("return fmt.Errorf(\"" + path + ": " + what + "%v is less than " + rule.Min + "\", " + value + ")\n}\n")
+	}
+	if rule.Max != "" {
+		c.WriteString("if " + value + " > " + rule.Max + " {\n")
+		c.WriteString("return fmt.Errorf(\"" + path + ": " + what + "%v is greater than " + rule.Max + "\", " + value + ")\n}\n")
+	}
+}
+
+func (gen *GenGo) genFunValidate(st *StructInfo) {
+	c := &gen.code
+	c.WriteString(`//Validate checks the members by the annotations in the tars file.
+func (st *` + st.TName + `) Validate() error {
+`)
+	for i := range st.Mb {
+		v := &st.Mb[i]
+		path := st.TName + "." + v.KeyStr
+		for j := range v.Rules {
+			gen.genRule(v, path, &v.Rules[j])
+		}
+		if hasStruct(v.Type) {
+			gen.genValidateValue("st."+v.Key, v.Type, path, nil)
+		}
+	}
+	c.WriteString("return nil\n}\n")
+}
+
+func (gen *GenGo) genEnumJSON(en *EnumInfo) {
+	c := &gen.code
+	seen := make(map[int32]bool)
+	var names []string
+	c.WriteString(`//String returns the name of the enum, or the number if it is not defined.
+func (en ` + en.TName + `) String() string {
+	switch en {
+`)
+	for _, v := range en.Mb {
+		// the aliases of a value share the first name
+		if seen[v.Value] {
+			continue
+		}
+		seen[v.Value] = true
+		names = append(names, gen.makeEnumName(en, &v))
+		c.WriteString("case " + gen.makeEnumName(en, &v) + ":\nreturn \"" + v.KeyStr + "\"\n")
+	}
+	c.WriteString(`}
+	return strconv.Itoa(int(en))
+}
+
+//MarshalJSON encodes the enum as the name, or the number if it is not defined.
+func (en ` + en.TName + `) MarshalJSON() ([]byte, error) {
+	switch en {
+	case ` + strings.Join(names, ", ") + `:
+		return []byte(` + "`\"`" + ` + en.String() + ` + "`\"`" + `), nil
+	}
+	return []byte(strconv.Itoa(int(en))), nil
+}
+
+//UnmarshalJSON decodes the enum from the name or the number.
+func (en *` + en.TName + `) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var v int32
+		if err := json.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("invalid ` + en.TName + ` %s", data)
+		}
+		*en = ` + en.TName + `(v)
+		return nil
+	}
+	switch name {
+`)
+	for _, v := range en.Mb {
+		c.WriteString("case \"" + v.KeyStr + "\":\n*en = " + gen.makeEnumName(en, &v) + "\n")
+	}
+	c.WriteString(`default:
+		v, err := strconv.ParseInt(name, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid ` + en.TName + ` %q", name)
+		}
+		*en = ` + en.TName + `(v)
+	}
+	return nil
+}
+`)
+}
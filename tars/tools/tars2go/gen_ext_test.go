@@ -0,0 +1,187 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+const extTars = `
+enum Color { RED = 1, GREEN = 2 };
+
+struct Inner
+{
+    0 optional int v = 7;                // @range(, 10)
+};
+
+struct Rec
+{
+    0 require int age = 18;              // @range(0, 150)
+    1 optional string name = "anon";     // @required @length(, 8)
+    2 optional vector<int> ids;          // @length(1, 3)
+    3 optional map<string, Inner> m;
+    4 optional Color color = GREEN;
+    5 optional Inner in;
+    6 optional vector<byte> raw;
+    7 optional double score;             // @range(0.5, )
+    8 optional vector<Inner> list;
+};`
+
+const extTest = `package App
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func valid() Rec {
+	return Rec{Age: 20, Name: "a", Ids: []int32{1}, M: map[string]Inner{"k": {V: 1}}, Color: Color_RED,
+		In: Inner{V: 2}, Raw: []int8{1}, Score: 1, List: []Inner{{V: 3}}}
+}
+
+func TestJSONDefaults(t *testing.T) {
+	var r Rec
+	if err := json.Unmarshal([]byte("{\"age\": 20, \"in\": {}}"), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Age != 20 || r.Name != "anon" || r.Color != Color_GREEN || r.In.V != 7 || r.Score != 0 {
+		t.Errorf("the missing members not set to the defaults: %+v", r)
+	}
+
+	r = valid()
+	if err := json.Unmarshal([]byte("null"), &r); err != nil || !r.Equal(&Rec{Age: 20, Name: "a", Ids: []int32{1},
+		M: map[string]Inner{"k": {V: 1}}, Color: Color_RED, In: Inner{V: 2}, Raw: []int8{1}, Score: 1, List: []Inner{{V: 3}}}) {
+		t.Errorf("null changes the struct: %+v %v", r, err)
+	}
+}
+
+func TestJSONNil(t *testing.T) {
+	data, err := json.Marshal(Rec{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"\"ids\":[]", "\"m\":{}", "\"raw\":[]", "\"list\":[]"} {
+		if !strings.Contains(string(data), s) {
+			t.Errorf("expected %s in %s", s, data)
+		}
+	}
+	if strings.Contains(string(data), "null") {
+		t.Errorf("nil encoded as null in %s", data)
+	}
+
+	r := valid()
+	data, err = json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back Rec
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatal(err)
+	}
+	if !back.Equal(&r) {
+		t.Errorf("expected %+v, got %+v from %s", r, back, data)
+	}
+}
+
+func TestEnumJSON(t *testing.T) {
+	tests := []struct {
+		color Color
+		json  string
+	}{
+		{Color_RED, "\"RED\""},
+		{Color_GREEN, "\"GREEN\""},
+		{Color(5), "5"},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.color)
+		if err != nil || string(data) != tt.json {
+			t.Errorf("marshal %d: expected %s, got %s %v", tt.color, tt.json, data, err)
+		}
+		var c Color
+		if err := json.Unmarshal(data, &c); err != nil || c != tt.color {
+			t.Errorf("unmarshal %s: expected %d, got %d %v", data, tt.color, c, err)
+		}
+	}
+	for _, data := range []string{"2", "\"2\""} {
+		var c Color
+		if err := json.Unmarshal([]byte(data), &c); err != nil || c != Color_GREEN {
+			t.Errorf("unmarshal %s: expected GREEN, got %d %v", data, c, err)
+		}
+	}
+	for _, data := range []string{"\"BLUE\"", "true"} {
+		var c Color
+		if err := json.Unmarshal([]byte(data), &c); err == nil {
+			t.Errorf("unmarshal %s: expected error, got %d", data, c)
+		}
+	}
+	if s := Color(Color_GREEN).String(); s != "GREEN" {
+		t.Errorf("expected GREEN, got %s", s)
+	}
+}
+
+func TestCopyEqual(t *testing.T) {
+	r := valid()
+	c := r.Copy()
+	if !c.Equal(&r) || !r.Equal(c) {
+		t.Fatalf("copy not equal: %+v", c)
+	}
+	c.Ids[0] = 9
+	c.M["k"] = Inner{V: 9}
+	c.Raw[0] = 9
+	c.List[0].V = 9
+	c.In.V = 9
+	if r.Ids[0] != 1 || r.M["k"].V != 1 || r.Raw[0] != 1 || r.List[0].V != 3 || r.In.V != 2 {
+		t.Fatalf("copy shares the memory: %+v", r)
+	}
+	if c.Equal(&r) {
+		t.Fatal("changed copy still equal")
+	}
+
+	// the nil vectors and maps equal to the empty ones
+	a := Rec{}
+	b := Rec{Ids: []int32{}, M: map[string]Inner{}, Raw: []int8{}, List: []Inner{}}
+	if !a.Equal(&b) {
+		t.Error("nil not equal to empty")
+	}
+	var null *Rec
+	if !null.Equal(nil) || null.Equal(&a) || a.Equal(nil) || null.Copy() != nil {
+		t.Error("nil struct compared or copied wrongly")
+	}
+	if a.Copy().Ids != nil {
+		t.Error("copy of nil vector not nil")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		change func(r *Rec)
+		err    string
+	}{
+		{func(r *Rec) {}, ""},
+		{func(r *Rec) { r.Age = -1 }, "Rec.age: -1 is less than 0"},
+		{func(r *Rec) { r.Age = 151 }, "Rec.age: 151 is greater than 150"},
+		{func(r *Rec) { r.Name = "" }, "Rec.name is required"},
+		{func(r *Rec) { r.Name = "123456789" }, "Rec.name: length 9 is greater than 8"},
+		{func(r *Rec) { r.Ids = nil }, "Rec.ids: length 0 is less than 1"},
+		{func(r *Rec) { r.Ids = []int32{1, 2, 3, 4} }, "Rec.ids: length 4 is greater than 3"},
+		{func(r *Rec) { r.M["k"] = Inner{V: 11} }, "Rec.m[k]: Inner.v: 11 is greater than 10"},
+		{func(r *Rec) { r.In.V = 11 }, "Rec.in: Inner.v: 11 is greater than 10"},
+		{func(r *Rec) { r.List = []Inner{{V: 1}, {V: 11}} }, "Rec.list[1]: Inner.v: 11 is greater than 10"},
+		{func(r *Rec) { r.Score = 0.25 }, "Rec.score: 0.25 is less than 0.5"},
+	}
+	for _, tt := range tests {
+		r := valid()
+		tt.change(&r)
+		err := r.Validate()
+		if tt.err == "" && err != nil || tt.err != "" && (err == nil || err.Error() != tt.err) {
+			t.Errorf("expected %q, got %v", tt.err, err)
+		}
+	}
+}
+`
+
+func TestGenExt(t *testing.T) {
+	dir := genPackage(t, extTars, gJSON, gCopy, gValidate)
+	defer os.RemoveAll(dir)
+	goTest(t, dir, extTest)
+}
@@ -14,6 +14,8 @@ import (
 
 var gE = flag.Bool("E", false, "Generate code before fmt for troubleshooting")
 var gAddServant = flag.Bool("add-servant", true, "Generate AddServant function")
+var gBytes = flag.Bool("bytes", false, "Generate vector<byte> as []byte, which shares the memory of the decoded data")
+var gContextFirst = flag.Bool("context-first", false, "Generate the proxy functions taking the context first and the call options last")
 
 //GenGo record go code information.
 type GenGo struct {
@@ -67,6 +69,7 @@ func (itf *InterfaceInfo) rename() {
 func (en *EnumInfo) rename() {
 	en.TName = upperFirstLatter(en.TName)
 	for i := range en.Mb {
+		en.Mb[i].KeyStr = en.Mb[i].Key
 		en.Mb[i].Key = upperFirstLatter(en.Mb[i].Key)
 	}
 }
@@ -82,6 +85,7 @@ func (fun *FunInfo) rename() {
 	fun.NameStr = fun.Name
 	fun.Name = upperFirstLatter(fun.Name)
 	for i := range fun.Args {
+		fun.Args[i].NameStr = fun.Args[i].Name
 		fun.Args[i].Name = upperFirstLatter(fun.Args[i].Name)
 	}
 }
@@ -155,6 +159,9 @@ func (gen *GenGo) genStructPackage(st *StructInfo) {
 import (
 "fmt"
 `)
+	if *gJSON {
+		gen.code.WriteString("\"encoding/json\"\n")
+	}
 	//"tars/protocol/codec"
 	gen.code.WriteString("\"" + gen.tarsPath + "/protocol/codec\"\n")
 	for k := range st.DependModule {
@@ -170,11 +177,13 @@ func (gen *GenGo) genIFPackage(itf *InterfaceInfo) {
 import (
 "fmt"
 "context"
+"encoding/json"
 `)
 	gen.code.WriteString("\"" + gen.tarsPath + "/protocol/res/requestf\"\n")
+	gen.code.WriteString("\"" + gen.tarsPath + "/protocol/res/basef\"\n")
 	gen.code.WriteString("m \"" + gen.tarsPath + "/model\"\n")
 	gen.code.WriteString("\"" + gen.tarsPath + "/protocol/codec\"\n")
-	gen.code.WriteString("\"" + gen.tarsPath + "/util/tools\"\n")
+	gen.code.WriteString("\"" + gen.tarsPath + "/protocol/tup\"\n")
 	gen.code.WriteString("\"" + gen.tarsPath + "/util/current\"\n")
 
 	if *gAddServant {
@@ -223,7 +232,11 @@ func (gen *GenGo) genType(ty *VarType) string {
 	case tkTString:
 		ret = "string"
 	case tkTVector:
-		ret = "[]" + gen.genType(ty.TypeK)
+		if isBytes(ty) {
+			ret = "[]byte"
+		} else {
+			ret = "[]" + gen.genType(ty.TypeK)
+		}
 	case tkTMap:
 		ret = "map[" + gen.genType(ty.TypeK) + "]" + gen.genType(ty.TypeV)
 	case tkName:
@@ -271,6 +284,9 @@ func (gen *GenGo) genFunResetDefault(st *StructInfo) {
 	c.WriteString("}\n")
 }
 
+// jsonRetKey is the name of the return value in the JSON object of the response.
+const jsonRetKey = `"tars_ret"`
+
 func errString(hasRet bool) string {
 	var retStr string
 	if hasRet {
@@ -283,11 +299,16 @@ func errString(hasRet bool) string {
   }`
 }
 
+// isBytes checks if the type is vector<byte> generated as []byte.
+func isBytes(ty *VarType) bool {
+	return *gBytes && ty.Type == tkTVector && ty.TypeK.Type == tkTByte && !ty.TypeK.Unsigned
+}
+
 func (gen *GenGo) genWriteSimpleList(mb *StructMember, prefix string, hasRet bool) {
 	c := &gen.code
 	tag := strconv.Itoa(int(mb.Tag))
 	unsign := ""
-	if mb.Type.TypeK.Unsigned {
+	if mb.Type.TypeK.Unsigned || isBytes(mb.Type) {
 		unsign = "u"
 	}
 	errStr := errString(hasRet)
@@ -445,13 +466,17 @@ func (gen *GenGo) genReadSimpleList(mb *StructMember, prefix string, hasRet bool
 		unsign = "u"
 	}
 	errStr := errString(hasRet)
+	read := "Read_slice_" + unsign + "int8"
+	if isBytes(mb.Type) {
+		read = "Read_bytes"
+	}
 
 	c.WriteString(`
 err, _ = _is.SkipTo(codec.BYTE, 0, true)
 ` + errStr + `
 err = _is.Read_int32(&length, 0, true)
 ` + errStr + `
-err = _is.Read_slice_` + unsign + `int8(&` + prefix + mb.Key + `, length, true)
+err = _is.` + read + `(&` + prefix + mb.Key + `, length, true)
 ` + errStr + `
 `)
 }
@@ -498,6 +523,12 @@ if ty == codec.LIST {
 
 	dummy := &StructMember{}
 	dummy.Type = mb.Type.TypeK
+	if isBytes(mb.Type) {
+		// the elements of []byte are read as uint8
+		elem := *mb.Type.TypeK
+		elem.Unsigned = true
+		dummy.Type = &elem
+	}
 	dummy.Key = mb.Key + "[i" + vc + "]"
 	gen.genReadVar(dummy, prefix, hasRet)
 
@@ -689,6 +720,15 @@ func (gen *GenGo) genStruct(st *StructInfo) {
 	gen.genFunReadBlock(st)
 	gen.genFunWriteTo(st)
 	gen.genFunWriteBlock(st)
+	if *gJSON {
+		gen.genFunJSON(st)
+	}
+	if *gCopy {
+		gen.genFunCopy(st)
+	}
+	if *gValidate {
+		gen.genFunValidate(st)
+	}
 
 	gen.saveToSourceFile(st.TName + ".go")
 }
@@ -708,6 +748,9 @@ func (gen *GenGo) genEnum(en *EnumInfo) {
 	gen.genPackage()
 
 	c := &gen.code
+	if *gJSON {
+		c.WriteString("import (\n\"encoding/json\"\n\"fmt\"\n\"strconv\"\n)\n\n")
+	}
 
 	c.WriteString("type " + en.TName + " int32\n")
 	c.WriteString("const (\n")
@@ -717,6 +760,9 @@ func (gen *GenGo) genEnum(en *EnumInfo) {
 	}
 
 	c.WriteString(")\n")
+	if *gJSON {
+		gen.genEnumJSON(en)
+	}
 
 	gen.saveToSourceFile(en.TName + ".go")
 }
@@ -776,6 +822,10 @@ func (gen *GenGo) genAll() {
 	for _, v := range gen.p.Interface {
 		gen.genInterface(&v)
 	}
+
+	if *gOpenAPI {
+		gen.genOpenAPI()
+	}
 }
 
 func (gen *GenGo) genInterface(itf *InterfaceInfo) {
@@ -791,6 +841,10 @@ func (gen *GenGo) genInterface(itf *InterfaceInfo) {
 	gen.genIFDispatch(itf)
 
 	gen.saveToSourceFile(itf.TName + "_IF.go")
+
+	if *gMock {
+		gen.genMock(itf)
+	}
 }
 
 func (gen *GenGo) genIFProxy(itf *InterfaceInfo) {
@@ -801,8 +855,16 @@ func (gen *GenGo) genIFProxy(itf *InterfaceInfo) {
 	c.WriteString("}" + "\n")
 
 	for _, v := range itf.Fun {
+		if *gContextFirst {
+			// the context is always taken, so there are no WithContext functions
+			gen.genIFProxyFun(itf.TName, &v, true)
+			gen.genIFProxyFunOneway(itf.TName, &v, true)
+			continue
+		}
 		gen.genIFProxyFun(itf.TName, &v, false)
 		gen.genIFProxyFun(itf.TName, &v, true)
+		gen.genIFProxyFunOneway(itf.TName, &v, false)
+		gen.genIFProxyFunOneway(itf.TName, &v, true)
 
 	}
 
@@ -816,7 +878,35 @@ func (_obj *` + itf.TName + `) TarsSetTimeout(t int) {
 	_obj.s.TarsSetTimeout(t)
 }
 `)
-	c.WriteString(`func (_obj *` + itf.TName + `) setMap(l int, res *requestf.ResponsePacket,  ctx map[string]string, sts map[string]string) {
+	c.WriteString(`//TarsSetVersion sets the protocol version of the servant, basef.TUPVERSION for the servants speaking TUP,
+//basef.JSONVERSION for encoding the arguments as a JSON object. The servants other than m.VersionServant
+//only speak tars, it takes no effect on them.
+func (_obj *` + itf.TName + `) TarsSetVersion(v int16) {
+	if vs, ok := _obj.s.(m.VersionServant); ok {
+		vs.TarsSetVersion(v)
+	}
+}
+`)
+	if !*gContextFirst {
+		gen.genSetMap(itf)
+	}
+
+	if *gAddServant {
+		c.WriteString(`//AddServant adds servant  for the service.
+func (_obj *` + itf.TName + `) AddServant(imp _imp` + itf.TName + `, obj string) {
+  tars.AddServant(_obj, imp, obj)
+}
+`)
+		c.WriteString(`//AddServant adds servant  for the service with context.
+func (_obj *` + itf.TName + `) AddServantWithContext(imp _imp` + itf.TName + `WithContext, obj string) {
+  tars.AddServantWithContext(_obj, imp, obj)
+}
+`)
+	}
+}
+
+func (gen *GenGo) genSetMap(itf *InterfaceInfo) {
+	gen.code.WriteString(`func (_obj *` + itf.TName + `) setMap(l int, res *requestf.ResponsePacket,  ctx map[string]string, sts map[string]string) {
 		if l == 1{
 			for k, _ := range(ctx){
 				delete(ctx, k)
@@ -840,24 +930,50 @@ func (_obj *` + itf.TName + `) TarsSetTimeout(t int) {
 		}
 		}
 	`)
+}
 
-	if *gAddServant {
-		c.WriteString(`//AddServant adds servant  for the service.
-func (_obj *` + itf.TName + `) AddServant(imp _imp` + itf.TName + `, obj string) {
-  tars.AddServant(_obj, imp, obj)
+// genOpt returns the declaration of the options of the proxy functions.
+func genOpt() string {
+	if *gContextFirst {
+		return " _opt ...m.CallOption)"
+	}
+	return " _opt ...map[string]string)"
 }
+
+// genInvoke calls the servant with the options of the proxy function, the oneway calls have no response.
+func (gen *GenGo) genInvoke(fun *FunInfo, ctype string, hasRet bool, oneway bool) {
+	resp := "_resp"
+	newResp := "_resp := new(requestf.ResponsePacket)\n"
+	if oneway {
+		resp = "nil"
+		newResp = ""
+	}
+	if *gContextFirst {
+		gen.code.WriteString(`_opts := m.NewCallOptions(_opt...)
+` + newResp + `err = m.Invoke(ctx, _obj.s, ` + ctype + `, "` + fun.NameStr + `", _os.ToBytes(), _opts, ` + resp + `)
+` + errString(hasRet) + `
 `)
-		c.WriteString(`//AddServant adds servant  for the service with context.
-func (_obj *` + itf.TName + `) AddServantWithContext(imp _imp` + itf.TName + `WithContext, obj string) {
-  tars.AddServantWithContext(_obj, imp, obj)
+		return
+	}
+	gen.code.WriteString(`var _status map[string]string
+var _context map[string]string
+if len(_opt) == 1{
+	_context =_opt[0]
+}else if len(_opt) == 2 {
+	_context = _opt[0]
+	_status = _opt[1]
 }
+` + newResp + `err = _obj.s.Tars_invoke(ctx, ` + ctype + `, "` + fun.NameStr + `", _os.ToBytes(), _status, _context, ` + resp + `)
+` + errString(hasRet) + `
 `)
-	}
 }
 
 func (gen *GenGo) genIFProxyFun(interfName string, fun *FunInfo, withContext bool) {
 	c := &gen.code
-	if withContext == true {
+	if *gContextFirst {
+		c.WriteString("//" + fun.Name + " is the proxy function for the method defined in the tars file, with the context and the call options\n")
+		c.WriteString("func (_obj *" + interfName + ") " + fun.Name + "(ctx context.Context,")
+	} else if withContext == true {
 		c.WriteString("//" + fun.Name + "WithContext is the proxy function for the method defined in the tars file, with the context\n")
 		c.WriteString("func (_obj *" + interfName + ") " + fun.Name + "WithContext(ctx context.Context,")
 	} else {
@@ -868,7 +984,7 @@ func (gen *GenGo) genIFProxyFun(interfName string, fun *FunInfo, withContext boo
 		gen.genArgs(&v)
 	}
 
-	c.WriteString(" _opt ...map[string]string)")
+	c.WriteString(genOpt())
 	if fun.HasRet {
 		c.WriteString("(ret " + gen.genType(fun.RetType) + ", err error){" + "\n")
 	} else {
@@ -879,8 +995,10 @@ func (gen *GenGo) genIFProxyFun(interfName string, fun *FunInfo, withContext boo
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
   `)
-	c.WriteString("_os := codec.NewBuffer()")
+	c.WriteString("_os := codec.NewBuffer()\n")
+	gen.genNewReqArgs()
 	var isOut bool
 	for k, v := range fun.Args {
 		if v.IsOut {
@@ -890,45 +1008,32 @@ func (gen *GenGo) genIFProxyFun(interfName string, fun *FunInfo, withContext boo
 			dummy.Type = v.Type
 			dummy.Key = v.Name
 			dummy.Tag = int32(k + 1)
-			gen.genWriteVar(dummy, "", fun.HasRet)
+			gen.genWriteArg(dummy, "_req", []string{`"` + v.NameStr + `"`}, `"`+v.NameStr+`"`, fun.HasRet)
 		}
 	}
+	gen.genEncodeArgs("_req", fun.HasRet)
 	// empty args and below separate
 	c.WriteString("\n")
 	errStr := errString(fun.HasRet)
 
 	if withContext == false {
-		c.WriteString(`
-var _status map[string]string
-var _context map[string]string
-if len(_opt) == 1{
-	_context =_opt[0]
-}else if len(_opt) == 2 {
-	_context = _opt[0]
-	_status = _opt[1]
-}
-_resp := new(requestf.ResponsePacket)
-ctx := context.Background()
-err = _obj.s.Tars_invoke(ctx, 0, "` + fun.NameStr + `", _os.ToBytes(), _status, _context, _resp)
-` + errStr + `
-`)
-	} else {
-		c.WriteString(`var _status map[string]string
-var _context map[string]string
-if len(_opt) == 1{
-	_context =_opt[0]
-}else if len(_opt) == 2 {
-	_context = _opt[0]
-	_status = _opt[1]
-}
-_resp := new(requestf.ResponsePacket)
-err = _obj.s.Tars_invoke(ctx, 0, "` + fun.NameStr + `", _os.ToBytes(), _status, _context, _resp)
-` + errStr + `
-`)
+		c.WriteString("ctx := context.Background()\n")
 	}
+	gen.genInvoke(fun, "0", fun.HasRet, false)
 
+	c.WriteString("var _rspTup_ *tup.UniAttribute\n")
+	c.WriteString("var _rspJson_ map[string]json.RawMessage\n")
 	if isOut || fun.HasRet {
-		c.WriteString("_is := codec.NewReader(tools.Int8ToByte(_resp.SBuffer))")
+		c.WriteString("_is := codec.NewReader(_resp.SBuffer)\n")
+		c.WriteString(`if _reqTup_ != nil {
+	_rspTup_ = tup.NewUniAttribute()
+	err = _rspTup_.Decode(_is)
+	` + errStr + `
+}
+if _reqJson_ != nil {
+`)
+		gen.genDecodeJSONArgs("_rspJson_", "_resp.SBuffer", fun.HasRet)
+		c.WriteString("}\n")
 	}
 	if fun.HasRet {
 		dummy := &StructMember{}
@@ -936,7 +1041,7 @@ err = _obj.s.Tars_invoke(ctx, 0, "` + fun.NameStr + `", _os.ToBytes(), _status,
 		dummy.Key = "ret"
 		dummy.Tag = 0
 		dummy.Require = true
-		gen.genReadVar(dummy, "", fun.HasRet)
+		gen.genReadArg(dummy, "_rsp", "tup.RetKey", jsonRetKey, fun.HasRet)
 	}
 
 	for k, v := range fun.Args {
@@ -946,15 +1051,22 @@ err = _obj.s.Tars_invoke(ctx, 0, "` + fun.NameStr + `", _os.ToBytes(), _status,
 			dummy.Key = "(*" + v.Name + ")"
 			dummy.Tag = int32(k + 1)
 			dummy.Require = true
-			gen.genReadVar(dummy, "", fun.HasRet)
+			gen.genReadArg(dummy, "_rsp", `"`+v.NameStr+`"`, `"`+v.NameStr+`"`, fun.HasRet)
 		}
 	}
 
+	if *gContextFirst {
+		c.WriteString("_opts.CopyBack(_resp)\n")
+	} else {
+		c.WriteString("_obj.setMap(len(_opt), _resp,_context,_status )\n")
+	}
 	c.WriteString(`
-	_obj.setMap(len(_opt), _resp,_context,_status )
   _ = length
   _ = have
   _ = ty
+  _ = _tupBuffer_
+  _ = _rspTup_
+  _ = _rspJson_
   `)
 
 	if fun.HasRet {
@@ -966,6 +1078,141 @@ err = _obj.s.Tars_invoke(ctx, 0, "` + fun.NameStr + `", _os.ToBytes(), _status,
 	c.WriteString("}" + "\n")
 }
 
+func (gen *GenGo) genIFProxyFunOneway(interfName string, fun *FunInfo, withContext bool) {
+	c := &gen.code
+	if *gContextFirst {
+		c.WriteString("//" + fun.Name + "Oneway sends the request for the method defined in the tars file without waiting for the response, with the context and the call options\n")
+		c.WriteString("func (_obj *" + interfName + ") " + fun.Name + "Oneway(ctx context.Context,")
+	} else if withContext == true {
+		c.WriteString("//" + fun.Name + "OnewayWithContext sends the request for the method defined in the tars file without waiting for the response, with the context\n")
+		c.WriteString("func (_obj *" + interfName + ") " + fun.Name + "OnewayWithContext(ctx context.Context,")
+	} else {
+		c.WriteString("//" + fun.Name + "Oneway sends the request for the method defined in the tars file without waiting for the response\n")
+		c.WriteString("func (_obj *" + interfName + ") " + fun.Name + "Oneway(")
+	}
+	for _, v := range fun.Args {
+		// out parameters are never filled by a oneway call
+		if !v.IsOut {
+			gen.genArgs(&v)
+		}
+	}
+
+	c.WriteString(genOpt())
+	c.WriteString("(err error)" + "{" + "\n")
+
+	c.WriteString(`
+	var length int32
+	var have bool
+	var ty byte
+  `)
+	c.WriteString("_os := codec.NewBuffer()\n")
+	gen.genNewReqArgs()
+	for k, v := range fun.Args {
+		if !v.IsOut {
+			dummy := &StructMember{}
+			dummy.Type = v.Type
+			dummy.Key = v.Name
+			dummy.Tag = int32(k + 1)
+			gen.genWriteArg(dummy, "_req", []string{`"` + v.NameStr + `"`}, `"`+v.NameStr+`"`, false)
+		}
+	}
+	gen.genEncodeArgs("_req", false)
+	c.WriteString("\n")
+	if withContext == false {
+		c.WriteString("ctx := context.Background()\n")
+	}
+	gen.genInvoke(fun, "byte(basef.TARSONEWAY)", false, true)
+	c.WriteString(`
+  _ = length
+  _ = have
+  _ = ty
+return nil
+}
+`)
+}
+
+// genNewReqArgs creates the TUP attribute or the JSON object of the request
+// if the servant speaks TUP or JSON.
+func (gen *GenGo) genNewReqArgs() {
+	gen.code.WriteString(`var _reqTup_ *tup.UniAttribute
+var _reqJson_ map[string]interface{}
+switch m.GetVersion(_obj.s) {
+case basef.TUPVERSION:
+	_reqTup_ = tup.NewUniAttribute()
+case basef.JSONVERSION:
+	_reqJson_ = make(map[string]interface{})
+}
+`)
+}
+
+// genEncodeArgs replaces the content of _os with the TUP attribute or the JSON object if there is one.
+func (gen *GenGo) genEncodeArgs(side string, hasRet bool) {
+	errStr := errString(hasRet)
+	gen.code.WriteString(`if ` + side + `Tup_ != nil {
+	_os.Reset()
+	err = ` + side + `Tup_.Encode(_os)
+	` + errStr + `
+}
+if ` + side + `Json_ != nil {
+	var _jsonBuffer_ []byte
+	_jsonBuffer_, err = json.Marshal(` + side + `Json_)
+	` + errStr + `
+	_os.Reset()
+	err = _os.Write_slice_uint8(_jsonBuffer_)
+	` + errStr + `
+}
+`)
+}
+
+// genDecodeJSONArgs decodes the JSON object of the named vars from buf.
+func (gen *GenGo) genDecodeJSONArgs(jsonVar string, buf string, hasRet bool) {
+	gen.code.WriteString(jsonVar + ` = make(map[string]json.RawMessage)
+if len(` + buf + `) > 0 {
+	err = json.Unmarshal(` + buf + `, &` + jsonVar + `)
+	` + errString(hasRet) + `
+	if ` + jsonVar + ` == nil {
+		// a null object has no vars
+		` + jsonVar + ` = make(map[string]json.RawMessage)
+	}
+}
+`)
+}
+
+// genWriteArg writes the var with its tag, or puts it into the TUP attribute with the keys,
+// or into the JSON object with the json key.
+func (gen *GenGo) genWriteArg(v *StructMember, side string, tupKeys []string, jsonKey string, hasRet bool) {
+	c := &gen.code
+	c.WriteString("if " + side + "Json_ != nil {\n")
+	c.WriteString(side + "Json_[" + jsonKey + "] = " + v.Key + "\n")
+	c.WriteString("} else if " + side + "Tup_ != nil {\n_os.Reset()")
+	tup := *v
+	tup.Tag = 0
+	gen.genWriteVar(&tup, "", hasRet)
+	for _, k := range tupKeys {
+		c.WriteString(side + "Tup_.PutBuffer(" + k + ", _os.ToBytes())\n")
+	}
+	c.WriteString("} else {")
+	gen.genWriteVar(v, "", hasRet)
+	c.WriteString("}\n")
+}
+
+// genReadArg reads the var with its tag, or gets it from the TUP attribute by the tup key,
+// or from the JSON object by the json key, a var missing from the JSON object is left as zero.
+func (gen *GenGo) genReadArg(v *StructMember, side string, tupKey string, jsonKey string, hasRet bool) {
+	c := &gen.code
+	c.WriteString("if " + side + "Json_ != nil {\n")
+	c.WriteString("if _raw_, ok := " + side + "Json_[" + jsonKey + "]; ok {\n")
+	c.WriteString("err = json.Unmarshal(_raw_, &" + v.Key + ")\n" + errString(hasRet) + "\n}\n")
+	c.WriteString("} else if " + side + "Tup_ != nil {\n")
+	c.WriteString("err = " + side + "Tup_.GetBuffer(" + tupKey + ", &_tupBuffer_)\n" + errString(hasRet) + "\n_is.Reset(_tupBuffer_)")
+	tup := *v
+	tup.Tag = 0
+	gen.genReadVar(&tup, "", hasRet)
+	c.WriteString("} else {")
+	gen.genReadVar(v, "", hasRet)
+	c.WriteString("}\n")
+}
+
 func (gen *GenGo) genArgs(arg *ArgInfo) {
 	c := &gen.code
 	c.WriteString(arg.Name + " ")
@@ -1024,22 +1271,23 @@ func (gen *GenGo) genIFServerFunWithContext(fun *FunInfo) {
 
 func (gen *GenGo) genSwitchCaseBody(tname string, fun *FunInfo) {
 	c := &gen.code
-	c.WriteString(`func ` + fun.NameStr + `(ctx context.Context, _val interface{},_os *codec.Buffer, _is *codec.Reader, withContext bool)(err error){`)
+	c.WriteString(`func ` + fun.NameStr + `(ctx context.Context, _val interface{},_os *codec.Buffer, _is *codec.Reader, _reqTup_ *tup.UniAttribute, _rspTup_ *tup.UniAttribute, _reqJson_ map[string]json.RawMessage, _rspJson_ map[string]interface{}, withContext bool)(err error){`)
 	c.WriteString(`
 	var length int32
 	var have bool
 	var ty byte
+	var _tupBuffer_ []byte
 	`)
 
 	for k, v := range fun.Args {
-		c.WriteString("var " + v.Name + " " + gen.genType(v.Type))
+		c.WriteString("var " + v.Name + " " + gen.genType(v.Type) + "\n")
 		if !v.IsOut {
 			dummy := &StructMember{}
 			dummy.Type = v.Type
 			dummy.Key = v.Name
 			dummy.Tag = int32(k + 1)
 			dummy.Require = true
-			gen.genReadVar(dummy, "", false)
+			gen.genReadArg(dummy, "_req", `"`+v.NameStr+`"`, `"`+v.NameStr+`"`, false)
 		} else {
 			c.WriteString("\n")
 		}
@@ -1067,7 +1315,7 @@ func (gen *GenGo) genSwitchCaseBody(tname string, fun *FunInfo) {
 		dummy.Key = "ret"
 		dummy.Tag = 0
 		dummy.Require = true
-		gen.genWriteVar(dummy, "", false)
+		gen.genWriteArg(dummy, "_rsp", []string{"tup.RetKey", "tup.TarsRetKey"}, jsonRetKey, false)
 		c.WriteString("}else{")
 		c.WriteString(`
 		_imp := _val.(_imp` + tname + `WithContext)
@@ -1090,7 +1338,7 @@ func (gen *GenGo) genSwitchCaseBody(tname string, fun *FunInfo) {
 		dummy.Key = "ret"
 		dummy.Tag = 0
 		dummy.Require = true
-		gen.genWriteVar(dummy, "", false)
+		gen.genWriteArg(dummy, "_rsp", []string{"tup.RetKey", "tup.TarsRetKey"}, jsonRetKey, false)
 		c.WriteString("}\n")
 
 	} else {
@@ -1137,13 +1385,14 @@ func (gen *GenGo) genSwitchCaseBody(tname string, fun *FunInfo) {
 			dummy.Key = v.Name
 			dummy.Tag = int32(k + 1)
 			dummy.Require = true
-			gen.genWriteVar(dummy, "", false)
+			gen.genWriteArg(dummy, "_rsp", []string{`"` + v.NameStr + `"`}, `"`+v.NameStr+`"`, false)
 		}
 	}
 	c.WriteString(`
 _ = length
 _ = have
 _ = ty
+_ = _tupBuffer_
 `)
 	c.WriteString(`return nil 
 	}`)
@@ -1159,19 +1408,26 @@ func (gen *GenGo) genIFDispatch(itf *InterfaceInfo) {
 	c.WriteString("func(_obj *" + itf.TName + `) Dispatch(ctx context.Context, _val interface{}, req *requestf.RequestPacket, resp *requestf.ResponsePacket,withContext bool) (err error) {
   `)
 
-	var param bool
-	for _, v := range itf.Fun {
-		if len(v.Args) > 0 {
-			param = true
-			break
-		}
-	}
-
-	if param {
-		c.WriteString("_is := codec.NewReader(tools.Int8ToByte(req.SBuffer))")
-	}
-	c.WriteString(`
+	c.WriteString(`_is := codec.NewReader(req.SBuffer)
 _os := codec.NewBuffer()
+var _reqTup_, _rspTup_ *tup.UniAttribute
+var _reqJson_ map[string]json.RawMessage
+var _rspJson_ map[string]interface{}
+switch req.IVersion {
+case basef.TUPVERSION:
+	// TUP requests carry the arguments by name
+	_reqTup_ = tup.NewUniAttribute()
+	err = _reqTup_.Decode(_is)
+	if err != nil {
+		return err
+	}
+	_rspTup_ = tup.NewUniAttribute()
+case basef.JSONVERSION:
+	// JSON requests carry the arguments as an object by name
+`)
+	gen.genDecodeJSONArgs("_reqJson_", "req.SBuffer", false)
+	c.WriteString(`_rspJson_ = make(map[string]interface{})
+}
 switch req.SFuncName {
 `)
 
@@ -1183,7 +1439,9 @@ switch req.SFuncName {
 default:
 	return fmt.Errorf("func mismatch")
 }
-var _status map[string]string
+`)
+	gen.genEncodeArgs("_rsp", false)
+	c.WriteString(`var _status map[string]string
 s, ok := current.GetResponseStatus(ctx)
 if ok  && s != nil {
 	_status = s
@@ -1194,12 +1452,12 @@ if ok && c != nil  {
 	_context = c
 }
 *resp = requestf.ResponsePacket{
-	IVersion:     1,
+	IVersion:     req.IVersion,
 	CPacketType:  0,
 	IRequestId:   req.IRequestId,
 	IMessageType: 0,
 	IRet:         0,
-	SBuffer:      tools.ByteToInt8(_os.ToBytes()),
+	SBuffer:      _os.ToBytes(),
 	Status:       _status,
 	SResultDesc:  "",
 	Context:      _context,
@@ -1212,7 +1470,7 @@ return nil
 func (gen *GenGo) genSwitchCase(tname string, fun *FunInfo) {
 	c := &gen.code
 	c.WriteString(`case "` + fun.NameStr + `":` + "\n")
-	c.WriteString(`err := ` + fun.NameStr + `(ctx, _val, _os, _is, withContext)
+	c.WriteString(`err := ` + fun.NameStr + `(ctx, _val, _os, _is, _reqTup_, _rspTup_, _reqJson_, _rspJson_, withContext)
 		if err != nil {
 			return err
 		}
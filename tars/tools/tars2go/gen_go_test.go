@@ -0,0 +1,179 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// generate generates the code of the module App with the body and the flags set into the dir.
+func generate(t *testing.T, dir, body string, flags ...*bool) {
+	for _, f := range flags {
+		defer func(f *bool, v bool) { *f = v }(f, *f)
+		*f = true
+	}
+	path := writeTars(t, dir, "App.tars", body)
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("generate: %v", r)
+		}
+	}()
+	gen := NewGenGo(path, dir)
+	gen.tarsPath = "github.com/TarsCloud/TarsGo/tars"
+	gen.p = ParseFile(path)
+	gen.genAll()
+}
+
+// genPackage generates the code into a directory of this go module, so the code is built with this
+// tars. The directory starts with _ to be left out of ./..., the caller removes it.
+func genPackage(t *testing.T, body string, flags ...*bool) string {
+	if testing.Short() {
+		t.Skip("building the generated code in short mode")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go not found")
+	}
+	dir, err := ioutil.TempDir(".", "_gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok := false
+	defer func() {
+		if !ok {
+			os.RemoveAll(dir)
+		}
+	}()
+	generate(t, dir, body, flags...)
+	ok = true
+	return dir
+}
+
+// goTest vets and runs the test source in the generated package App of the dir.
+func goTest(t *testing.T, dir, test string) {
+	pkg := filepath.Join(dir, "App")
+	if err := ioutil.WriteFile(filepath.Join(pkg, "gen_test.go"), []byte(test), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{{"vet", "."}, {"test", "-count=1", "."}} {
+		cmd := exec.Command("go", args...)
+		cmd.Dir = pkg
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("go %s: %v\n%s", args[0], err, out)
+		}
+	}
+}
+
+const contextFirstTest = `package App
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TarsCloud/TarsGo/tars"
+	m "github.com/TarsCloud/TarsGo/tars/model"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/requestf"
+)
+
+// the proxy functions take the context first and the call options last
+var (
+	_ func(*Hello, context.Context, string, *string, ...m.CallOption) (int32, error) = (*Hello).TestHello
+	_ func(*Hello, context.Context, string, ...m.CallOption) error                   = (*Hello).TestHelloOneway
+	_ func(*Hello, context.Context, int32, *User, ...m.CallOption) (int32, error)    = (*Hello).GetUser
+	_ func(*Hello, context.Context, ...m.CallOption) error                           = (*Hello).Ping
+)
+
+type ctxKey struct{}
+
+// optionServant dispatches the calls to the imp in process, recording the options.
+type optionServant struct {
+	imp  interface{}
+	opts *m.CallOptions
+}
+
+func (s *optionServant) Tars_invoke(ctx context.Context, ctype byte, sFuncName string, buf []byte,
+	status map[string]string, reqContext map[string]string, resp *requestf.ResponsePacket) error {
+	return errors.New("Tars_invoke called instead of Tars_invokeWithOptions")
+}
+
+func (s *optionServant) TarsSetTimeout(t int) {
+}
+
+func (s *optionServant) Tars_invokeWithOptions(ctx context.Context, ctype byte, sFuncName string, buf []byte,
+	opts *m.CallOptions, resp *requestf.ResponsePacket) error {
+	s.opts = opts
+	req := &requestf.RequestPacket{IVersion: basef.TARSVERSION, CPacketType: int8(ctype), SFuncName: sFuncName,
+		SBuffer: buf, Context: opts.Context}
+	rsp := new(requestf.ResponsePacket)
+	if err := new(Hello).Dispatch(ctx, s.imp, req, rsp, true); err != nil {
+		return err
+	}
+	// the oneway calls take no response
+	if resp != nil {
+		*resp = *rsp
+		resp.Context = map[string]string{"reply": "1"}
+	}
+	return nil
+}
+
+type helloImp struct{}
+
+func (helloImp) TestHello(ctx context.Context, sReq string, sRsp *string) (int32, error) {
+	v, _ := ctx.Value(ctxKey{}).(string)
+	*sRsp = sReq + " " + v
+	return 1, nil
+}
+
+func (helloImp) GetUser(ctx context.Context, id int32, u *User) (int32, error) {
+	u.Age = id
+	return 0, nil
+}
+
+func (helloImp) Ping(ctx context.Context) error {
+	return nil
+}
+
+func TestContextFirst(t *testing.T) {
+	s := &optionServant{imp: helloImp{}}
+	proxy := new(Hello)
+	proxy.SetServant(s)
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "from ctx")
+	reqContext := map[string]string{"k": "v"}
+	var rsp string
+	ret, err := proxy.TestHello(ctx, "hi", &rsp, tars.WithTimeout(time.Second), tars.WithContext(reqContext))
+	if ret != 1 || err != nil || rsp != "hi from ctx" {
+		t.Fatalf("TestHello: %d %q %v", ret, rsp, err)
+	}
+	if s.opts.Timeout != time.Second {
+		t.Errorf("timeout option not passed, got %v", s.opts.Timeout)
+	}
+	if reqContext["reply"] != "1" || len(reqContext) != 1 {
+		t.Errorf("context of the response not copied back, got %v", reqContext)
+	}
+
+	var u User
+	if ret, err := proxy.GetUser(ctx, 20, &u, tars.WithHashKey("uid")); ret != 0 || err != nil || u.Age != 20 {
+		t.Fatalf("GetUser: %d %+v %v", ret, u, err)
+	}
+	if !s.opts.IsHash {
+		t.Error("hash option not passed")
+	}
+	if err := proxy.Ping(ctx); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if err := proxy.TestHelloOneway(ctx, "hi"); err != nil {
+		t.Fatalf("TestHelloOneway: %v", err)
+	}
+}
+`
+
+func TestGenContextFirst(t *testing.T) {
+	dir := genPackage(t, mockTars, gContextFirst)
+	defer os.RemoveAll(dir)
+	goTest(t, dir, contextFirstTest)
+}
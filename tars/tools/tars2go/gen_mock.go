@@ -0,0 +1,410 @@
+package main
+
+import (
+	"flag"
+	"strings"
+)
+
+var gMock = flag.Bool("mock", false, "Generate the mock client and the in-process fake for the interfaces")
+
+// argDecl returns the declaration of the argument like genArgs.
+func (gen *GenGo) argDecl(arg *ArgInfo) string {
+	ty := gen.genType(arg.Type)
+	if arg.IsOut || arg.Type.CType == tkStruct {
+		ty = "*" + ty
+	}
+	return arg.Name + " " + ty
+}
+
+// funParams returns the parameters of the proxy function, without the out ones for oneway.
+func (gen *GenGo) funParams(fun *FunInfo, oneway bool) (decls []string, names []string) {
+	for i := range fun.Args {
+		if oneway && fun.Args[i].IsOut {
+			continue
+		}
+		decls = append(decls, gen.argDecl(&fun.Args[i])+", ")
+		names = append(names, fun.Args[i].Name+", ")
+	}
+	return
+}
+
+func (gen *GenGo) funResults(fun *FunInfo) string {
+	if fun.HasRet {
+		return "(ret " + gen.genType(fun.RetType) + ", err error)"
+	}
+	return "(err error)"
+}
+
+func (gen *GenGo) genMock(itf *InterfaceInfo) {
+	gen.code.Reset()
+	c := &gen.code
+	gen.genHead()
+	c.WriteString("package " + gen.p.Module + "\n\n")
+	c.WriteString(`
+import (
+"fmt"
+"context"
+"reflect"
+"strings"
+"sync"
+"sync/atomic"
+`)
+	c.WriteString("\"" + gen.tarsPath + "/protocol/res/requestf\"\n")
+	c.WriteString("\"" + gen.tarsPath + "/protocol/res/basef\"\n")
+	if *gContextFirst {
+		c.WriteString("m \"" + gen.tarsPath + "/model\"\n")
+	}
+	for k := range itf.DependModule {
+		gen.genImport(k)
+	}
+	c.WriteString(")\n")
+
+	gen.genMockClient(itf)
+	gen.genMockFake(itf)
+	gen.saveToSourceFile(itf.TName + "_Mock.go")
+}
+
+// ctxNames returns the names of the proxy functions taking the context.
+func ctxNames(fun *FunInfo) (call string, oneway string) {
+	if *gContextFirst {
+		return fun.Name, fun.Name + "Oneway"
+	}
+	return fun.Name + "WithContext", fun.Name + "OnewayWithContext"
+}
+
+func (gen *GenGo) genMockClient(itf *InterfaceInfo) {
+	c := &gen.code
+	name := itf.TName
+	mock := name + "Mock"
+
+	c.WriteString("//" + name + "Client is the client of " + name + ", implemented by the proxy *" + name + " and the mock *" + mock + ".\n")
+	c.WriteString("type " + name + "Client interface {\n")
+	for i := range itf.Fun {
+		fun := &itf.Fun[i]
+		params, _ := gen.funParams(fun, false)
+		onewayParams, _ := gen.funParams(fun, true)
+		call, oneway := ctxNames(fun)
+		if !*gContextFirst {
+			c.WriteString(fun.Name + "(" + strings.Join(params, "") + genOpt() + gen.funResults(fun) + "\n")
+		}
+		c.WriteString(call + "(ctx context.Context, " + strings.Join(params, "") + genOpt() + gen.funResults(fun) + "\n")
+		if !*gContextFirst {
+			c.WriteString(fun.Name + "Oneway(" + strings.Join(onewayParams, "") + genOpt() + " (err error)\n")
+		}
+		c.WriteString(oneway + "(ctx context.Context, " + strings.Join(onewayParams, "") + genOpt() + " (err error)\n")
+	}
+	c.WriteString("}\n\n")
+
+	c.WriteString("//" + mock + " is the mock of " + name + "Client, the calls are answered by the expectations set by the Expect\n")
+	c.WriteString("//methods, or else by the functions set for the methods. The calls are recorded, and the calls answered by neither\n")
+	c.WriteString("//fail and are reported by Verify.\n")
+	c.WriteString("type " + mock + " struct {\n")
+	for i := range itf.Fun {
+		fun := &itf.Fun[i]
+		params, _ := gen.funParams(fun, false)
+		c.WriteString("//" + fun.Name + "Func answers the calls of " + fun.Name + ", the oneway calls included.\n")
+		c.WriteString(fun.Name + "Func func(ctx context.Context, " + strings.Join(params, "") + genOpt() + gen.funResults(fun) + "\n")
+	}
+	c.WriteString(`
+	mu sync.Mutex
+	calls []` + mock + `Call
+	expects []*_` + mock + `Expect
+	unexpected []string
+`)
+	for i := range itf.Fun {
+		fun := &itf.Fun[i]
+		c.WriteString("expect" + fun.Name + " []*" + mock + fun.Name + "Expect\n")
+	}
+	c.WriteString(`}
+
+//` + mock + `Call is a call recorded by ` + mock + `, Args are the arguments except the context and the options.
+type ` + mock + `Call struct {
+	Method string
+	Args   []interface{}
+}
+
+func (_obj *` + mock + `) record(method string, args ...interface{}) {
+	_obj.mu.Lock()
+	defer _obj.mu.Unlock()
+	_obj.calls = append(_obj.calls, ` + mock + `Call{Method: method, Args: args})
+}
+
+//Calls returns the recorded calls of the method, or all the calls if the method is empty.
+func (_obj *` + mock + `) Calls(method string) []` + mock + `Call {
+	_obj.mu.Lock()
+	defer _obj.mu.Unlock()
+	var calls []` + mock + `Call
+	for _, call := range _obj.calls {
+		if method == "" || call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+//Reset forgets the recorded calls.
+func (_obj *` + mock + `) Reset() {
+	_obj.mu.Lock()
+	defer _obj.mu.Unlock()
+	_obj.calls = nil
+}
+
+//_` + mock + `Expect is the part of the expectations common to the methods.
+type _` + mock + `Expect struct {
+	method string
+	args   []interface{}
+	times  int
+	calls  int
+}
+
+// match returns whether the call with the in arguments is expected, with mu held.
+func (e *_` + mock + `Expect) match(args []interface{}) bool {
+	if e.times >= 0 && e.calls >= e.times {
+		return false
+	}
+	return e.args == nil || reflect.DeepEqual(e.args, args)
+}
+
+func (_obj *` + mock + `) unexpectedCall(method string) error {
+	_obj.mu.Lock()
+	defer _obj.mu.Unlock()
+	_obj.unexpected = append(_obj.unexpected, method)
+	return fmt.Errorf("` + mock + `: unexpected call of %s", method)
+}
+
+//Verify returns an error if an expectation is not met, or a call is answered by neither an expectation
+//nor the function of the method.
+func (_obj *` + mock + `) Verify() error {
+	_obj.mu.Lock()
+	defer _obj.mu.Unlock()
+	var errs []string
+	for _, e := range _obj.expects {
+		if e.times >= 0 && e.calls != e.times {
+			errs = append(errs, fmt.Sprintf("%s called %d times, expected %d", e.method, e.calls, e.times))
+		}
+	}
+	for _, method := range _obj.unexpected {
+		errs = append(errs, "unexpected call of "+method)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("` + mock + `: %s", strings.Join(errs, ", "))
+}
+`)
+	for i := range itf.Fun {
+		gen.genMockExpect(itf, &itf.Fun[i])
+	}
+
+	for i := range itf.Fun {
+		fun := &itf.Fun[i]
+		params, names := gen.funParams(fun, false)
+		onewayParams, onewayNames := gen.funParams(fun, true)
+		args := strings.TrimSuffix(strings.Join(names, ""), ", ")
+		results := gen.funResults(fun)
+
+		call, oneway := ctxNames(fun)
+
+		if !*gContextFirst {
+			c.WriteString("//" + fun.Name + " calls " + fun.Name + "Func.\n")
+			c.WriteString("func (_obj *" + mock + ") " + fun.Name + "(" + strings.Join(params, "") + genOpt() + results + "{\n")
+			c.WriteString("return _obj." + call + "(context.Background(), " + strings.Join(names, "") + "_opt...)\n}\n")
+		}
+
+		c.WriteString("//" + call + " calls " + fun.Name + "Func with the context.\n")
+		c.WriteString("func (_obj *" + mock + ") " + call + "(ctx context.Context, " + strings.Join(params, "") + genOpt() + results + "{\n")
+		c.WriteString("_obj.record(\"" + fun.Name + "\"")
+		if args != "" {
+			c.WriteString(", " + args)
+		}
+		c.WriteString(")\n")
+		c.WriteString("if _e := _obj.expected" + fun.Name + "(" + strings.TrimSuffix(strings.Join(gen.inNames(fun), ""), ", ") + "); _e != nil {\n")
+		for j := range fun.Args {
+			v := &fun.Args[j]
+			if v.IsOut {
+				c.WriteString("if " + v.Name + " != nil {\n*" + v.Name + " = _e.out" + v.Name + "\n}\n")
+			}
+		}
+		if fun.HasRet {
+			c.WriteString("return _e.ret, _e.err\n}\n")
+		} else {
+			c.WriteString("return _e.err\n}\n")
+		}
+		c.WriteString("if _obj." + fun.Name + "Func == nil {\n")
+		c.WriteString("err = _obj.unexpectedCall(\"" + fun.Name + "\")\nreturn\n}\n")
+		c.WriteString("return _obj." + fun.Name + "Func(ctx, " + strings.Join(names, "") + "_opt...)\n}\n")
+
+		if !*gContextFirst {
+			c.WriteString("//" + fun.Name + "Oneway calls " + fun.Name + "Func and ignores the results except the error.\n")
+			c.WriteString("func (_obj *" + mock + ") " + fun.Name + "Oneway(" + strings.Join(onewayParams, "") + genOpt() + " (err error) {\n")
+			c.WriteString("return _obj." + oneway + "(context.Background(), " + strings.Join(onewayNames, "") + "_opt...)\n}\n")
+		}
+
+		c.WriteString("//" + oneway + " calls " + fun.Name + "Func with the context and ignores the results except the error.\n")
+		c.WriteString("func (_obj *" + mock + ") " + oneway + "(ctx context.Context, " + strings.Join(onewayParams, "") + genOpt() + " (err error) {\n")
+		var callArgs []string
+		for j := range fun.Args {
+			v := &fun.Args[j]
+			if v.IsOut {
+				c.WriteString("var " + v.Name + " " + gen.genType(v.Type) + "\n")
+				callArgs = append(callArgs, "&"+v.Name+", ")
+			} else {
+				callArgs = append(callArgs, v.Name+", ")
+			}
+		}
+		if fun.HasRet {
+			c.WriteString("_, ")
+		}
+		c.WriteString("err = _obj." + call + "(ctx, " + strings.Join(callArgs, "") + "_opt...)\nreturn err\n}\n")
+	}
+}
+
+// inNames returns the names of the in arguments.
+func (gen *GenGo) inNames(fun *FunInfo) (names []string) {
+	for i := range fun.Args {
+		if !fun.Args[i].IsOut {
+			names = append(names, fun.Args[i].Name+", ")
+		}
+	}
+	return
+}
+
+// genMockExpect generates the expectation of the method, set up by the Expect method of the mock.
+func (gen *GenGo) genMockExpect(itf *InterfaceInfo, fun *FunInfo) {
+	c := &gen.code
+	mock := itf.TName + "Mock"
+	expect := mock + fun.Name + "Expect"
+	base := "_" + mock + "Expect"
+
+	var inDecls, outDecls, outNames []string
+	for i := range fun.Args {
+		v := &fun.Args[i]
+		if v.IsOut {
+			outDecls = append(outDecls, v.Name+" "+gen.genType(v.Type)+", ")
+			outNames = append(outNames, v.Name)
+		} else {
+			inDecls = append(inDecls, gen.argDecl(v)+", ")
+		}
+	}
+	inNames := strings.TrimSuffix(strings.Join(gen.inNames(fun), ""), ", ")
+
+	c.WriteString("//" + expect + " is an expected call of " + fun.Name + ", set up by Expect" + fun.Name + ".\n")
+	c.WriteString("type " + expect + " struct {\n" + base + "\n")
+	if fun.HasRet {
+		c.WriteString("ret " + gen.genType(fun.RetType) + "\n")
+	}
+	for i := range outDecls {
+		c.WriteString("out" + outDecls[i][:len(outDecls[i])-2] + "\n")
+	}
+	c.WriteString("err error\n}\n\n")
+
+	c.WriteString("//Expect" + fun.Name + " expects a call of " + fun.Name + " with any arguments, once unless Times is set.\n")
+	c.WriteString("//The call returns the zero values unless Return is set.\n")
+	c.WriteString("func (_obj *" + mock + ") Expect" + fun.Name + "() *" + expect + " {\n")
+	c.WriteString(`_obj.mu.Lock()
+defer _obj.mu.Unlock()
+e := &` + expect + `{` + base + `: ` + base + `{method: "` + fun.Name + `", times: 1}}
+_obj.expect` + fun.Name + ` = append(_obj.expect` + fun.Name + `, e)
+_obj.expects = append(_obj.expects, &e.` + base + `)
+return e
+}
+
+`)
+
+	if len(inDecls) > 0 {
+		c.WriteString("//With only expects the call with the arguments, compared by reflect.DeepEqual.\n")
+		c.WriteString("func (e *" + expect + ") With(" + strings.TrimSuffix(strings.Join(inDecls, ""), ", ") + ") *" + expect + " {\n")
+		c.WriteString("e.args = []interface{}{" + inNames + "}\nreturn e\n}\n\n")
+	}
+
+	var retDecls []string
+	if fun.HasRet {
+		retDecls = append(retDecls, "ret "+gen.genType(fun.RetType)+", ")
+	}
+	retDecls = append(retDecls, outDecls...)
+	c.WriteString("//Return sets the results of the call, the out arguments included.\n")
+	c.WriteString("func (e *" + expect + ") Return(" + strings.Join(retDecls, "") + "err error) *" + expect + " {\n")
+	if fun.HasRet {
+		c.WriteString("e.ret = ret\n")
+	}
+	for _, name := range outNames {
+		c.WriteString("e.out" + name + " = " + name + "\n")
+	}
+	c.WriteString("e.err = err\nreturn e\n}\n\n")
+
+	c.WriteString("//Times expects the call n times, or any times if n is negative.\n")
+	c.WriteString("func (e *" + expect + ") Times(n int) *" + expect + " {\n")
+	c.WriteString("e.times = n\nreturn e\n}\n\n")
+
+	c.WriteString("func (_obj *" + mock + ") expected" + fun.Name + "(" + strings.TrimSuffix(strings.Join(inDecls, ""), ", ") + ") *" + expect + " {\n")
+	c.WriteString(`_obj.mu.Lock()
+defer _obj.mu.Unlock()
+args := []interface{}{` + inNames + `}
+for _, e := range _obj.expect` + fun.Name + ` {
+	if e.match(args) {
+		e.calls++
+		return e
+	}
+}
+return nil
+}
+
+`)
+}
+
+func (gen *GenGo) genMockFake(itf *InterfaceInfo) {
+	c := &gen.code
+	name := itf.TName
+	fake := "_fake" + name
+
+	c.WriteString(`
+//New` + name + `Fake returns a proxy calling the imp in process without the network, the imp implements
+//_imp` + name + ` or _imp` + name + `WithContext. The calls are still encoded and dispatched like the real ones,
+//in the protocol version set by TarsSetVersion.
+func New` + name + `Fake(imp interface{}) *` + name + ` {
+	_obj := new(` + name + `)
+	_obj.SetServant(&` + fake + `{imp: imp, version: basef.TARSVERSION})
+	return _obj
+}
+
+type ` + fake + ` struct {
+	imp     interface{}
+	version int16
+	sid     int32
+}
+
+func (_obj *` + fake + `) Tars_invoke(ctx context.Context, ctype byte, sFuncName string, buf []byte,
+	status map[string]string, reqContext map[string]string, resp *requestf.ResponsePacket) error {
+	_, withContext := _obj.imp.(_imp` + name + `WithContext)
+	req := &requestf.RequestPacket{
+		IVersion:     _obj.version,
+		CPacketType:  int8(ctype),
+		IRequestId:   atomic.AddInt32(&_obj.sid, 1),
+		SServantName: "` + name + `Fake",
+		SFuncName:    sFuncName,
+		SBuffer:      buf,
+		Context:      reqContext,
+		Status:       status,
+	}
+	rsp := new(requestf.ResponsePacket)
+	if err := new(` + name + `).Dispatch(ctx, _obj.imp, req, rsp, withContext); err != nil {
+		return err
+	}
+	if int8(ctype) != basef.TARSONEWAY {
+		*resp = *rsp
+	}
+	return nil
+}
+
+func (_obj *` + fake + `) TarsSetTimeout(t int) {
+}
+
+func (_obj *` + fake + `) TarsSetVersion(v int16) {
+	_obj.version = v
+}
+
+func (_obj *` + fake + `) TarsGetVersion() int16 {
+	return _obj.version
+}
+`)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+const mockTars = `
+struct User
+{
+    0 require int age;
+    1 optional string name;
+};
+
+interface Hello
+{
+    int testHello(string sReq, out string sRsp);
+    int getUser(int id, out User u);
+    void ping();
+};`
+
+const mockTest = `package App
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TarsCloud/TarsGo/tars/protocol/res/basef"
+)
+
+var _ HelloClient = new(Hello)
+var _ HelloClient = new(HelloMock)
+
+func TestMockExpect(t *testing.T) {
+	mock := new(HelloMock)
+	mock.ExpectTestHello().With("hi").Return(0, "hello", nil).Times(2)
+	mock.ExpectTestHello().Return(-1, "", errors.New("fail"))
+	mock.ExpectGetUser().With(int32(1)).Return(0, User{Age: 20, Name: "a"}, nil)
+	mock.ExpectPing().Times(-1)
+
+	var c HelloClient = mock
+	for i := 0; i < 2; i++ {
+		var rsp string
+		if ret, err := c.TestHello("hi", &rsp); ret != 0 || err != nil || rsp != "hello" {
+			t.Fatalf("TestHello: %d %q %v", ret, rsp, err)
+		}
+	}
+	var rsp string
+	if ret, err := c.TestHello("other", &rsp); ret != -1 || err == nil || err.Error() != "fail" {
+		t.Fatalf("TestHello with any arguments: %d %v", ret, err)
+	}
+	var u User
+	if ret, err := c.GetUser(1, &u); ret != 0 || err != nil || u.Age != 20 || u.Name != "a" {
+		t.Fatalf("GetUser: %d %+v %v", ret, u, err)
+	}
+	if err := mock.Verify(); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if calls := mock.Calls("TestHello"); len(calls) != 3 || calls[2].Args[0] != "other" {
+		t.Fatalf("Calls: %+v", calls)
+	}
+
+	// the calls beyond the expectations fail and are reported
+	if _, err := c.TestHello("hi", &rsp); err == nil {
+		t.Fatal("expected error for the unexpected call")
+	}
+	if err := mock.Verify(); err == nil || !strings.Contains(err.Error(), "unexpected call of TestHello") {
+		t.Fatalf("Verify of the unexpected call: %v", err)
+	}
+}
+
+func TestMockNotMet(t *testing.T) {
+	mock := new(HelloMock)
+	mock.ExpectGetUser().Times(2)
+	var u User
+	mock.GetUser(1, &u)
+	if err := mock.Verify(); err == nil || !strings.Contains(err.Error(), "GetUser called 1 times, expected 2") {
+		t.Fatalf("Verify: %v", err)
+	}
+}
+
+func TestMockFunc(t *testing.T) {
+	mock := &HelloMock{
+		TestHelloFunc: func(ctx context.Context, sReq string, sRsp *string, _opt ...map[string]string) (int32, error) {
+			*sRsp = "func " + sReq
+			return 1, nil
+		},
+	}
+	var rsp string
+	if ret, err := mock.TestHello("hi", &rsp); ret != 1 || err != nil || rsp != "func hi" {
+		t.Fatalf("TestHello: %d %q %v", ret, rsp, err)
+	}
+	if err := mock.TestHelloOneway("hi"); err != nil {
+		t.Fatalf("TestHelloOneway: %v", err)
+	}
+	if err := mock.Verify(); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+}
+
+type helloImp struct{}
+
+func (helloImp) TestHello(sReq string, sRsp *string) (int32, error) {
+	*sRsp = "hello " + sReq
+	return 1, nil
+}
+
+func (helloImp) GetUser(id int32, u *User) (int32, error) {
+	if id < 0 {
+		return -1, errors.New("no user")
+	}
+	u.Age = id
+	u.Name = "user"
+	return 0, nil
+}
+
+func (helloImp) Ping() error {
+	return nil
+}
+
+func TestFake(t *testing.T) {
+	for _, version := range []int16{basef.TARSVERSION, basef.TUPVERSION, basef.JSONVERSION} {
+		fake := NewHelloFake(helloImp{})
+		fake.TarsSetVersion(version)
+		var rsp string
+		if ret, err := fake.TestHello("hi", &rsp); ret != 1 || err != nil || rsp != "hello hi" {
+			t.Fatalf("version %d TestHello: %d %q %v", version, ret, rsp, err)
+		}
+		var u User
+		if ret, err := fake.GetUser(20, &u); ret != 0 || err != nil || u.Age != 20 || u.Name != "user" {
+			t.Fatalf("version %d GetUser: %d %+v %v", version, ret, u, err)
+		}
+		if _, err := fake.GetUser(-1, &u); err == nil {
+			t.Fatalf("version %d GetUser: expected the error of the servant", version)
+		}
+		if err := fake.Ping(); err != nil {
+			t.Fatalf("version %d Ping: %v", version, err)
+		}
+		if err := fake.TestHelloOneway("hi"); err != nil {
+			t.Fatalf("version %d TestHelloOneway: %v", version, err)
+		}
+	}
+}
+`
+
+func TestGenMock(t *testing.T) {
+	dir := genPackage(t, mockTars, gMock)
+	defer os.RemoveAll(dir)
+	goTest(t, dir, mockTest)
+}
@@ -0,0 +1,378 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var gOpenAPI = flag.Bool("openapi", false, "Generate the OpenAPI 3 document of the interfaces and the JSON Schemas of the structs, for calling the servants in JSON")
+
+// object is a JSON object of the documents.
+type object map[string]interface{}
+
+// schemaGen builds the schemas of the types, the structs and enums are defined once in defs and referred by ref.
+type schemaGen struct {
+	gen  *GenGo
+	ref  string
+	defs object
+}
+
+func origModule(p *Parse) string {
+	if p.OriginModule != "" {
+		return p.OriginModule
+	}
+	return p.Module
+}
+
+// findModule finds the parse of the module in p and the included ones.
+func findModule(p *Parse, module string) *Parse {
+	if origModule(p) == module {
+		return p
+	}
+	for _, inc := range p.IncParse {
+		if found := findModule(inc, module); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func memberName(m *StructMember) string {
+	if m.KeyStr != "" {
+		return m.KeyStr
+	}
+	return m.Key
+}
+
+func enumName(m *EnumMember) string {
+	if m.KeyStr != "" {
+		return m.KeyStr
+	}
+	return m.Key
+}
+
+func argName(a *ArgInfo) string {
+	if a.NameStr != "" {
+		return a.NameStr
+	}
+	return a.Name
+}
+
+// named returns the reference of the struct or enum named in p, and defines it if not yet.
+func (s *schemaGen) named(p *Parse, name string) object {
+	if i := strings.Index(name, "::"); i >= 0 {
+		if inc := findModule(p, name[:i]); inc != nil {
+			p = inc
+		}
+		name = name[i+2:]
+	}
+	qualified := origModule(p) + "." + name
+	ref := object{"$ref": s.ref + qualified}
+	if _, ok := s.defs[qualified]; ok {
+		return ref
+	}
+	for i := range p.Enum {
+		if p.Enum[i].TName == name {
+			s.defs[qualified] = s.enumSchema(&p.Enum[i])
+			return ref
+		}
+	}
+	for i := range p.Struct {
+		if p.Struct[i].TName == name {
+			// defined before the members for the recursive structs
+			s.defs[qualified] = object{}
+			s.defs[qualified] = s.structSchema(p, &p.Struct[i])
+			return ref
+		}
+	}
+	s.gen.genErr("can not find the definition of " + qualified)
+	return nil
+}
+
+func (s *schemaGen) enumSchema(en *EnumInfo) object {
+	if *gJSON {
+		// -json encodes the enums by name
+		var names []interface{}
+		for i := range en.Mb {
+			names = append(names, enumName(&en.Mb[i]))
+		}
+		return object{"type": "string", "enum": names}
+	}
+	var values []interface{}
+	for _, m := range en.Mb {
+		values = append(values, m.Value)
+	}
+	return object{"type": "integer", "format": "int32", "enum": values}
+}
+
+// intSchema returns the schema of the integer, with the bounds of the narrow or unsigned types.
+func intSchema(ty *VarType) object {
+	bits := intBits(ty)
+	sc := object{"type": "integer", "format": "int32"}
+	if bits == 64 || bits == 32 && ty.Unsigned {
+		sc["format"] = "int64"
+	}
+	if ty.Unsigned {
+		sc["minimum"] = 0
+		if bits < 64 {
+			sc["maximum"] = uint64(1)<<uint(bits) - 1
+		}
+	} else if bits < 32 {
+		sc["minimum"] = -(int64(1) << uint(bits-1))
+		sc["maximum"] = int64(1)<<uint(bits-1) - 1
+	}
+	return sc
+}
+
+func (s *schemaGen) typeSchema(p *Parse, ty *VarType) object {
+	switch ty.Type {
+	case tkTBool:
+		return object{"type": "boolean"}
+	case tkTByte, tkTShort, tkTInt, tkTLong:
+		return intSchema(ty)
+	case tkTFloat:
+		return object{"type": "number", "format": "float"}
+	case tkTDouble:
+		return object{"type": "number", "format": "double"}
+	case tkTString:
+		return object{"type": "string"}
+	case tkTVector:
+		if isBytes(ty) {
+			// []byte is encoded in base64
+			return object{"type": "string", "format": "byte"}
+		}
+		return object{"type": "array", "items": s.typeSchema(p, ty.TypeK)}
+	case tkTMap:
+		// the keys are encoded as strings
+		return object{"type": "object", "additionalProperties": s.typeSchema(p, ty.TypeV)}
+	case tkName:
+		return s.named(p, ty.TypeSt)
+	}
+	s.gen.genErr("Unknow Type " + TokenMap[ty.Type])
+	return nil
+}
+
+// defaultValue returns the default value of the member in JSON.
+func (s *schemaGen) defaultValue(p *Parse, m *StructMember) (interface{}, bool) {
+	switch m.DefType {
+	case tkInteger:
+		if v, err := strconv.ParseInt(m.Default, 0, 64); err == nil {
+			return v, true
+		}
+	case tkFloat:
+		if v, err := strconv.ParseFloat(m.Default, 64); err == nil {
+			return v, true
+		}
+	case tkString:
+		return strings.Trim(m.Default, `"`), true
+	case tkTrue:
+		return true, true
+	case tkFalse:
+		return false, true
+	case tkName:
+		// the default of an enum is Enum_Member
+		name := m.Type.TypeSt
+		if i := strings.Index(name, "::"); i >= 0 {
+			if inc := findModule(p, name[:i]); inc != nil {
+				p = inc
+			}
+			name = name[i+2:]
+		}
+		for _, en := range p.Enum {
+			if en.TName != name {
+				continue
+			}
+			for i := range en.Mb {
+				if m.Default == en.TName+"_"+en.Mb[i].Key || m.Default == en.TName+"_"+enumName(&en.Mb[i]) {
+					if *gJSON {
+						return enumName(&en.Mb[i]), true
+					}
+					return en.Mb[i].Value, true
+				}
+			}
+		}
+	}
+	return nil, false
+}
+
+// applyRules adds the validation annotations to the schema.
+func applyRules(sc object, m *StructMember) {
+	var min, max string
+	switch m.Type.Type {
+	case tkTString:
+		min, max = "minLength", "maxLength"
+	case tkTVector:
+		min, max = "minItems", "maxItems"
+		if isBytes(m.Type) {
+			// the length of the base64 is not the length of the bytes
+			min, max = "", ""
+		}
+	case tkTMap:
+		min, max = "minProperties", "maxProperties"
+	default:
+		min, max = "minimum", "maximum"
+	}
+	bound := func(v string) interface{} {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+		if n, err := strconv.ParseUint(v, 10, 64); err == nil {
+			return n
+		}
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	}
+	for _, r := range m.Rules {
+		if min == "" {
+			return
+		}
+		switch r.Name {
+		case "required":
+			if _, ok := sc[min]; !ok {
+				sc[min] = 1
+			}
+		case "range", "length":
+			if r.Min != "" {
+				sc[min] = bound(r.Min)
+			}
+			if r.Max != "" {
+				sc[max] = bound(r.Max)
+			}
+		}
+	}
+}
+
+func (s *schemaGen) structSchema(p *Parse, st *StructInfo) object {
+	props := object{}
+	var required []string
+	for i := range st.Mb {
+		m := &st.Mb[i]
+		sc := s.typeSchema(p, m.Type)
+		if _, ok := sc["$ref"]; ok && (m.Default != "" || len(m.Rules) > 0) {
+			// the siblings of $ref are ignored
+			sc = object{"allOf": []interface{}{sc}}
+		}
+		if v, ok := s.defaultValue(p, m); ok {
+			sc["default"] = v
+		}
+		applyRules(sc, m)
+		props[memberName(m)] = sc
+		if m.Require {
+			required = append(required, memberName(m))
+		}
+	}
+	sc := object{"type": "object", "properties": props}
+	if len(required) > 0 {
+		sc["required"] = required
+	}
+	return sc
+}
+
+// argsSchema returns the schema of the JSON object of the in or out parameters, named as the JSON protocol.
+func (s *schemaGen) argsSchema(fun *FunInfo, out bool) object {
+	props := object{}
+	required := []string{}
+	if out && fun.HasRet {
+		props[strings.Trim(jsonRetKey, `"`)] = s.typeSchema(s.gen.p, fun.RetType)
+		required = append(required, strings.Trim(jsonRetKey, `"`))
+	}
+	for i := range fun.Args {
+		a := &fun.Args[i]
+		if a.IsOut != out {
+			continue
+		}
+		props[argName(a)] = s.typeSchema(s.gen.p, a.Type)
+		required = append(required, argName(a))
+	}
+	sc := object{"type": "object", "properties": props}
+	if len(required) > 0 {
+		sc["required"] = required
+	}
+	return sc
+}
+
+func jsonContent(schema object) object {
+	return object{"application/json": object{"schema": schema}}
+}
+
+func (gen *GenGo) saveFile(filename string, v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		gen.genErr(err.Error())
+	}
+	dir := gen.prefix + gen.p.Module
+	if err := os.MkdirAll(dir, 0766); err != nil {
+		gen.genErr(err.Error())
+	}
+	if err := ioutil.WriteFile(dir+"/"+filename, append(data, '\n'), 0666); err != nil {
+		gen.genErr(err.Error())
+	}
+}
+
+// genOpenAPI generates the OpenAPI document of the interfaces, every method is a POST operation at
+// /Interface/method taking and returning the parameters as the JSON protocol, and a JSON Schema for
+// every struct.
+func (gen *GenGo) genOpenAPI() {
+	base := strings.TrimSuffix(filepath.Base(gen.path), filepath.Ext(gen.path))
+	s := &schemaGen{gen: gen, ref: "#/components/schemas/", defs: object{}}
+	paths := object{}
+	for i := range gen.p.Interface {
+		itf := &gen.p.Interface[i]
+		for j := range itf.Fun {
+			fun := &itf.Fun[j]
+			name := fun.NameStr
+			if name == "" {
+				name = fun.Name
+			}
+			paths["/"+itf.TName+"/"+name] = object{"post": object{
+				"operationId": itf.TName + "_" + name,
+				"tags":        []string{itf.TName},
+				"requestBody": object{
+					"required": true,
+					"content":  jsonContent(s.argsSchema(fun, false)),
+				},
+				"responses": object{
+					"200": object{
+						"description": "the return value and the out parameters",
+						"content":     jsonContent(s.argsSchema(fun, true)),
+					},
+					"default": object{"description": "the call failed"},
+				},
+			}}
+		}
+	}
+	for i := range gen.p.Struct {
+		s.named(gen.p, gen.p.Struct[i].TName)
+	}
+	for i := range gen.p.Enum {
+		s.named(gen.p, gen.p.Enum[i].TName)
+	}
+	gen.saveFile(base+".openapi.json", object{
+		"openapi": "3.0.3",
+		"info": object{
+			"title":       origModule(gen.p),
+			"description": "Generated from " + filepath.Base(gen.path) + " by tars2go " + VERSION,
+			"version":     "1.0.0",
+		},
+		"paths":      paths,
+		"components": object{"schemas": s.defs},
+	})
+
+	for i := range gen.p.Struct {
+		st := &gen.p.Struct[i]
+		ss := &schemaGen{gen: gen, ref: "#/definitions/", defs: object{}}
+		root := ss.named(gen.p, st.TName)
+		schema := object{
+			"$schema":     "http://json-schema.org/draft-07/schema#",
+			"title":       origModule(gen.p) + "." + st.TName,
+			"allOf":       []interface{}{root},
+			"definitions": ss.defs,
+		}
+		gen.saveFile(st.TName+".schema.json", schema)
+	}
+}
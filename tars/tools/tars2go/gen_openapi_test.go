@@ -0,0 +1,154 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const openapiTars = `
+enum Color { RED = 1, GREEN = 2 };
+
+struct Inner
+{
+    0 optional int v;
+};
+
+struct User
+{
+    0 require int age;                   // @range(0, 150)
+    1 optional string name;              // @length(, 8)
+    2 optional Color color = GREEN;
+    3 require Inner in;
+    4 optional vector<byte> raw;
+    5 optional map<string, Inner> m;
+};
+
+interface Hello
+{
+    int testHello(string sReq, out string sRsp);
+    bool getUser(int id, out User u, out vector<Inner> all);
+    void ping();
+};`
+
+// readJSON reads the JSON file generated for the module App.
+func readJSON(t *testing.T, dir, name string) interface{} {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "App", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+// lookup returns the value at the path of the keys in the JSON document, or nil if there is none.
+func lookup(v interface{}, keys ...string) interface{} {
+	for _, k := range keys {
+		o, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = o[k]
+	}
+	return v
+}
+
+// jsonValue returns the value decoded from the JSON, to be compared with the documents.
+func jsonValue(t *testing.T, s string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+// jsonAt is the JSON value expected at the path of the keys.
+type jsonAt struct {
+	keys  []string
+	value string
+}
+
+func TestGenOpenAPI(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags []*bool
+		// the expected values at the paths of the document
+		expected []jsonAt
+	}{
+		{
+			name: "default",
+			expected: []jsonAt{
+				{[]string{"components", "schemas", "App.Color"}, `{"type": "integer", "format": "int32", "enum": [1, 2]}`},
+				{[]string{"components", "schemas", "App.User", "properties", "raw"}, `{"type": "array", "items": {"type": "integer", "format": "int32", "minimum": -128, "maximum": 127}}`},
+				{[]string{"components", "schemas", "App.User", "properties", "color"}, `{"allOf": [{"$ref": "#/components/schemas/App.Color"}], "default": 2}`},
+			},
+		},
+		{
+			name:  "json and bytes",
+			flags: []*bool{gJSON, gBytes},
+			expected: []jsonAt{
+				{[]string{"components", "schemas", "App.Color"}, `{"type": "string", "enum": ["RED", "GREEN"]}`},
+				{[]string{"components", "schemas", "App.User", "properties", "raw"}, `{"type": "string", "format": "byte"}`},
+				{[]string{"components", "schemas", "App.User", "properties", "color"}, `{"allOf": [{"$ref": "#/components/schemas/App.Color"}], "default": "GREEN"}`},
+			},
+		},
+	}
+	common := []jsonAt{
+		{[]string{"paths", "/Hello/testHello", "post", "operationId"}, `"Hello_testHello"`},
+		{[]string{"paths", "/Hello/getUser", "post", "operationId"}, `"Hello_getUser"`},
+		{[]string{"paths", "/Hello/ping", "post", "operationId"}, `"Hello_ping"`},
+		{[]string{"paths", "/Hello/testHello", "post", "requestBody", "content", "application/json", "schema"}, `{"type": "object", "properties": {"sReq": {"type": "string"}}, "required": ["sReq"]}`},
+		{[]string{"paths", "/Hello/testHello", "post", "responses", "200", "content", "application/json", "schema"}, `{"type": "object", "properties": {"tars_ret": {"type": "integer", "format": "int32"}, "sRsp": {"type": "string"}},
+			"required": ["tars_ret", "sRsp"]}`},
+		{[]string{"paths", "/Hello/getUser", "post", "responses", "200", "content", "application/json", "schema"}, `{"type": "object", "properties": {"tars_ret": {"type": "boolean"}, "u": {"$ref": "#/components/schemas/App.User"},
+			"all": {"type": "array", "items": {"$ref": "#/components/schemas/App.Inner"}}}, "required": ["tars_ret", "u", "all"]}`},
+		{[]string{"paths", "/Hello/getUser", "post", "requestBody", "content", "application/json", "schema"}, `{"type": "object", "properties": {"id": {"type": "integer", "format": "int32"}}, "required": ["id"]}`},
+		{[]string{"paths", "/Hello/ping", "post", "responses", "200", "content", "application/json", "schema"}, `{"type": "object", "properties": {}}`},
+		{[]string{"components", "schemas", "App.User", "required"}, `["age", "in"]`},
+		{[]string{"components", "schemas", "App.User", "properties", "age"}, `{"type": "integer", "format": "int32", "minimum": 0, "maximum": 150}`},
+		{[]string{"components", "schemas", "App.User", "properties", "name"}, `{"type": "string", "maxLength": 8}`},
+		{[]string{"components", "schemas", "App.User", "properties", "in"}, `{"$ref": "#/components/schemas/App.Inner"}`},
+		{[]string{"components", "schemas", "App.User", "properties", "m"}, `{"type": "object", "additionalProperties": {"$ref": "#/components/schemas/App.Inner"}}`},
+		{[]string{"components", "schemas", "App.Inner"}, `{"type": "object", "properties": {"v": {"type": "integer", "format": "int32"}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "openapi")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			generate(t, dir, openapiTars, append(tt.flags, gOpenAPI)...)
+
+			doc := readJSON(t, dir, "App.openapi.json")
+			if paths := lookup(doc, "paths").(map[string]interface{}); len(paths) != 3 {
+				t.Errorf("expected a path for every method, got %v", paths)
+			}
+			for _, e := range append(common, tt.expected...) {
+				if got := lookup(doc, e.keys...); !reflect.DeepEqual(got, jsonValue(t, e.value)) {
+					t.Errorf("%v: expected %s, got %v", e.keys, e.value, got)
+				}
+			}
+
+			schema := readJSON(t, dir, "User.schema.json")
+			if got := lookup(schema, "allOf"); !reflect.DeepEqual(got, jsonValue(t, `[{"$ref": "#/definitions/App.User"}]`)) {
+				t.Errorf("expected the struct schema referring to its definition, got %v", got)
+			}
+			if got := lookup(schema, "definitions", "App.User", "properties", "in"); !reflect.DeepEqual(got,
+				jsonValue(t, `{"$ref": "#/definitions/App.Inner"}`)) {
+				t.Errorf("expected the definitions referred in the struct schema, got %v", got)
+			}
+			for _, name := range []string{"App.Inner", "App.Color"} {
+				if lookup(schema, "definitions", name) == nil {
+					t.Errorf("%s not defined in the struct schema", name)
+				}
+			}
+		})
+	}
+}
@@ -142,6 +142,15 @@ type LexState struct {
 	buff      *bytes.Buffer
 
 	source string
+
+	// comments are kept for the annotations in them
+	comments []comment
+}
+
+// comment is the text of a comment and the line it starts at.
+type comment struct {
+	line int
+	text string
 }
 
 func isNewLine(b byte) bool {
@@ -285,12 +294,17 @@ func (ls *LexState) readString() (TK, *SemInfo) {
 }
 
 func (ls *LexState) readLongComment() {
+	line := ls.linenumber
+	defer func() {
+		ls.comments = append(ls.comments, comment{line: line, text: ls.tokenBuff.String()})
+	}()
 	for {
 		switch ls.current {
 		case EOS:
 			ls.lexErr("respect */")
 			return
 		case '\n', '\r':
+			ls.tokenBuff.WriteByte(' ')
 			ls.incLine()
 		case '*':
 			ls.next()
@@ -300,7 +314,9 @@ func (ls *LexState) readLongComment() {
 				ls.next()
 				return
 			}
+			ls.tokenBuff.WriteByte('*')
 		default:
+			ls.tokenBuff.WriteByte(ls.current)
 			ls.next()
 		}
 	}
@@ -340,8 +356,10 @@ func (ls *LexState) llex() (TK, *SemInfo) {
 			ls.next()
 			if ls.current == '/' {
 				for !isNewLine(ls.current) && ls.current != EOS {
+					ls.tokenBuff.WriteByte(ls.current)
 					ls.next()
 				}
+				ls.comments = append(ls.comments, comment{line: ls.linenumber, text: ls.tokenBuff.String()})
 			} else if ls.current == '*' {
 				ls.next()
 				ls.readLongComment()
@@ -25,6 +25,7 @@ func (t *importPath) Set(value string) error {
 }
 
 var gOutdir = flag.String("outdir", "", "which dir to put generated code")
+var gCompat = flag.Bool("compat", false, "Report the backward incompatible changes of the new tars file as JSON instead of generating code")
 var gImports importPath
 
 func printhelp() {
@@ -34,6 +35,8 @@ func printhelp() {
 	}
 	fmt.Printf("Usage: %s [flags] *.tars\n", bin)
 	fmt.Printf("       %s -I tars/protocol/res/endpoint [-I ...] QueryF.tars\n", bin)
+	fmt.Printf("       %s -compat old.tars new.tars\n", bin)
+	fmt.Printf("       %s -to-proto *.tars | -from-proto *.proto\n", bin)
 	flag.PrintDefaults()
 }
 
@@ -46,6 +49,28 @@ func main() {
 		printhelp()
 		os.Exit(0)
 	}
+	if *gCompat {
+		if flag.NArg() != 2 {
+			printhelp()
+			os.Exit(2)
+		}
+		os.Exit(checkCompat(flag.Arg(0), flag.Arg(1), os.Stdout))
+	}
+	if *gToProto || *gFromProto {
+		code := 0
+		for _, filename := range flag.Args() {
+			var c int
+			if *gToProto {
+				c = convertToProto(filename, *gOutdir)
+			} else {
+				c = convertToTars(filename, *gOutdir)
+			}
+			if c != 0 {
+				code = c
+			}
+		}
+		os.Exit(code)
+	}
 	for _, filename := range flag.Args() {
 		gen := NewGenGo(filename, *gOutdir)
 		gen.I = gImports
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
@@ -27,6 +28,8 @@ type StructMember struct {
 	KeyStr  string // original key
 	Default string
 	DefType TK
+	Rules   []ValidateRule // the annotations in the comments
+	line    int            // the line of the end
 }
 
 // StructMemberSorter When serializing, make sure the tags are ordered.
@@ -45,9 +48,10 @@ type StructInfo struct {
 
 //ArgInfo record argument information.
 type ArgInfo struct {
-	Name  string
-	IsOut bool
-	Type  *VarType
+	Name    string
+	NameStr string // the name in the tars file, the key of the TUP attribute
+	IsOut   bool
+	Type    *VarType
 }
 
 //FunInfo record function information.
@@ -68,8 +72,9 @@ type InterfaceInfo struct {
 
 //EnumMember record member information.
 type EnumMember struct {
-	Key   string
-	Value int32
+	Key    string
+	KeyStr string // original key
+	Value  int32
 }
 
 //EnumInfo record EnumMember information include name.
@@ -299,6 +304,7 @@ func (p *Parse) parseStructMember() *StructMember {
 
 	p.next()
 	if p.t.T == tkSemi {
+		m.line = p.t.Line
 		return m
 	}
 	if p.t.T != tkEq {
@@ -312,6 +318,7 @@ func (p *Parse) parseStructMember() *StructMember {
 	p.next()
 	p.parseStructMemberDefault(m)
 	p.expect(tkSemi)
+	m.line = p.t.Line
 
 	return m
 }
@@ -340,6 +347,7 @@ func (p *Parse) parseStruct() {
 		}
 	}
 	p.expect(tkBracel)
+	start := p.t.Line
 
 	for {
 		m := p.parseStructMember()
@@ -350,6 +358,7 @@ func (p *Parse) parseStruct() {
 	}
 	p.expect(tkSemi) //semicolon at the end of the struct.
 
+	p.parseAnnotations(&st, start)
 	p.checkTag(&st)
 	p.sortTag(&st)
 
@@ -686,7 +695,7 @@ func (p *Parse) analyzeDepend() {
 	for _, v := range p.Include {
 		pInc := ParseFile(v)
 		p.IncParse = append(p.IncParse, pInc)
-		fmt.Println("parse include: ", v)
+		fmt.Fprintln(os.Stderr, "parse include: ", v)
 	}
 
 	p.analyzeDefault()
@@ -0,0 +1,845 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var gFromProto = flag.Bool("from-proto", false, "Convert the proto files into tars files instead of generating code")
+
+// protoToken is a token of the proto file, the identifiers include the dots of the full names.
+type protoToken struct {
+	kind byte // 'i' identifier, 'n' number, 's' string, or the symbol
+	s    string
+	line int
+	doc  string // the comments before the token
+}
+
+type protoLexer struct {
+	src  []byte
+	pos  int
+	line int
+	doc  []string
+}
+
+func isProtoIdent(b byte) bool {
+	return isLetter(b) || b >= '0' && b <= '9' || b == '.'
+}
+
+func (l *protoLexer) next() (t protoToken, err error) {
+	for l.pos < len(l.src) {
+		b := l.src[l.pos]
+		switch {
+		case b == '\n':
+			l.line++
+			l.pos++
+		case b == ' ' || b == '\t' || b == '\r':
+			l.pos++
+		case strings.HasPrefix(string(l.src[l.pos:]), "//"):
+			end := bytes.IndexByte(l.src[l.pos:], '\n')
+			if end < 0 {
+				end = len(l.src) - l.pos
+			}
+			l.doc = append(l.doc, string(l.src[l.pos+2:l.pos+end]))
+			l.pos += end
+		case strings.HasPrefix(string(l.src[l.pos:]), "/*"):
+			end := bytes.Index(l.src[l.pos+2:], []byte("*/"))
+			if end < 0 {
+				return t, fmt.Errorf("%d: unterminated comment", l.line)
+			}
+			text := string(l.src[l.pos+2 : l.pos+2+end])
+			l.doc = append(l.doc, text)
+			l.line += strings.Count(text, "\n")
+			l.pos += end + 4
+		default:
+			return l.token()
+		}
+	}
+	return protoToken{kind: 0, line: l.line}, nil
+}
+
+func (l *protoLexer) token() (protoToken, error) {
+	t := protoToken{line: l.line, doc: strings.Join(l.doc, "\n")}
+	l.doc = nil
+	start := l.pos
+	b := l.src[l.pos]
+	switch {
+	case isLetter(b) || b == '.' && l.pos+1 < len(l.src) && isLetter(l.src[l.pos+1]):
+		for l.pos < len(l.src) && isProtoIdent(l.src[l.pos]) {
+			l.pos++
+		}
+		t.kind = 'i'
+	case b >= '0' && b <= '9' || b == '.':
+		for l.pos < len(l.src) && (isProtoIdent(l.src[l.pos]) ||
+			(l.src[l.pos] == '-' || l.src[l.pos] == '+') && (l.src[l.pos-1] == 'e' || l.src[l.pos-1] == 'E')) {
+			l.pos++
+		}
+		t.kind = 'n'
+	case b == '"' || b == '\'':
+		l.pos++
+		for l.pos < len(l.src) && l.src[l.pos] != b {
+			if l.src[l.pos] == '\\' {
+				l.pos++
+			}
+			l.pos++
+		}
+		if l.pos >= len(l.src) {
+			return t, fmt.Errorf("%d: unterminated string", l.line)
+		}
+		l.pos++
+		s, err := strconv.Unquote(`"` + strings.Replace(string(l.src[start+1:l.pos-1]), `"`, `\"`, -1) + `"`)
+		if err != nil {
+			return t, fmt.Errorf("%d: invalid string %s", l.line, l.src[start:l.pos])
+		}
+		t.kind = 's'
+		t.s = s
+		return t, nil
+	default:
+		l.pos++
+		t.kind = b
+	}
+	t.s = string(l.src[start:l.pos])
+	return t, nil
+}
+
+// protoField is a field of a message, or the key type and value type of a map in Key and Type.
+type protoField struct {
+	Label   string // optional, required, repeated or empty
+	Type    string
+	Key     string
+	Name    string
+	Number  int
+	Default string
+}
+
+type protoMessage struct {
+	Name   string // the full name in the proto file, like Outer.Inner
+	Doc    string
+	Fields []protoField
+}
+
+type protoEnumValue struct {
+	Name   string
+	Number int64
+}
+
+type protoEnum struct {
+	Name   string
+	Values []protoEnumValue
+}
+
+type protoRPC struct {
+	Name      string
+	In        string
+	Out       string
+	streaming bool
+}
+
+type protoService struct {
+	Name string
+	RPCs []protoRPC
+}
+
+// protoFile is the definitions in a proto file, the ones of the other files are not resolved.
+type protoFile struct {
+	Source   string
+	Syntax   string
+	Package  string
+	Imports  []string
+	Messages []*protoMessage
+	Enums    []*protoEnum
+	Services []*protoService
+}
+
+type protoParser struct {
+	warner
+	lex protoLexer
+	t   protoToken
+	f   *protoFile
+}
+
+func (p *protoParser) fail(format string, a ...interface{}) {
+	panic(fmt.Sprintf("%s: %d. %s", p.f.Source, p.t.line, fmt.Sprintf(format, a...)))
+}
+
+func (p *protoParser) next() {
+	t, err := p.lex.next()
+	if err != nil {
+		panic(p.f.Source + ": " + err.Error())
+	}
+	p.t = t
+}
+
+func (p *protoParser) is(s string) bool {
+	return p.t.kind != 's' && p.t.s == s
+}
+
+func (p *protoParser) expect(s string) {
+	if !p.is(s) {
+		p.fail("expect %s instead of %s", s, p.t.s)
+	}
+	p.next()
+}
+
+func (p *protoParser) ident() string {
+	if p.t.kind != 'i' {
+		p.fail("expect a name instead of %s", p.t.s)
+	}
+	s := p.t.s
+	p.next()
+	return s
+}
+
+// constant returns a constant as it is written, with the sign of the numbers.
+func (p *protoParser) constant() string {
+	sign := ""
+	if p.is("-") || p.is("+") {
+		sign = p.t.s
+		p.next()
+	}
+	t := p.t
+	if t.kind != 'i' && t.kind != 'n' && t.kind != 's' {
+		p.fail("expect a constant instead of %s", t.s)
+	}
+	p.next()
+	if t.kind == 's' {
+		return strconv.Quote(t.s)
+	}
+	return sign + t.s
+}
+
+// skipStatement skips to the end of the statement or the block.
+func (p *protoParser) skipStatement() {
+	depth := 0
+	for p.t.kind != 0 {
+		switch {
+		case p.is("{"):
+			depth++
+		case p.is("}"):
+			depth--
+			if depth == 0 {
+				p.next()
+				if p.is(";") {
+					p.next()
+				}
+				return
+			}
+		case p.is(";") && depth == 0:
+			p.next()
+			return
+		}
+		p.next()
+	}
+}
+
+// fieldOptions parses the options of a field and returns the default value.
+func (p *protoParser) fieldOptions() string {
+	def := ""
+	if !p.is("[") {
+		return def
+	}
+	p.next()
+	for {
+		// the names of the custom options are like (my.option).field
+		name := ""
+		for !p.is("=") {
+			if p.t.kind == 0 {
+				p.fail("expect =")
+			}
+			name += p.t.s
+			p.next()
+		}
+		p.next()
+		if p.is("{") {
+			p.skipStatement()
+		} else if v := p.constant(); name == "default" {
+			def = v
+		}
+		if !p.is(",") {
+			break
+		}
+		p.next()
+	}
+	p.expect("]")
+	return def
+}
+
+func (p *protoParser) parseField(msg *protoMessage) {
+	f := protoField{}
+	if p.is("optional") || p.is("required") || p.is("repeated") {
+		f.Label = p.t.s
+		p.next()
+	}
+	if p.is("map") {
+		p.next()
+		p.expect("<")
+		f.Key = p.ident()
+		p.expect(",")
+		f.Type = p.ident()
+		p.expect(">")
+	} else {
+		f.Type = p.ident()
+	}
+	f.Name = p.ident()
+	p.expect("=")
+	n, err := strconv.ParseInt(p.t.s, 0, 32)
+	if err != nil {
+		p.fail("invalid field number %s", p.t.s)
+	}
+	f.Number = int(n)
+	p.next()
+	f.Default = p.fieldOptions()
+	p.expect(";")
+	msg.Fields = append(msg.Fields, f)
+}
+
+func (p *protoParser) parseEnum(scope string) {
+	en := &protoEnum{Name: scope + p.ident()}
+	p.expect("{")
+	for !p.is("}") {
+		switch {
+		case p.is("option") || p.is("reserved"):
+			p.skipStatement()
+		case p.is(";"):
+			p.next()
+		default:
+			v := protoEnumValue{Name: p.ident()}
+			p.expect("=")
+			n, err := strconv.ParseInt(strings.TrimPrefix(p.constant(), "+"), 0, 32)
+			if err != nil {
+				p.fail("invalid value of %s", v.Name)
+			}
+			v.Number = n
+			p.fieldOptions()
+			p.expect(";")
+			en.Values = append(en.Values, v)
+		}
+	}
+	p.next()
+	p.f.Enums = append(p.f.Enums, en)
+}
+
+func (p *protoParser) parseMessage(scope string) {
+	msg := &protoMessage{Doc: p.t.doc}
+	p.next()
+	msg.Name = scope + p.ident()
+	p.f.Messages = append(p.f.Messages, msg)
+	p.expect("{")
+	p.parseMessageBody(msg)
+}
+
+func (p *protoParser) parseMessageBody(msg *protoMessage) {
+	for !p.is("}") {
+		if p.t.kind == 0 {
+			p.fail("expect }")
+		}
+		switch {
+		case p.is(";"):
+			p.next()
+		case p.is("message"):
+			p.parseMessage(msg.Name + ".")
+		case p.is("enum"):
+			p.next()
+			p.parseEnum(msg.Name + ".")
+		case p.is("oneof"):
+			p.next()
+			name := p.ident()
+			p.warn(msg.Name+"."+name, "oneof flattened into optional members, they are not exclusive in tars")
+			p.expect("{")
+			p.parseMessageBody(msg)
+		case p.is("option") || p.is("reserved") || p.is("extensions"):
+			p.skipStatement()
+		case p.is("extend"):
+			p.warn(msg.Name, "extend dropped, tars has no extensions")
+			p.skipStatement()
+		case p.is("group") || (p.is("optional") || p.is("required") || p.is("repeated")) && p.lexPeek() == "group":
+			p.warn(msg.Name, "group dropped, tars has no groups")
+			p.skipStatement()
+		default:
+			p.parseField(msg)
+		}
+	}
+	p.next()
+}
+
+// lexPeek returns the text of the token after the current one.
+func (p *protoParser) lexPeek() string {
+	lex := p.lex
+	t, err := lex.next()
+	if err != nil {
+		return ""
+	}
+	return t.s
+}
+
+func (p *protoParser) parseService() {
+	p.next()
+	svc := &protoService{Name: p.ident()}
+	p.expect("{")
+	for !p.is("}") {
+		if !p.is("rpc") {
+			p.skipStatement()
+			continue
+		}
+		rpc := protoRPC{}
+		p.next()
+		rpc.Name = p.ident()
+		p.expect("(")
+		if p.is("stream") && p.lexPeek() != ")" {
+			rpc.streaming = true
+			p.next()
+		}
+		rpc.In = p.ident()
+		p.expect(")")
+		p.expect("returns")
+		p.expect("(")
+		if p.is("stream") && p.lexPeek() != ")" {
+			rpc.streaming = true
+			p.next()
+		}
+		rpc.Out = p.ident()
+		p.expect(")")
+		if p.is("{") {
+			p.skipStatement()
+		} else {
+			p.expect(";")
+		}
+		svc.RPCs = append(svc.RPCs, rpc)
+	}
+	p.next()
+	p.f.Services = append(p.f.Services, svc)
+}
+
+func (p *protoParser) parse() {
+	p.next()
+	for p.t.kind != 0 {
+		switch {
+		case p.is(";"):
+			p.next()
+		case p.is("syntax"):
+			p.next()
+			p.expect("=")
+			p.f.Syntax = p.t.s
+			p.next()
+			p.expect(";")
+		case p.is("package"):
+			p.next()
+			p.f.Package = p.ident()
+			p.expect(";")
+		case p.is("import"):
+			p.next()
+			if p.is("public") || p.is("weak") {
+				p.next()
+			}
+			if p.t.kind != 's' {
+				p.fail("expect the file to import")
+			}
+			p.f.Imports = append(p.f.Imports, p.t.s)
+			p.next()
+			p.expect(";")
+		case p.is("option"):
+			p.skipStatement()
+		case p.is("message"):
+			p.parseMessage("")
+		case p.is("enum"):
+			p.next()
+			p.parseEnum("")
+		case p.is("service"):
+			p.parseService()
+		case p.is("extend"):
+			p.warn("extend", "dropped, tars has no extensions")
+			p.skipStatement()
+		default:
+			p.fail("unexpected %s", p.t.s)
+		}
+	}
+}
+
+// protoToTars converts a proto file into a tars file.
+type protoToTars struct {
+	*protoParser
+	buf       bytes.Buffer
+	module    string
+	local     map[string]string // the full names of the messages and enums to the tars names
+	enums     map[string]*protoEnum
+	unwrapped map[*protoRPC]string // the methods of the parameters wrapped by -to-proto
+}
+
+var tarsKeywords = func() map[string]bool {
+	m := make(map[string]bool)
+	for t := tkDummyKeywordBegin + 1; t < tkDummyTypeEnd; t++ {
+		if t != tkDummyKeywordEnd && t != tkDummyTypeBegin {
+			m[TokenMap[t]] = true
+		}
+	}
+	return m
+}()
+
+// tarsName returns the name as a tars identifier, the keywords get a trailing underscore.
+func (c *protoToTars) tarsName(where, name string) string {
+	if tarsKeywords[name] {
+		c.warn(where, "%s renamed to %s_, it is a tars keyword", name, name)
+		return name + "_"
+	}
+	return name
+}
+
+// moduleName returns the tars module of the proto package.
+func moduleName(pkg string) string {
+	return strings.Replace(pkg, ".", "_", -1)
+}
+
+// resolve returns the tars name of the message or enum referred in the scope, like the protoc
+// resolution from the innermost scope, and if it is an enum.
+func (c *protoToTars) resolve(scope string, name string) (string, *protoEnum) {
+	if strings.HasPrefix(name, ".") {
+		full := name[1:]
+		if c.f.Package != "" && strings.HasPrefix(full, c.f.Package+".") {
+			full = full[len(c.f.Package)+1:]
+		}
+		if t, ok := c.local[full]; ok {
+			return t, c.enums[full]
+		}
+		name = full
+	} else {
+		for s := scope; ; {
+			full := name
+			if s != "" {
+				full = s + "." + name
+			}
+			if t, ok := c.local[full]; ok {
+				return t, c.enums[full]
+			}
+			if s == "" {
+				break
+			}
+			if i := strings.LastIndex(s, "."); i >= 0 {
+				s = s[:i]
+			} else {
+				s = ""
+			}
+		}
+		if c.f.Package != "" && strings.HasPrefix(name, c.f.Package+".") {
+			if t, ok := c.local[name[len(c.f.Package)+1:]]; ok {
+				return t, c.enums[name[len(c.f.Package)+1:]]
+			}
+		}
+	}
+	// the types of the other files are pkg.Name
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return "", nil
+	}
+	if _, ok := c.local[name[:i]]; ok {
+		return "", nil
+	}
+	return moduleName(name[:i]) + "::" + name[i+1:], nil
+}
+
+var protoScalars = map[string]string{
+	"double":   "double",
+	"float":    "float",
+	"int32":    "int",
+	"sint32":   "int",
+	"sfixed32": "int",
+	"int64":    "long",
+	"sint64":   "long",
+	"sfixed64": "long",
+	"uint32":   "unsigned int",
+	"fixed32":  "unsigned int",
+	"uint64":   "long",
+	"fixed64":  "long",
+	"bool":     "bool",
+	"string":   "string",
+	"bytes":    "vector<byte>",
+}
+
+// tarsType returns the tars type of the proto type in the scope, or an empty string and the reason.
+func (c *protoToTars) tarsType(scope string, where string, name string) (string, *protoEnum, string) {
+	if t, ok := protoScalars[name]; ok {
+		if name == "uint64" || name == "fixed64" {
+			c.warn(where, "%s is long in tars, the values over the max of int64 are negative", name)
+		}
+		return t, nil, ""
+	}
+	if strings.HasPrefix(strings.TrimPrefix(name, "."), "google.protobuf.") {
+		return "", nil, "the well-known type " + name + " has no tars counterpart"
+	}
+	t, en := c.resolve(scope, name)
+	if t == "" {
+		return "", nil, "type " + name + " is not defined"
+	}
+	return t, en, ""
+}
+
+// defaultValue returns the tars default of the proto2 default.
+func (c *protoToTars) defaultValue(where string, f *protoField, en *protoEnum) string {
+	if f.Default == "" {
+		return ""
+	}
+	switch {
+	case en != nil:
+		for _, v := range en.Values {
+			if v.Name == f.Default {
+				return f.Default
+			}
+		}
+	case f.Type == "bool", f.Type == "string":
+		return f.Default
+	case f.Type == "bytes", strings.Contains(f.Default, "inf"), strings.Contains(f.Default, "nan"):
+	default:
+		if _, err := strconv.ParseFloat(f.Default, 64); err == nil {
+			return f.Default
+		}
+		if _, err := strconv.ParseInt(f.Default, 0, 64); err == nil {
+			return f.Default
+		}
+	}
+	c.warn(where, "default value %s dropped", f.Default)
+	return ""
+}
+
+// member returns the tars member or argument of the field, or an empty string if it can not be translated.
+func (c *protoToTars) member(scope string, where string, f *protoField) (string, string) {
+	ty, en, reason := c.tarsType(scope, where, f.Type)
+	if ty == "" {
+		c.warn(where, "dropped, %s", reason)
+		return "", ""
+	}
+	if f.Key != "" {
+		key, _, reason := c.tarsType(scope, where, f.Key)
+		if key == "" {
+			c.warn(where, "dropped, %s", reason)
+			return "", ""
+		}
+		return "map<" + key + ", " + ty + ">", ""
+	}
+	if f.Label == "repeated" {
+		return "vector<" + ty + ">", ""
+	}
+	return ty, c.defaultValue(where, f, en)
+}
+
+func (c *protoToTars) printf(format string, a ...interface{}) {
+	fmt.Fprintf(&c.buf, format, a...)
+}
+
+func (c *protoToTars) convertEnum(en *protoEnum) {
+	c.printf("    enum %s\n    {\n", c.local[en.Name])
+	for i, v := range en.Values {
+		sep := ","
+		if i == len(en.Values)-1 {
+			sep = ""
+		}
+		c.printf("        %s = %d%s\n", c.tarsName(en.Name, v.Name), v.Number, sep)
+	}
+	c.printf("    };\n\n")
+}
+
+func (c *protoToTars) convertMessage(msg *protoMessage) {
+	fields := append([]protoField(nil), msg.Fields...)
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Number < fields[j].Number })
+	c.printf("    struct %s\n    {\n", c.local[msg.Name])
+	for i := range fields {
+		f := &fields[i]
+		where := msg.Name + "." + f.Name
+		// the tags start with 0 and the field numbers with 1
+		tag := f.Number - 1
+		if tag > 255 {
+			c.warn(where, "dropped, the field number %d is over the max tag 255 of tars", f.Number)
+			continue
+		}
+		ty, def := c.member(msg.Name, where, f)
+		if ty == "" {
+			continue
+		}
+		req := "optional"
+		if f.Label == "required" {
+			req = "require"
+		}
+		if def != "" {
+			def = " = " + def
+		}
+		c.printf("        %d %s %s %s%s;\n", tag, req, ty, c.tarsName(where, f.Name), def)
+	}
+	c.printf("    };\n\n")
+}
+
+var wrapRe = regexp.MustCompile(`@tars\.(in|out)\s+(\S+)`)
+
+// wrappedFor returns the method of the message of the parameters generated by -to-proto, like
+// "in Servant.add".
+func wrappedFor(msg *protoMessage) string {
+	m := wrapRe.FindStringSubmatch(msg.Doc)
+	if m == nil {
+		return ""
+	}
+	return m[1] + " " + m[2]
+}
+
+func (c *protoToTars) findMessage(tarsName string) *protoMessage {
+	for _, msg := range c.f.Messages {
+		if c.local[msg.Name] == tarsName {
+			return msg
+		}
+	}
+	return nil
+}
+
+// unwrap returns the method of the parameters wrapped in the messages, or false if they are not
+// the messages generated by -to-proto for the method.
+func (c *protoToTars) unwrap(svc *protoService, rpc *protoRPC) (string, bool) {
+	in := c.findMessage(rpc.In)
+	out := c.findMessage(rpc.Out)
+	if in == nil || out == nil || wrappedFor(in) != "in "+svc.Name+"."+rpc.Name ||
+		wrappedFor(out) != "out "+svc.Name+"."+rpc.Name {
+		return "", false
+	}
+	where := svc.Name + "." + rpc.Name
+	// the messages are converted as they are if the parameters can not
+	warned := len(c.warnings)
+	ret := "void"
+	var args []string
+	for _, msg := range []*protoMessage{in, out} {
+		fields := append([]protoField(nil), msg.Fields...)
+		sort.SliceStable(fields, func(i, j int) bool { return fields[i].Number < fields[j].Number })
+		for i := range fields {
+			f := &fields[i]
+			ty, _ := c.member(msg.Name, where+"."+f.Name, f)
+			if ty == "" {
+				c.warnings = c.warnings[:warned]
+				return "", false
+			}
+			switch {
+			case msg == out && f.Name == strings.Trim(jsonRetKey, `"`):
+				ret = ty
+			case msg == out:
+				args = append(args, "out "+ty+" "+c.tarsName(where, f.Name))
+			default:
+				args = append(args, ty+" "+c.tarsName(where, f.Name))
+			}
+		}
+	}
+	return ret + " " + rpc.Name + "(" + strings.Join(args, ", ") + ");", true
+}
+
+func (c *protoToTars) convertService(svc *protoService) {
+	c.printf("    interface %s\n    {\n", c.tarsName(svc.Name, svc.Name))
+	for i := range svc.RPCs {
+		rpc := &svc.RPCs[i]
+		where := svc.Name + "." + rpc.Name
+		if rpc.streaming {
+			c.warn(where, "dropped, tars has no streaming calls")
+			continue
+		}
+		if fun, ok := c.unwrapped[rpc]; ok {
+			c.printf("        %s\n", fun)
+			continue
+		}
+		in, _, reason := c.tarsType("", where, rpc.In)
+		if in == "" {
+			c.warn(where, "dropped, %s", reason)
+			continue
+		}
+		out, _, reason := c.tarsType("", where, rpc.Out)
+		if out == "" {
+			c.warn(where, "dropped, %s", reason)
+			continue
+		}
+		// the same as the methods of pb2tarsgo
+		c.printf("        void %s(%s input, out %s output);\n", c.tarsName(where, rpc.Name), in, out)
+	}
+	c.printf("    };\n\n")
+}
+
+func (c *protoToTars) convert() []byte {
+	f := c.f
+	c.module = moduleName(f.Package)
+	if c.module == "" {
+		c.module = strings.TrimSuffix(filepath.Base(f.Source), filepath.Ext(f.Source))
+		c.warn("package", "no package, the module is named %s", c.module)
+	}
+	c.local = make(map[string]string)
+	c.enums = make(map[string]*protoEnum)
+	for _, msg := range f.Messages {
+		// the nested messages and enums are flattened as Outer_Inner
+		c.local[msg.Name] = strings.Replace(msg.Name, ".", "_", -1)
+	}
+	for _, en := range f.Enums {
+		c.local[en.Name] = strings.Replace(en.Name, ".", "_", -1)
+		c.enums[en.Name] = en
+	}
+
+	c.printf("// Generated from %s by tars2go %s.\n\n", filepath.Base(f.Source), VERSION)
+	for _, imp := range f.Imports {
+		if strings.HasPrefix(imp, "google/protobuf/") {
+			c.warn(imp, "import dropped, the well-known types have no tars counterpart")
+			continue
+		}
+		c.printf("#include %q\n", strings.TrimSuffix(imp, filepath.Ext(imp))+".tars")
+	}
+	if len(f.Imports) > 0 {
+		c.printf("\n")
+	}
+	c.printf("module %s\n{\n", c.module)
+
+	c.unwrapped = make(map[*protoRPC]string)
+	wrapped := make(map[*protoMessage]bool)
+	for _, svc := range f.Services {
+		for i := range svc.RPCs {
+			rpc := &svc.RPCs[i]
+			if fun, ok := c.unwrap(svc, rpc); ok && !rpc.streaming {
+				c.unwrapped[rpc] = fun
+				wrapped[c.findMessage(rpc.In)] = true
+				wrapped[c.findMessage(rpc.Out)] = true
+			}
+		}
+	}
+	for _, en := range f.Enums {
+		c.convertEnum(en)
+	}
+	for _, msg := range f.Messages {
+		if !wrapped[msg] {
+			c.convertMessage(msg)
+		}
+	}
+	for _, svc := range f.Services {
+		c.convertService(svc)
+	}
+	out := bytes.TrimRight(c.buf.Bytes(), "\n")
+	return append(out, "\n};\n"...)
+}
+
+// convertToTars converts the proto file into outdir, it returns the exit code.
+func convertToTars(path string, outdir string) (code int) {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	p := &protoParser{warner: warner{source: path}, f: &protoFile{Source: path}}
+	p.lex = protoLexer{src: src, line: 1}
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Fprintln(os.Stderr, r)
+			code = 1
+		}
+	}()
+	p.parse()
+	c := &protoToTars{protoParser: p}
+	data := c.convert()
+	c.flush()
+	if err := saveConverted(path, outdir, ".tars", data); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
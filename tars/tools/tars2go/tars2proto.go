@@ -0,0 +1,351 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var gToProto = flag.Bool("to-proto", false, "Convert the tars files into proto3 files instead of generating code")
+
+// warner collects the warnings of the constructs that can not be translated faithfully.
+type warner struct {
+	source   string
+	warnings []string
+}
+
+func (w *warner) warn(where string, format string, a ...interface{}) {
+	w.warnings = append(w.warnings, w.source+": "+where+": "+fmt.Sprintf(format, a...))
+}
+
+func (w *warner) flush() {
+	for _, s := range w.warnings {
+		fmt.Fprintln(os.Stderr, "warning:", s)
+	}
+}
+
+// saveConverted writes the converted file named after the source with the new extension into outdir.
+func saveConverted(source string, outdir string, ext string, data []byte) error {
+	base := strings.TrimSuffix(filepath.Base(source), filepath.Ext(source))
+	if outdir != "" {
+		if err := os.MkdirAll(outdir, 0766); err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(filepath.Join(outdir, base+ext), data, 0666)
+}
+
+// tarsToProto converts a tars file into a proto3 file.
+type tarsToProto struct {
+	warner
+	p    *Parse
+	buf  bytes.Buffer
+	used map[string]bool // the names of the messages and enums
+}
+
+func (c *tarsToProto) printf(format string, a ...interface{}) {
+	fmt.Fprintf(&c.buf, format, a...)
+}
+
+// protoType returns the proto type of the tars type, or an empty string if there is no such type.
+func (c *tarsToProto) protoType(ty *VarType) string {
+	switch ty.Type {
+	case tkTBool:
+		return "bool"
+	case tkTByte, tkTShort, tkTInt:
+		if ty.Unsigned {
+			return "uint32"
+		}
+		return "int32"
+	case tkTLong:
+		return "int64"
+	case tkTFloat:
+		return "float"
+	case tkTDouble:
+		return "double"
+	case tkTString:
+		return "string"
+	case tkTVector:
+		if ty.TypeK.Type == tkTByte && !ty.TypeK.Unsigned {
+			return "bytes"
+		}
+	case tkName:
+		return strings.Replace(ty.TypeSt, "::", ".", 1)
+	}
+	return ""
+}
+
+// fieldType returns the type of the proto field of the tars type, or an empty string and the reason
+// if it can not be translated.
+func (c *tarsToProto) fieldType(ty *VarType) (string, string) {
+	switch ty.Type {
+	case tkTVector:
+		if t := c.protoType(ty); t != "" {
+			return t, ""
+		}
+		if ty.TypeK.Type == tkTVector && c.protoType(ty.TypeK) != "bytes" || ty.TypeK.Type == tkTMap {
+			return "", "proto has no nested repeated fields for " + typeString(ty)
+		}
+		return "repeated " + c.protoType(ty.TypeK), ""
+	case tkTMap:
+		switch ty.TypeK.Type {
+		case tkTBool, tkTByte, tkTShort, tkTInt, tkTLong, tkTString:
+		default:
+			return "", "proto only has map keys of integers, bool and string for " + typeString(ty)
+		}
+		if ty.TypeV.Type == tkTMap || ty.TypeV.Type == tkTVector && c.protoType(ty.TypeV) != "bytes" {
+			return "", "proto has no map values of repeated fields or maps for " + typeString(ty)
+		}
+		return "map<" + c.protoType(ty.TypeK) + ", " + c.protoType(ty.TypeV) + ">", ""
+	}
+	return c.protoType(ty), ""
+}
+
+// isZeroDefault checks if the default value is the zero value, the only default of proto3.
+func (c *tarsToProto) isZeroDefault(m *StructMember) bool {
+	switch m.DefType {
+	case tkInteger, tkFloat:
+		f, err := strconv.ParseFloat(m.Default, 64)
+		if err != nil {
+			n, _ := strconv.ParseInt(m.Default, 0, 64)
+			return n == 0
+		}
+		return f == 0
+	case tkString:
+		return m.Default == `""`
+	case tkFalse:
+		return true
+	case tkName:
+		en := c.findEnum(m.Type.TypeSt)
+		if en == nil {
+			return false
+		}
+		for _, v := range en.Mb {
+			if m.Default == en.TName+"_"+v.Key {
+				return v.Value == 0
+			}
+		}
+		return false
+	}
+	return m.Default == ""
+}
+
+func (c *tarsToProto) findEnum(name string) *EnumInfo {
+	p := c.p
+	if i := strings.Index(name, "::"); i >= 0 {
+		if p = findModule(c.p, name[:i]); p == nil {
+			return nil
+		}
+		name = name[i+2:]
+	}
+	for i := range p.Enum {
+		if p.Enum[i].TName == name {
+			return &p.Enum[i]
+		}
+	}
+	return nil
+}
+
+func (c *tarsToProto) convertEnum(en *EnumInfo) {
+	var zero *EnumMember
+	values := make(map[int32]bool)
+	alias := false
+	for i := range en.Mb {
+		if en.Mb[i].Value == 0 && zero == nil {
+			zero = &en.Mb[i]
+		}
+		alias = alias || values[en.Mb[i].Value]
+		values[en.Mb[i].Value] = true
+	}
+	c.printf("enum %s {\n", en.TName)
+	if alias {
+		c.printf("  option allow_alias = true;\n")
+	}
+	// the first value of the proto3 enums must be 0
+	if zero == nil {
+		c.warn(en.TName, "proto3 enums start with 0, %s_UNSPECIFIED = 0 is added", en.TName)
+		c.printf("  %s_UNSPECIFIED = 0;\n", en.TName)
+	} else {
+		c.printf("  %s = 0;\n", zero.Key)
+	}
+	for i := range en.Mb {
+		if &en.Mb[i] != zero {
+			c.printf("  %s = %d;\n", en.Mb[i].Key, en.Mb[i].Value)
+		}
+	}
+	c.printf("}\n\n")
+}
+
+func (c *tarsToProto) convertStruct(st *StructInfo) {
+	c.printf("message %s {\n", st.TName)
+	for i := range st.Mb {
+		m := &st.Mb[i]
+		where := st.TName + "." + m.Key
+		ty, reason := c.fieldType(m.Type)
+		if ty == "" {
+			c.warn(where, "dropped, %s", reason)
+			continue
+		}
+		if m.Require {
+			c.warn(where, "require dropped, proto3 fields are optional")
+		}
+		if !c.isZeroDefault(m) {
+			def := m.Default
+			if en := c.findEnum(m.Type.TypeSt); en != nil && m.DefType == tkName {
+				def = strings.TrimPrefix(def, en.TName+"_")
+			}
+			c.warn(where, "default value %s dropped, proto3 fields default to zero", def)
+		}
+		// the field numbers start with 1 and the tags with 0
+		c.printf("  %s %s = %d;\n", ty, m.Key, m.Tag+1)
+	}
+	c.printf("}\n\n")
+}
+
+// uniqueName returns the name of a generated message, prefixed with the interface if it is used.
+func (c *tarsToProto) uniqueName(itf *InterfaceInfo, name string) string {
+	if c.used[name] {
+		name = itf.TName + name
+	}
+	c.used[name] = true
+	return name
+}
+
+// isDirect checks if the method is like the ones of pb2tarsgo, void f(Req input, out Rsp output),
+// which is translated into rpc f(Req) returns (Rsp) without the messages of the parameters.
+func isDirect(fun *FunInfo) bool {
+	if fun.HasRet || len(fun.Args) != 2 || fun.Args[0].IsOut || !fun.Args[1].IsOut {
+		return false
+	}
+	return fun.Args[0].Type.CType == tkStruct && fun.Args[1].Type.CType == tkStruct
+}
+
+func argKey(a *ArgInfo, i int) string {
+	if a.Name == "" {
+		return "arg" + strconv.Itoa(i)
+	}
+	return a.Name
+}
+
+func (c *tarsToProto) convertInterface(itf *InterfaceInfo) {
+	var rpcs []string
+	for i := range itf.Fun {
+		fun := &itf.Fun[i]
+		where := itf.TName + "." + fun.Name
+		if isDirect(fun) {
+			rpcs = append(rpcs, fmt.Sprintf("  rpc %s(%s) returns (%s);\n",
+				fun.Name, c.protoType(fun.Args[0].Type), c.protoType(fun.Args[1].Type)))
+			continue
+		}
+
+		// the parameters are wrapped in messages, marked for converting back
+		var in, out []string
+		if fun.HasRet {
+			ty, reason := c.fieldType(fun.RetType)
+			if ty == "" {
+				c.warn(where, "dropped, %s", reason)
+				continue
+			}
+			out = append(out, ty+" "+strings.Trim(jsonRetKey, `"`))
+		}
+		ok := true
+		for j := range fun.Args {
+			a := &fun.Args[j]
+			ty, reason := c.fieldType(a.Type)
+			if ty == "" {
+				c.warn(where, "dropped, %s", reason)
+				ok = false
+				break
+			}
+			if a.IsOut {
+				out = append(out, ty+" "+argKey(a, j))
+			} else {
+				in = append(in, ty+" "+argKey(a, j))
+			}
+		}
+		if !ok {
+			continue
+		}
+		title := strings.ToUpper(fun.Name[:1]) + fun.Name[1:]
+		req := c.uniqueName(itf, title+"Request")
+		rsp := c.uniqueName(itf, title+"Response")
+		c.printf("// @tars.in %s.%s\nmessage %s {\n", itf.TName, fun.Name, req)
+		for j, f := range in {
+			c.printf("  %s = %d;\n", f, j+1)
+		}
+		c.printf("}\n\n// @tars.out %s.%s\nmessage %s {\n", itf.TName, fun.Name, rsp)
+		for j, f := range out {
+			c.printf("  %s = %d;\n", f, j+1)
+		}
+		c.printf("}\n\n")
+		rpcs = append(rpcs, fmt.Sprintf("  rpc %s(%s) returns (%s);\n", fun.Name, req, rsp))
+	}
+	c.printf("service %s {\n%s}\n\n", itf.TName, strings.Join(rpcs, ""))
+}
+
+func (c *tarsToProto) convert() []byte {
+	p := c.p
+	c.printf("// Generated from %s by tars2go %s.\n\n", filepath.Base(p.Source), VERSION)
+	c.printf("syntax = \"proto3\";\n\npackage %s;\n\n", p.Module)
+	for _, inc := range p.Include {
+		c.printf("import %q;\n", strings.TrimSuffix(inc, filepath.Ext(inc))+".proto")
+	}
+	if len(p.Include) > 0 {
+		c.printf("\n")
+	}
+	for _, v := range p.Const {
+		c.warn(v.Key, "const dropped, proto has no constants")
+	}
+	for _, v := range p.HashKey {
+		c.warn(v.Name, "key dropped, proto has no keys")
+	}
+
+	c.used = make(map[string]bool)
+	for _, v := range p.Enum {
+		c.used[v.TName] = true
+	}
+	for _, v := range p.Struct {
+		c.used[v.TName] = true
+	}
+	enumKeys := make(map[string]string)
+	for i := range p.Enum {
+		en := &p.Enum[i]
+		for _, v := range en.Mb {
+			// the enum values are in the scope of the package
+			if other, ok := enumKeys[v.Key]; ok && other != en.TName {
+				c.warn(en.TName+"."+v.Key, "conflicts with %s.%s, proto enum values share the package scope", other, v.Key)
+			}
+			enumKeys[v.Key] = en.TName
+		}
+		c.convertEnum(en)
+	}
+	for i := range p.Struct {
+		c.convertStruct(&p.Struct[i])
+	}
+	for i := range p.Interface {
+		c.convertInterface(&p.Interface[i])
+	}
+	return bytes.TrimRight(c.buf.Bytes(), "\n")
+}
+
+// convertToProto converts the tars file into outdir, it returns the exit code.
+func convertToProto(path string, outdir string) int {
+	p, err := parseForCompat(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	c := &tarsToProto{warner: warner{source: path}, p: p}
+	data := append(c.convert(), '\n')
+	c.flush()
+	if err := saveConverted(path, outdir, ".proto", data); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
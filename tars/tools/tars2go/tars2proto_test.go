@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// describe lists the enums, structs and interfaces of the parsed file, one line for each member.
+func describe(p *Parse) []string {
+	var lines []string
+	for _, en := range p.Enum {
+		for _, m := range en.Mb {
+			lines = append(lines, fmt.Sprintf("enum %s %s = %d", en.TName, m.Key, m.Value))
+		}
+	}
+	for _, st := range p.Struct {
+		for _, m := range st.Mb {
+			req := "optional"
+			if m.Require {
+				req = "require"
+			}
+			lines = append(lines, fmt.Sprintf("struct %s %d %s %s %s", st.TName, m.Tag, req, typeString(m.Type), m.Key))
+		}
+	}
+	for _, itf := range p.Interface {
+		for _, fun := range itf.Fun {
+			var args []string
+			for _, a := range fun.Args {
+				args = append(args, direction(a)+" "+typeString(a.Type)+" "+a.Name)
+			}
+			lines = append(lines, fmt.Sprintf("interface %s %s %s(%s)", itf.TName, typeString(fun.RetType), fun.Name, strings.Join(args, ", ")))
+		}
+	}
+	return lines
+}
+
+// parseTars parses the tars source written into the dir.
+func parseTars(t *testing.T, dir, src string) *Parse {
+	path := filepath.Join(dir, "App.tars")
+	if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p, err := parseForCompat(path)
+	if err != nil {
+		t.Fatalf("parse %s: %v", src, err)
+	}
+	return p
+}
+
+// protoToTarsSource converts the proto source, it returns the tars source and the warnings.
+func protoToTarsSource(t *testing.T, src string) (out string, warnings []string) {
+	p := &protoParser{warner: warner{source: "App.proto"}, f: &protoFile{Source: "App.proto"}}
+	p.lex = protoLexer{src: []byte(src), line: 1}
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("parse %s: %v", src, r)
+		}
+	}()
+	p.parse()
+	c := &protoToTars{protoParser: p}
+	return string(c.convert()), c.warnings
+}
+
+func TestTarsProtoRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name     string
+		tars     string
+		expected string // the body after converting back, the same as tars if empty
+		warnings []string
+	}{
+		{
+			name: "struct",
+			tars: "struct A { 0 optional int x; 1 optional string s; 2 optional vector<string> v; 3 optional map<string, long> m; 4 optional bool b; };",
+		},
+		{
+			name:     "require",
+			tars:     "struct A { 0 require int x; 1 optional double d; };",
+			expected: "struct A { 0 optional int x; 1 optional double d; };",
+			warnings: []string{"App.tars: A.x: require dropped, proto3 fields are optional"},
+		},
+		{
+			name:     "narrow integers",
+			tars:     "struct A { 0 optional byte b; 1 optional short s; 2 optional vector<byte> bs; };",
+			expected: "struct A { 0 optional int b; 1 optional int s; 2 optional vector<byte> bs; };",
+		},
+		{
+			name: "enum",
+			tars: "enum E { E_A = 0, E_B = 2 }; struct A { 0 optional E e; };",
+		},
+		{
+			name:     "enum without zero",
+			tars:     "enum E { E_A = 1 };",
+			expected: "enum E { E_UNSPECIFIED = 0, E_A = 1 };",
+			warnings: []string{"App.tars: E: proto3 enums start with 0, E_UNSPECIFIED = 0 is added"},
+		},
+		{
+			name:     "default",
+			tars:     "struct A { 0 optional int x = 1; 1 optional string s = \"\"; };",
+			expected: "struct A { 0 optional int x; 1 optional string s; };",
+			warnings: []string{"App.tars: A.x: default value 1 dropped, proto3 fields default to zero"},
+		},
+		{
+			name:     "nested vector",
+			tars:     "struct A { 0 optional int x; 1 optional vector<vector<int>> vv; };",
+			expected: "struct A { 0 optional int x; };",
+			warnings: []string{"App.tars: A.vv: dropped, proto has no nested repeated fields for vector<vector<int>>"},
+		},
+		{
+			name:     "const",
+			tars:     "const int N = 1; struct A { 0 optional int x; };",
+			expected: "struct A { 0 optional int x; };",
+			warnings: []string{"App.tars: N: const dropped, proto has no constants"},
+		},
+		{
+			name: "direct method",
+			tars: "struct Req { 0 optional int a; }; struct Rsp { 0 optional int b; }; interface I { void f(Req input, out Rsp output); };",
+		},
+		{
+			name: "wrapped method",
+			tars: "struct A { 0 optional int x; }; interface I { int add(int a, int b, out int c); void get(A a, out vector<A> as); };",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := "module App\n{\n" + tt.tars + "\n};\n"
+			p := parseTars(t, dir, src)
+			c := &tarsToProto{warner: warner{source: "App.tars"}, p: p}
+			proto := string(c.convert())
+			if !reflect.DeepEqual(c.warnings, tt.warnings) {
+				t.Errorf("expected warnings %q, got %q", tt.warnings, c.warnings)
+			}
+
+			back, warnings := protoToTarsSource(t, proto)
+			if len(warnings) != 0 {
+				t.Errorf("unexpected warnings converting back %q", warnings)
+			}
+			expected := tt.expected
+			if expected == "" {
+				expected = tt.tars
+			}
+			want := describe(parseTars(t, dir, "module App\n{\n"+expected+"\n};\n"))
+			if got := describe(parseTars(t, dir, back)); !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %q, got %q\nproto:\n%s\ntars:\n%s", want, got, proto, back)
+			}
+		})
+	}
+}
+
+func TestProtoToTars(t *testing.T) {
+	dir, err := ioutil.TempDir("", "convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name     string
+		proto    string
+		expected string
+		warnings []string
+	}{
+		{
+			name:     "message",
+			proto:    "message A { int32 x = 1; repeated string s = 2; map<string, int64> m = 5; bytes b = 6; }",
+			expected: "struct A { 0 optional int x; 1 optional vector<string> s; 4 optional map<string, long> m; 5 optional vector<byte> b; };",
+		},
+		{
+			name:     "nested",
+			proto:    "message A { message B { int32 y = 1; } enum E { E_ZERO = 0; } B b = 1; E e = 2; }",
+			expected: "enum A_E { E_ZERO = 0 }; struct A { 0 optional A_B b; 1 optional A_E e; }; struct A_B { 0 optional int y; };",
+		},
+		{
+			name:     "service",
+			proto:    "message Req { int32 a = 1; } message Rsp { int32 b = 1; } service S { rpc f(Req) returns (Rsp); }",
+			expected: "struct Req { 0 optional int a; }; struct Rsp { 0 optional int b; }; interface S { void f(Req input, out Rsp output); };",
+		},
+		{
+			name:     "streaming",
+			proto:    "message Req { int32 a = 1; } service S { rpc f(stream Req) returns (Req); rpc g(Req) returns (Req); }",
+			expected: "struct Req { 0 optional int a; }; interface S { void g(Req input, out Req output); };",
+			warnings: []string{"App.proto: S.f: dropped, tars has no streaming calls"},
+		},
+		{
+			name:     "well-known import",
+			proto:    "import \"google/protobuf/empty.proto\"; message A { int32 x = 1; }",
+			expected: "struct A { 0 optional int x; };",
+			warnings: []string{"App.proto: google/protobuf/empty.proto: import dropped, the well-known types have no tars counterpart"},
+		},
+		{
+			name:     "large field number",
+			proto:    "message A { int32 x = 1; int32 y = 300; }",
+			expected: "struct A { 0 optional int x; };",
+			warnings: []string{"App.proto: A.y: dropped, the field number 300 is over the max tag 255 of tars"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, warnings := protoToTarsSource(t, "syntax = \"proto3\";\npackage App;\n"+tt.proto+"\n")
+			if !reflect.DeepEqual(warnings, tt.warnings) {
+				t.Errorf("expected warnings %q, got %q", tt.warnings, warnings)
+			}
+			want := describe(parseTars(t, dir, "module App\n{\n"+tt.expected+"\n};\n"))
+			if got := describe(parseTars(t, dir, out)); !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %q, got %q\ntars:\n%s", want, got, out)
+			}
+		})
+	}
+}
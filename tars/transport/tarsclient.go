@@ -1,6 +1,7 @@
 package transport
 
 import (
+	"errors"
 	"io"
 	"net"
 	"sync"
@@ -34,8 +35,11 @@ type TarsClient struct {
 	conf      *TarsClientConf
 	sendQueue chan []byte
 	//recvQueue chan []byte
+	closed bool
 }
 
+var errClientClosed = errors.New("tars client closed")
+
 type connection struct {
 	tc *TarsClient
 
@@ -68,13 +72,16 @@ func (tc *TarsClient) Send(req []byte) error {
 	return nil
 }
 
-//Close close the client connection with the server.
+//Close close the client connection with the server, the client can not send anymore.
 func (tc *TarsClient) Close() {
 	w := tc.conn
+	w.connLock.Lock()
+	tc.closed = true
 	if !w.isClosed && w.conn != nil {
 		w.isClosed = true
 		w.conn.Close()
 	}
+	w.connLock.Unlock()
 }
 
 func (c *connection) send(conn net.Conn) {
@@ -173,6 +180,10 @@ func (c *connection) recv(conn net.Conn) {
 
 func (c *connection) reConnect() (err error) {
 	c.connLock.Lock()
+	if c.tc.closed {
+		c.connLock.Unlock()
+		return errClientClosed
+	}
 	if c.isClosed {
 		TLOG.Debug("Connect:", c.tc.address)
 		c.conn, err = net.Dial(c.tc.conf.Proto, c.tc.address)
@@ -57,6 +57,10 @@ func (h *tcpHandler) handleConn(conn *net.TCPConn, pkg []byte) {
 			TLOG.Error("Failed to set context with client port")
 		}
 		rsp := h.ts.invoke(ctx, pkg)
+		if len(rsp) == 0 {
+			// oneway request, nothing to send back
+			return
+		}
 		if _, err := conn.Write(rsp); err != nil {
 			TLOG.Errorf("send pkg to %v failed %v", remoteAddr, err)
 		}
@@ -44,6 +44,9 @@ func (h *udpHandler) Handle() error {
 		go func() {
 			ctx := context.Background()
 			rsp := h.ts.invoke(ctx, pkg[4:]) // no need to check package
+			if len(rsp) == 0 {
+				return
+			}
 			if _, err := h.conn.WriteToUDP(rsp, udpAddr); err != nil {
 				TLOG.Errorf("send pkg to %v failed %v", udpAddr, err)
 			}
@@ -2,33 +2,56 @@ package endpoint
 
 import "github.com/TarsCloud/TarsGo/tars/protocol/res/endpointf"
 
+//istcpSSL is the istcp of the ssl endpoints in EndpointF, 0 is udp and 1 is tcp.
+const istcpSSL = 2
+
 //Tars2endpoint make endpointf.EndpointF to Endpoint struct.
 func Tars2endpoint(end endpointf.EndpointF) Endpoint {
-	proto := "tcp"
-	if end.Istcp == 0 {
+	proto, istcp := "tcp", end.Istcp
+	switch end.Istcp {
+	case 0:
 		proto = "udp"
+	case istcpSSL:
+		proto, istcp = "ssl", 1
 	}
 	return Endpoint{
 		Host:    end.Host,
 		Port:    int32(end.Port),
 		Timeout: int32(end.Timeout),
-		Istcp:   end.Istcp,
+		Istcp:   istcp,
 		Proto:   proto,
 		Bind:    "",
 		//Container: end.ContainerName,
-		SetId: end.SetId,
+		SetId:      end.SetId,
+		Weight:     end.Weight,
+		WeightType: end.WeightType,
+		Grid:       end.Grid,
+		Qos:        end.Qos,
+		Encrypt:    end.Encrypt,
+		AuthType:   end.AuthType,
 	}
 
 }
 
-//Endpoint2tars transfer Endpoint to endpointf.EndpointF
+//Endpoint2tars transfer Endpoint to endpointf.EndpointF. EndpointF has no unix, bind and version,
+//the unix endpoints become tcp ones.
 func Endpoint2tars(end Endpoint) endpointf.EndpointF {
+	istcp := end.Istcp
+	if end.Proto == "ssl" {
+		istcp = istcpSSL
+	}
 	return endpointf.EndpointF{
 		Host:    end.Host,
 		Port:    int32(end.Port),
 		Timeout: int32(end.Timeout),
-		Istcp:   end.Istcp,
+		Istcp:   istcp,
 		//	ContainerName: end.Container,
-		SetId: end.SetId,
+		SetId:      end.SetId,
+		Weight:     end.Weight,
+		WeightType: end.WeightType,
+		Grid:       end.Grid,
+		Qos:        end.Qos,
+		AuthType:   end.AuthType,
+		Encrypt:    end.Encrypt,
 	}
 }
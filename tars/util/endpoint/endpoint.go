@@ -2,12 +2,19 @@ package endpoint
 
 //Endpoint struct is used record a remote server instance.
 type Endpoint struct {
-	Host      string
-	Port      int32
-	Timeout   int32
-	Istcp     int32 //need remove
-	Proto     string
-	Bind      string
-	Container string
-	SetId     string
+	Host       string
+	Port       int32
+	Timeout    int32
+	Istcp      int32 //need remove
+	Proto      string
+	Bind       string
+	Container  string
+	SetId      string
+	Weight     int32
+	WeightType int32
+	Grid       int32
+	Qos        int32
+	Encrypt    int32
+	AuthType   int32
+	Version    int32
 }
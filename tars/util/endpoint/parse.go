@@ -1,32 +1,140 @@
 package endpoint
 
 import (
-	"flag"
+	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 )
 
-//Parse pares string to struct Endpoint, like tcp -h 10.219.139.142 -p 19386 -t 60000
-func Parse(endpoint string) Endpoint {
-	//tcp -h 10.219.139.142 -p 19386 -t 60000
-	proto := endpoint[0:3]
-	pFlag := flag.NewFlagSet(proto, flag.ContinueOnError)
-	var host, bind string
-	var port, timeout int
-	pFlag.StringVar(&host, "h", "", "host")
-	pFlag.IntVar(&port, "p", 0, "port")
-	pFlag.IntVar(&timeout, "t", 3000, "timeout")
-	pFlag.StringVar(&bind, "b", "", "bind")
-	pFlag.Parse(strings.Fields(endpoint)[1:])
-	istcp := int32(0)
-	if proto == "tcp" {
-		istcp = int32(1)
-	}
-	return Endpoint{
-		Host:    host,
-		Port:    int32(port),
-		Timeout: int32(timeout),
-		Istcp:   istcp,
-		Proto:   proto,
-		Bind:    bind,
+//DefaultTimeout is the timeout in ms of the endpoint without -t.
+const DefaultTimeout = 3000
+
+//IsStream returns whether the protocol is a stream one, tcp, ssl and unix are stream protocols.
+func IsStream(proto string) bool {
+	return proto == "tcp" || proto == "ssl" || proto == "unix"
+}
+
+//IsSupported returns whether the transport speaks the protocol, only tcp and udp for now.
+//The ssl and unix endpoints are parsed for the config shared with the other tars implementations.
+func IsSupported(proto string) bool {
+	return proto == "tcp" || proto == "udp"
+}
+
+//Parse parses string to struct Endpoint, like tcp -h 10.219.139.142 -p 19386 -t 60000.
+//The protocol is one of tcp, udp, ssl and unix, followed by the options:
+// -h host (the socket path for unix), -p port, -t timeout in ms, -b bind address, -s set id,
+// -w weight, -v version, -g grid, -q qos, -e encrypt and -a auth type.
+func Parse(endpoint string) (Endpoint, error) {
+	fields := strings.Fields(endpoint)
+	if len(fields) == 0 {
+		return Endpoint{}, errors.New("empty endpoint")
+	}
+	e := Endpoint{Proto: strings.ToLower(fields[0]), Timeout: DefaultTimeout}
+	switch e.Proto {
+	case "tcp", "udp", "ssl", "unix":
+	default:
+		return Endpoint{}, fmt.Errorf("invalid endpoint protocol %q: %s", fields[0], endpoint)
+	}
+	if IsStream(e.Proto) {
+		e.Istcp = 1
+	}
+	seen := make(map[string]bool)
+	for i := 1; i < len(fields); i += 2 {
+		opt := fields[i]
+		if len(opt) != 2 || opt[0] != '-' {
+			return Endpoint{}, fmt.Errorf("invalid endpoint option %q: %s", opt, endpoint)
+		}
+		if seen[opt] {
+			return Endpoint{}, fmt.Errorf("duplicated endpoint option %s: %s", opt, endpoint)
+		}
+		seen[opt] = true
+		if i+1 >= len(fields) {
+			return Endpoint{}, fmt.Errorf("missing value of endpoint option %s: %s", opt, endpoint)
+		}
+		value := fields[i+1]
+		var err error
+		switch opt[1] {
+		case 'h':
+			e.Host = value
+		case 'b':
+			e.Bind = value
+		case 's':
+			e.SetId = value
+		case 'p':
+			e.Port, err = parseInt(value, 0, 65535)
+		case 't':
+			e.Timeout, err = parseInt(value, 0, -1)
+		case 'w':
+			e.Weight, err = parseInt(value, -1, -1)
+		case 'v':
+			e.Version, err = parseInt(value, 0, -1)
+		case 'g':
+			e.Grid, err = parseInt(value, 0, -1)
+		case 'q':
+			e.Qos, err = parseInt(value, 0, -1)
+		case 'e':
+			e.Encrypt, err = parseInt(value, 0, 1)
+		case 'a':
+			e.AuthType, err = parseInt(value, 0, -1)
+		default:
+			return Endpoint{}, fmt.Errorf("unknown endpoint option %s: %s", opt, endpoint)
+		}
+		if err != nil {
+			return Endpoint{}, fmt.Errorf("invalid value of endpoint option %s: %v: %s", opt, err, endpoint)
+		}
+	}
+	if e.Host == "" {
+		return Endpoint{}, fmt.Errorf("missing host: %s", endpoint)
+	}
+	if e.Proto != "unix" && !seen["-p"] {
+		return Endpoint{}, fmt.Errorf("missing port: %s", endpoint)
+	}
+	return e, nil
+}
+
+// parseInt parses the int32 in [min, max], max < 0 means no upper limit.
+func parseInt(s string, min, max int64) (int32, error) {
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if v < min || (max >= 0 && v > max) {
+		return 0, fmt.Errorf("%d out of range", v)
+	}
+	return int32(v), nil
+}
+
+//String formats the endpoint in the grammar accepted by Parse.
+func (e Endpoint) String() string {
+	proto := e.Proto
+	if proto == "" {
+		proto = "tcp"
+		if e.Istcp == 0 {
+			proto = "udp"
+		}
+	}
+	var b strings.Builder
+	b.WriteString(proto)
+	b.WriteString(" -h ")
+	b.WriteString(e.Host)
+	if proto != "unix" || e.Port != 0 {
+		fmt.Fprintf(&b, " -p %d", e.Port)
+	}
+	fmt.Fprintf(&b, " -t %d", e.Timeout)
+	if e.Bind != "" {
+		b.WriteString(" -b " + e.Bind)
+	}
+	if e.SetId != "" {
+		b.WriteString(" -s " + e.SetId)
+	}
+	for _, o := range []struct {
+		flag  string
+		value int32
+	}{{"w", e.Weight}, {"v", e.Version}, {"g", e.Grid}, {"q", e.Qos}, {"e", e.Encrypt}, {"a", e.AuthType}} {
+		if o.value != 0 {
+			fmt.Fprintf(&b, " -%s %d", o.flag, o.value)
+		}
 	}
+	return b.String()
 }
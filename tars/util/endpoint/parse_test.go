@@ -7,11 +7,100 @@ import (
 
 //TestParse tests pasing the endpoint.
 func TestParse(t *testing.T) {
-	e := Parse("tcp -h 127.0.0.1 -p 19386 -t 60000")
+	e, err := Parse("tcp -h 127.0.0.1 -p 19386 -t 60000")
+	if err != nil {
+		t.Fatal(err)
+	}
 	fmt.Println(e)
-	e2 := Parse("udp -h 127.0.0.1 -p 19386 -t 60000")
+	e2, err := Parse("udp -h 127.0.0.1 -p 19386 -t 60000")
+	if err != nil {
+		t.Fatal(err)
+	}
 	fmt.Println(e2)
 	tars := Endpoint2tars(e2)
 	fmt.Println(tars)
 	fmt.Println(Tars2endpoint(tars))
 }
+
+//TestParseOptions tests parsing all the options and formatting back.
+func TestParseOptions(t *testing.T) {
+	e, err := Parse("ssl -h 10.0.0.1 -p 443 -t 5000 -b 0.0.0.0 -s app.sz.1 -w 80 -v 2 -g 1 -q 3 -e 1 -a 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := Endpoint{Host: "10.0.0.1", Port: 443, Timeout: 5000, Istcp: 1, Proto: "ssl", Bind: "0.0.0.0",
+		SetId: "app.sz.1", Weight: 80, Version: 2, Grid: 1, Qos: 3, Encrypt: 1, AuthType: 1}
+	if e != expect {
+		t.Fatalf("Unexpected endpoint %+v", e)
+	}
+	for _, s := range []string{
+		"tcp -h 127.0.0.1 -p 10015 -t 3000",
+		"udp -h 127.0.0.1 -p 10015 -t 60000 -s app.sz.1",
+		"unix -h /tmp/tars.sock -t 3000",
+		"ssl -h 10.0.0.1 -p 443 -t 5000 -b 0.0.0.0 -s app.sz.1 -w 80 -v 2 -g 1 -q 3 -e 1 -a 1",
+	} {
+		e, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse %q: %v", s, err)
+		}
+		if e.String() != s {
+			t.Errorf("Expected %q, got %q", s, e.String())
+		}
+	}
+	e, _ = Parse("tcp -h 127.0.0.1 -p 10015")
+	if e.Timeout != DefaultTimeout {
+		t.Errorf("Expected default timeout, got %d", e.Timeout)
+	}
+}
+
+//TestParseMalformed tests the errors of malformed endpoints.
+func TestParseMalformed(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"tc",
+		"http -h 127.0.0.1 -p 80",
+		"tcp -h 127.0.0.1",
+		"tcp -p 10015",
+		"tcp -h 127.0.0.1 -p",
+		"tcp -h 127.0.0.1 -p abc",
+		"tcp -h 127.0.0.1 -p 70000",
+		"tcp -h 127.0.0.1 -p 10015 -x 1",
+		"tcp -h 127.0.0.1 -p 10015 -p 10016",
+		"tcp -h 127.0.0.1 -p 10015 -e 2",
+		"tcp -h 127.0.0.1 10015",
+	} {
+		if e, err := Parse(s); err == nil {
+			t.Errorf("Expected error parsing %q, got %+v", s, e)
+		}
+	}
+}
+
+//TestConvert tests converting the endpoints to EndpointF and back.
+func TestConvert(t *testing.T) {
+	for _, s := range []string{
+		"tcp -h 127.0.0.1 -p 10015 -t 3000 -e 1",
+		"udp -h 127.0.0.1 -p 10015 -t 60000 -s app.sz.1",
+		"ssl -h 10.0.0.1 -p 443 -t 5000 -s app.sz.1 -w 80 -g 1 -q 3 -e 1 -a 1",
+	} {
+		e, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse %q: %v", s, err)
+		}
+		if back := Tars2endpoint(Endpoint2tars(e)); back != e {
+			t.Errorf("Expected %+v, got %+v", e, back)
+		}
+	}
+	e, _ := Parse("ssl -h 10.0.0.1 -p 443")
+	if end := Endpoint2tars(e); end.Istcp != 2 {
+		t.Errorf("Expected istcp 2 of ssl, got %d", end.Istcp)
+	}
+}
+
+//TestIsSupported tests the protocols spoken by the transport.
+func TestIsSupported(t *testing.T) {
+	for proto, expect := range map[string]bool{"tcp": true, "udp": true, "ssl": false, "unix": false} {
+		if IsSupported(proto) != expect {
+			t.Errorf("Expected %v of %s", expect, proto)
+		}
+	}
+}
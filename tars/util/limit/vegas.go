@@ -0,0 +1,160 @@
+package limit
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+//Limiter is an adaptive concurrency limiter.
+type Limiter interface {
+	//Acquire takes a slot for a new request, false means the limit is reached.
+	Acquire() bool
+	//Release gives back the slot taken by Acquire, with the observed latency.
+	//dropped means the request failed because of overload, like a timeout.
+	Release(rtt time.Duration, dropped bool)
+	//Limit returns the current concurrency limit.
+	Limit() int
+	//Inflight returns the number of requests holding a slot.
+	Inflight() int
+}
+
+//Vegas is a limiter adapted from the TCP Vegas congestion control.
+//It estimates the queue of the downstream from the gap between the observed
+//latency and the minimum latency, and grows or shrinks the limit to keep
+//that queue small.
+type Vegas struct {
+	mu            sync.Mutex
+	limit         float64
+	minLimit      float64
+	maxLimit      float64
+	rttNoLoad     time.Duration
+	samples       int
+	probeInterval int
+	inflight      int32
+	curLimit      int32
+	// backoffAt is the time of the last back off, the drops of the requests sent before it are
+	// caused by the same overload
+	backoffAt time.Time
+}
+
+//NewVegas returns a vegas limiter starting at initLimit and bounded by minLimit and maxLimit.
+func NewVegas(initLimit, minLimit, maxLimit int) *Vegas {
+	if minLimit < 1 {
+		minLimit = 1
+	}
+	if maxLimit < minLimit {
+		maxLimit = minLimit
+	}
+	if initLimit < minLimit {
+		initLimit = minLimit
+	} else if initLimit > maxLimit {
+		initLimit = maxLimit
+	}
+	return &Vegas{
+		limit:         float64(initLimit),
+		minLimit:      float64(minLimit),
+		maxLimit:      float64(maxLimit),
+		probeInterval: 1000,
+		curLimit:      int32(initLimit),
+	}
+}
+
+//Acquire takes a slot if the inflight requests are below the limit.
+func (v *Vegas) Acquire() bool {
+	for {
+		n := atomic.LoadInt32(&v.inflight)
+		if n >= atomic.LoadInt32(&v.curLimit) {
+			return false
+		}
+		if atomic.CompareAndSwapInt32(&v.inflight, n, n+1) {
+			return true
+		}
+	}
+}
+
+//Release gives back the slot and updates the limit with the sample.
+//The limit backs off at most once per rtt window, only for the drops of the requests sent after the last back off.
+func (v *Vegas) Release(rtt time.Duration, dropped bool) {
+	inflight := atomic.AddInt32(&v.inflight, -1) + 1
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if dropped {
+		now := time.Now()
+		if now.Add(-rtt).Before(v.backoffAt) {
+			return
+		}
+		v.backoffAt = now
+		v.setLimit(v.limit - v.limit/2)
+		return
+	}
+	if rtt <= 0 {
+		return
+	}
+	v.samples++
+	if v.rttNoLoad == 0 || rtt < v.rttNoLoad || v.samples%v.probeInterval == 0 {
+		// probe the no load latency again from time to time, since the downstream may change
+		v.rttNoLoad = rtt
+		return
+	}
+	// only a busy limiter tells us something about the limit
+	if float64(inflight)*2 < v.limit {
+		return
+	}
+	queue := math.Ceil(v.limit * (1 - float64(v.rttNoLoad)/float64(rtt)))
+	step := math.Max(1, math.Log10(v.limit))
+	alpha := 3 * step
+	beta := 6 * step
+	if queue < alpha {
+		v.setLimit(v.limit + step)
+	} else if queue > beta {
+		v.setLimit(v.limit - step)
+	}
+}
+
+func (v *Vegas) setLimit(limit float64) {
+	v.limit = math.Min(v.maxLimit, math.Max(v.minLimit, limit))
+	atomic.StoreInt32(&v.curLimit, int32(v.limit))
+}
+
+//Limit returns the current limit.
+func (v *Vegas) Limit() int {
+	return int(atomic.LoadInt32(&v.curLimit))
+}
+
+//Inflight returns the number of requests holding a slot.
+func (v *Vegas) Inflight() int {
+	return int(atomic.LoadInt32(&v.inflight))
+}
+
+//Unlimited is a limiter without limit, it only counts the inflight requests.
+type Unlimited struct {
+	inflight int32
+}
+
+//NewUnlimited returns a limiter that never rejects a request.
+func NewUnlimited() *Unlimited {
+	return &Unlimited{}
+}
+
+//Acquire always takes a slot.
+func (u *Unlimited) Acquire() bool {
+	atomic.AddInt32(&u.inflight, 1)
+	return true
+}
+
+//Release gives back the slot.
+func (u *Unlimited) Release(rtt time.Duration, dropped bool) {
+	atomic.AddInt32(&u.inflight, -1)
+}
+
+//Limit returns 0, there is no limit.
+func (u *Unlimited) Limit() int {
+	return 0
+}
+
+//Inflight returns the number of requests holding a slot.
+func (u *Unlimited) Inflight() int {
+	return int(atomic.LoadInt32(&u.inflight))
+}
@@ -0,0 +1,90 @@
+package limit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVegasAcquire(t *testing.T) {
+	v := NewVegas(2, 1, 10)
+	if !v.Acquire() || !v.Acquire() {
+		t.Fatal("acquire under the limit fail")
+	}
+	if v.Acquire() {
+		t.Error("acquire over the limit should fail")
+	}
+	if v.Inflight() != 2 {
+		t.Error("inflight error:", v.Inflight())
+	}
+	v.Release(time.Millisecond, false)
+	if !v.Acquire() {
+		t.Error("acquire after release fail")
+	}
+}
+
+func TestVegasAdjust(t *testing.T) {
+	v := NewVegas(10, 1, 100)
+	// fast downstream, the limit grows
+	for i := 0; i < 100; i++ {
+		for v.Acquire() {
+		}
+		for v.Inflight() > 0 {
+			v.Release(time.Millisecond, false)
+		}
+	}
+	grown := v.Limit()
+	if grown <= 10 {
+		t.Error("limit should grow:", grown)
+	}
+	// degrading downstream, the limit shrinks
+	for i := 0; i < 5; i++ {
+		for v.Acquire() {
+		}
+		for v.Inflight() > 0 {
+			v.Release(10*time.Millisecond, false)
+		}
+	}
+	if v.Limit() >= grown {
+		t.Error("limit should shrink:", v.Limit(), grown)
+	}
+	v.Acquire()
+	before := v.Limit()
+	v.Release(time.Second, true)
+	if v.Limit() >= before && before > 1 {
+		t.Error("limit should back off on drop:", v.Limit(), before)
+	}
+}
+
+func TestVegasBackoffOncePerWindow(t *testing.T) {
+	v := NewVegas(100, 1, 100)
+	for i := 0; i < 10; i++ {
+		v.Acquire()
+	}
+	// a burst of timeouts of the requests sent together backs off once
+	for i := 0; i < 10; i++ {
+		v.Release(time.Second, true)
+	}
+	if v.Limit() != 50 {
+		t.Error("limit should back off once in the window:", v.Limit())
+	}
+	// a request sent after the back off backs off again
+	time.Sleep(2 * time.Millisecond)
+	v.Acquire()
+	v.Release(time.Millisecond, true)
+	if v.Limit() != 25 {
+		t.Error("limit should back off in the next window:", v.Limit())
+	}
+}
+
+func TestUnlimited(t *testing.T) {
+	u := NewUnlimited()
+	for i := 0; i < 100000; i++ {
+		if !u.Acquire() {
+			t.Fatal("unlimited acquire fail")
+		}
+	}
+	u.Release(time.Millisecond, true)
+	if u.Inflight() != 99999 {
+		t.Error("inflight error:", u.Inflight())
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sync/atomic"
 	"time"
 )
 
@@ -26,12 +27,31 @@ var (
 	loggerMap = make(map[string]*Logger)
 	writeDone = make(chan bool)
 
-	currUnixTime int64
-	currDateTime string
-	currDateHour string
-	currDateDay  string
+	// currTime is the *clock updated every second, read by the goroutines logging at the same time
+	currTime atomic.Value
 )
 
+// clock is the time of the logs, formatted once a second.
+type clock struct {
+	unixTime int64
+	dateTime string
+	dateHour string
+	dateDay  string
+}
+
+func setClock(now time.Time) {
+	currTime.Store(&clock{
+		unixTime: now.Unix(),
+		dateTime: now.Format("2006-01-02 15:04:05"),
+		dateHour: now.Format("2006010215"),
+		dateDay:  now.Format("20060102"),
+	})
+}
+
+func currClock() *clock {
+	return currTime.Load().(*clock)
+}
+
 //Logger is the struct with name and wirter.
 type Logger struct {
 	name   string
@@ -49,11 +69,7 @@ type logValue struct {
 }
 
 func init() {
-	now := time.Now()
-	currUnixTime = now.Unix()
-	currDateTime = now.Format("2006-01-02 15:04:05")
-	currDateHour = now.Format("2006010215")
-	currDateDay = now.Format("20060102")
+	setClock(time.Now())
 	go func() {
 		tm := time.NewTimer(time.Second)
 		if err := recover(); err != nil { // avoid timer panic
@@ -63,11 +79,7 @@ func init() {
 			d := time.Second - time.Duration(now.Nanosecond())
 			tm.Reset(d)
 			<-tm.C
-			now = time.Now()
-			currUnixTime = now.Unix()
-			currDateTime = now.Format("2006-01-02 15:04:05")
-			currDateHour = now.Format("2006010215")
-			currDateDay = now.Format("20060102")
+			setClock(time.Now())
 		}
 	}()
 	go flushLog(true)
@@ -223,7 +235,7 @@ func (l *Logger) writef(level LogLevel, format string, v []interface{}) {
 
 	buf := bytes.NewBuffer(nil)
 	if l.writer.NeedPrefix() {
-		fmt.Fprintf(buf, "%s|", currDateTime)
+		fmt.Fprintf(buf, "%s|", currClock().dateTime)
 		if logLevel == DEBUG {
 			_, file, line, ok := runtime.Caller(2)
 			if !ok {
@@ -55,7 +55,7 @@ type HourWriter struct {
 type DateType uint8
 
 func reOpenFile(path string, currFile **os.File, openTime *int64) {
-	*openTime = currUnixTime
+	*openTime = currClock().unixTime
 	if *currFile != nil {
 		(*currFile).Close()
 	}
@@ -77,7 +77,7 @@ func (w *ConsoleWriter) NeedPrefix() bool {
 
 //Write for writing []byte to the writter.
 func (w *RollFileWriter) Write(v []byte) {
-	if w.currFile == nil || w.openTime+10 < currUnixTime {
+	if w.currFile == nil || w.openTime+10 < currClock().unixTime {
 		fullPath := filepath.Join(w.logpath, w.name+".log")
 		reOpenFile(fullPath, &w.currFile, &w.openTime)
 	}
@@ -128,7 +128,7 @@ func (w *RollFileWriter) NeedPrefix() bool {
 
 //Write method implement for the DateWriter
 func (w *DateWriter) Write(v []byte) {
-	if w.currFile == nil || w.openTime+10 < currUnixTime {
+	if w.currFile == nil || w.openTime+10 < currClock().unixTime {
 		fullPath := filepath.Join(w.logpath, w.name+"_"+w.currDate+".log")
 		reOpenFile(fullPath, &w.currFile, &w.openTime)
 	}
@@ -191,7 +191,7 @@ func (w *DateWriter) cleanOldLogs() {
 
 func (w *DateWriter) getCurrDate() string {
 	if w.dateType == HOUR {
-		return currDateHour
+		return currClock().dateHour
 	}
-	return currDateDay // DAY
+	return currClock().dateDay // DAY
 }